@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"leavemanagement/lm-db-service/internal/storage/database"
+	"os"
+	"time"
+)
+
+const databaseType = "mysql"
+
+var timeout = flag.Duration("timeout", 5*time.Minute, "time allowed to read the whole audit trail")
+
+// lm-audit-verify recomputes the hash chain of lm_audit_event and exits with
+// status 1 when an event was changed, removed or inserted out of band.
+func main() {
+	flag.Parse()
+	db, err := database.NewMysqlDB(databaseType)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	defer db.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	count, err := db.VerifyAuditTrail(ctx)
+	var tamperErr *database.AuditTamperError
+	if errors.As(err, &tamperErr) {
+		fmt.Fprintln(os.Stderr, tamperErr)
+		os.Exit(1)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	fmt.Printf("audit trail intact, %d events verified\n", count)
+}
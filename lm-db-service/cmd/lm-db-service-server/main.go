@@ -1,41 +1,215 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
+	"leavemanagement/lm-db-service/internal/health"
+	"leavemanagement/lm-db-service/internal/idempotency"
+	"leavemanagement/lm-db-service/internal/logging"
+	"leavemanagement/lm-db-service/internal/metrics"
+	"leavemanagement/lm-db-service/internal/middleware"
+	"leavemanagement/lm-db-service/internal/retention"
 	"leavemanagement/lm-db-service/internal/storage/database"
+	"leavemanagement/lm-db-service/internal/tlsconfig"
+	"leavemanagement/lm-db-service/internal/tracing"
 	"leavemanagement/lm-db-service/models"
 	"leavemanagement/lm-db-service/pkg/pb"
 	"log"
 	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"leavemanagement/lm-db-service/cmd/lm-db-service-server/services"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
 const (
 	databaseType = "mysql"
+	databaseName = "leave_management"
 	protocol     = "tcp"
 	addr         = "0.0.0.0:50051"
+	serviceName  = "leaveManagement.leaveManagementSerivce"
+	servicePath  = "/" + serviceName + "/"
 )
 
+var (
+	rpcTimeout      = flag.Duration("rpc-timeout", 5*time.Second, "default deadline applied to every RPC")
+	listTimeout     = flag.Duration("list-timeout", 15*time.Second, "deadline applied to LeavesList")
+	queryTimeout    = flag.Duration("query-timeout", 3*time.Second, "deadline applied to every MySQL statement")
+	healthInterval  = flag.Duration("health-interval", 5*time.Second, "how often the database is pinged for readiness")
+	shutdownTimeout = flag.Duration("shutdown-timeout", 20*time.Second, "time allowed for in-flight RPCs to finish on shutdown")
+	tlsCertFile     = flag.String("tls-cert", "", "server certificate file; enables TLS when set")
+	tlsKeyFile      = flag.String("tls-key", "", "server private key file")
+	tlsClientCAFile = flag.String("tls-client-ca", "", "CA file used to verify client certificates; enables mutual TLS when set")
+	tlsRequireCert  = flag.Bool("tls-require-client-cert", false, "reject clients that do not present a certificate")
+	tlsReload       = flag.Duration("tls-reload-interval", time.Minute, "how often certificate files are checked for changes")
+	metricsAddr     = flag.String("metrics-addr", "0.0.0.0:9090", "address of the Prometheus metrics endpoint; empty disables it")
+	pendingDays     = flag.Int("metrics-pending-days", 3, "age in days after which pending applications are reported as stale")
+	traceExporter   = flag.String("trace-exporter", tracing.ExporterNone, "span exporter: none, stdout or otlp")
+	otlpEndpoint    = flag.String("otlp-endpoint", "localhost:4317", "OTLP gRPC collector address")
+	otlpInsecure    = flag.Bool("otlp-insecure", false, "connect to the OTLP collector without TLS")
+	logLevel        = flag.String("log-level", "info", "minimum log level: debug, info, warn or error")
+	retentionPeriod = flag.Duration("retention-period", 365*24*time.Hour, "time deleted leave applications are kept before being purged; 0 disables purging")
+	purgeInterval   = flag.Duration("purge-interval", 24*time.Hour, "how often deleted leave applications past the retention period are purged")
+	idempotencyTTL  = flag.Duration("idempotency-window", 24*time.Hour, "how long results of requests sent with an idempotency key are kept for retries")
+	compOffInterval = flag.Duration("comp-off-expiry-interval", time.Hour, "how often expired comp-off credits are lapsed")
+)
+
+var logger *zap.Logger
+
 func main() {
+	flag.Parse()
 	var db models.DatabaseIF
-	log.Print("Leave Management Server")
+	var err error
+	logger, err = logging.New(*logLevel)
+	if err != nil {
+		log.Fatalf("invalid log level: %v", err)
+	}
+	defer logger.Sync()
+	defer zap.RedirectStdLog(logger)()
+	logger.Info("Leave Management Server", zap.String("addr", addr))
 	lis, err := net.Listen(protocol, addr)
 	if err != nil {
-		log.Fatalf("failed to listen:%v", err)
+		logger.Fatal("failed to listen", zap.Error(err))
 	}
-	db, err = database.NewMysqlDB(databaseType)
+	mysqlDB, err := database.NewMysqlDB(databaseType)
 	if err != nil {
-		log.Fatal(err)
+		logger.Fatal("failed to connect to database", zap.Error(err))
+	}
+	mysqlDB.QueryTimeout = *queryTimeout
+	db = mysqlDB
+	if err := metrics.RegisterDB(mysqlDB.DB, databaseName); err != nil {
+		logger.Fatal("failed to register database metrics", zap.Error(err))
+	}
+	prometheus.MustRegister(metrics.NewPendingCollector(mysqlDB.CountPendingLeaves, *pendingDays, *queryTimeout))
+	ctx, stop := context.WithCancel(context.Background())
+	defer stop()
+	shutdownTracing, err := tracing.Setup(ctx, tracing.Config{
+		Exporter:    *traceExporter,
+		ServiceName: serviceName,
+		Endpoint:    *otlpEndpoint,
+		Insecure:    *otlpInsecure,
+	})
+	if err != nil {
+		logger.Fatal("failed to set up tracing", zap.Error(err))
+	}
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(
+			otelgrpc.UnaryServerInterceptor(),
+			logging.UnaryServer(logger),
+			metrics.UnaryServer(),
+			middleware.UnaryDeadline(*rpcTimeout, map[string]time.Duration{
+				servicePath + "LeavesList": *listTimeout,
+			}),
+			idempotency.UnaryServer(mysqlDB, *idempotencyTTL,
+				servicePath+"AdjustBalance",
+				servicePath+"ApplyLeave",
+				servicePath+"ChangeCompOffStatus",
+				servicePath+"ChangeEncashmentStatus",
+				servicePath+"ChangeLeaveStatus",
+				servicePath+"CreateBlackoutPeriod",
+				servicePath+"DeleteBlackoutPeriod",
+				servicePath+"DeleteLeave",
+				servicePath+"EncashLeave",
+				servicePath+"RecordExit",
+				servicePath+"RequestCompOff",
+				servicePath+"RestoreLeave",
+				servicePath+"UpdateLeave",
+			),
+		),
+	}
+	if *tlsCertFile != "" {
+		reloader, err := tlsconfig.NewReloader(*tlsCertFile, *tlsKeyFile, *tlsClientCAFile, *tlsRequireCert, logger)
+		if err != nil {
+			logger.Fatal("failed to load tls certificates", zap.Error(err))
+		}
+		go reloader.Watch(ctx, *tlsReload)
+		opts = append(opts, grpc.Creds(credentials.NewTLS(reloader.TLSConfig())))
+	} else {
+		logger.Warn("tls is not configured, serving plaintext")
 	}
-	s := grpc.NewServer()
+	s := grpc.NewServer(opts...)
 	pb.RegisterLeaveManagementSerivceServer(s, &services.Server{
 		DB: db,
 	})
+	checker := health.NewChecker(db, *healthInterval, serviceName)
+	healthpb.RegisterHealthServer(s, checker.Server)
 	reflection.Register(s)
-	if err := s.Serve(lis); err != nil {
-		log.Fatalf("failed to serve:%v", err)
+
+	go checker.Run(ctx)
+	if *retentionPeriod > 0 {
+		purgeJob := retention.NewJob("deleted leave applications", mysqlDB.PurgeDeletedLeaves, *retentionPeriod, *purgeInterval, *rpcTimeout, logger)
+		go purgeJob.Run(ctx)
+	}
+	keysJob := retention.NewJob("idempotency keys", mysqlDB.PurgeIdempotencyKeys, *idempotencyTTL, time.Hour, *rpcTimeout, logger)
+	go keysJob.Run(ctx)
+	compOffJob := retention.NewJob("expired comp-off credits", mysqlDB.LapseCompOffs, 0, *compOffInterval, *rpcTimeout, logger)
+	go compOffJob.Run(ctx)
+
+	var metricsServer *http.Server
+	if *metricsAddr != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", promhttp.Handler())
+		metricsServer = &http.Server{Addr: *metricsAddr, Handler: mux}
+		go func() {
+			if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				logger.Error("failed to serve metrics", zap.Error(err))
+			}
+		}()
+	}
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- s.Serve(lis)
+	}()
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, syscall.SIGTERM, os.Interrupt)
+	select {
+	case err := <-serveErr:
+		logger.Fatal("failed to serve", zap.Error(err))
+	case received := <-sig:
+		logger.Info("shutting down", zap.String("signal", received.String()))
+	}
+	stop()
+	checker.Shutdown()
+	gracefulStop(s, *shutdownTimeout)
+	if metricsServer != nil {
+		metricsServer.Close()
+	}
+	if err := db.Close(); err != nil {
+		logger.Error("failed to close database", zap.Error(err))
+	}
+	flushCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := shutdownTracing(flushCtx); err != nil {
+		logger.Error("failed to flush traces", zap.Error(err))
+	}
+}
+
+// gracefulStop stops accepting new RPCs and waits up to timeout for in-flight
+// ones to finish before closing the remaining connections.
+func gracefulStop(s *grpc.Server, timeout time.Duration) {
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(timeout):
+		logger.Warn("shutdown timeout exceeded, closing remaining connections")
+		s.Stop()
 	}
 }
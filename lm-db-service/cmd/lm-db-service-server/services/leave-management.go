@@ -21,9 +21,14 @@ func (svc Server) GetLeaveById(ctx context.Context, req *pb.GetLeaveByIdRequest)
 	return leave, err
 }
 
+func (svc Server) ListMyLeaves(ctx context.Context, req *pb.ListMyLeavesRequest) (*pb.LeavesListResponse, error) {
+	leaves, err := svc.DB.ListMyLeaves(ctx, req)
+	return leaves, err
+}
+
 func (svc Server) ApplyLeave(ctx context.Context, req *pb.ApplyLeaveRequest) (*pb.ApplyLeaveResponse, error) {
-	err := svc.DB.ApplyLeave(ctx, req)
-	return &pb.ApplyLeaveResponse{}, err
+	leave, err := svc.DB.ApplyLeave(ctx, req)
+	return leave, err
 }
 
 func (svc Server) ChangeLeaveStatus(ctx context.Context, req *pb.ChangeLeaveStatusRequest) (*pb.ChangeLeaveStatusResponse, error) {
@@ -36,7 +41,102 @@ func (svc Server) DeleteLeave(ctx context.Context, req *pb.DeleteLeaveRequest) (
 	return &pb.DeleteLeaveResponse{}, err
 }
 
+func (svc Server) RestoreLeave(ctx context.Context, req *pb.RestoreLeaveRequest) (*pb.RestoreLeaveResponse, error) {
+	err := svc.DB.RestoreLeave(ctx, req)
+	return &pb.RestoreLeaveResponse{}, err
+}
+
 func (svc Server) UpdateLeave(ctx context.Context, req *pb.UpdateLeaveRequest) (*pb.UpdateLeaveResponse, error) {
-	err := svc.DB.UpdateLeave(ctx, req)
-	return &pb.UpdateLeaveResponse{}, err
+	leave, err := svc.DB.UpdateLeave(ctx, req)
+	return leave, err
+}
+
+func (svc Server) GetLeaveBalances(ctx context.Context, req *pb.GetLeaveBalancesRequest) (*pb.GetLeaveBalancesResponse, error) {
+	balances, err := svc.DB.GetLeaveBalances(ctx, req)
+	return balances, err
+}
+
+func (svc Server) ListAuditEvents(ctx context.Context, req *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	events, err := svc.DB.ListAuditEvents(ctx, req)
+	return events, err
+}
+
+func (svc Server) TeamCalendar(ctx context.Context, req *pb.TeamCalendarRequest) (*pb.TeamCalendarResponse, error) {
+	calendar, err := svc.DB.TeamCalendar(ctx, req)
+	return calendar, err
+}
+
+func (svc Server) CreateBlackoutPeriod(ctx context.Context, req *pb.CreateBlackoutPeriodRequest) (*pb.CreateBlackoutPeriodResponse, error) {
+	blackoutPeriod, err := svc.DB.CreateBlackoutPeriod(ctx, req)
+	return blackoutPeriod, err
+}
+
+func (svc Server) ListBlackoutPeriods(ctx context.Context, req *pb.ListBlackoutPeriodsRequest) (*pb.ListBlackoutPeriodsResponse, error) {
+	blackoutPeriods, err := svc.DB.ListBlackoutPeriods(ctx, req)
+	return blackoutPeriods, err
+}
+
+func (svc Server) DeleteBlackoutPeriod(ctx context.Context, req *pb.DeleteBlackoutPeriodRequest) (*pb.DeleteBlackoutPeriodResponse, error) {
+	err := svc.DB.DeleteBlackoutPeriod(ctx, req)
+	return &pb.DeleteBlackoutPeriodResponse{}, err
+}
+
+func (svc Server) ListEligibleLeaveTypes(ctx context.Context, req *pb.ListEligibleLeaveTypesRequest) (*pb.ListEligibleLeaveTypesResponse, error) {
+	leaveTypes, err := svc.DB.ListEligibleLeaveTypes(ctx, req)
+	return leaveTypes, err
+}
+
+func (svc Server) RequestCompOff(ctx context.Context, req *pb.RequestCompOffRequest) (*pb.RequestCompOffResponse, error) {
+	compOff, err := svc.DB.RequestCompOff(ctx, req)
+	return compOff, err
+}
+
+func (svc Server) ChangeCompOffStatus(ctx context.Context, req *pb.ChangeCompOffStatusRequest) (*pb.ChangeCompOffStatusResponse, error) {
+	err := svc.DB.ChangeCompOffStatus(ctx, req)
+	return &pb.ChangeCompOffStatusResponse{}, err
+}
+
+func (svc Server) ListCompOffs(ctx context.Context, req *pb.ListCompOffsRequest) (*pb.ListCompOffsResponse, error) {
+	compOffs, err := svc.DB.ListCompOffs(ctx, req)
+	return compOffs, err
+}
+
+func (svc Server) EncashLeave(ctx context.Context, req *pb.EncashLeaveRequest) (*pb.EncashLeaveResponse, error) {
+	encashment, err := svc.DB.EncashLeave(ctx, req)
+	return encashment, err
+}
+
+func (svc Server) ChangeEncashmentStatus(ctx context.Context, req *pb.ChangeEncashmentStatusRequest) (*pb.ChangeEncashmentStatusResponse, error) {
+	err := svc.DB.ChangeEncashmentStatus(ctx, req)
+	return &pb.ChangeEncashmentStatusResponse{}, err
+}
+
+func (svc Server) ListEncashments(ctx context.Context, req *pb.ListEncashmentsRequest) (*pb.ListEncashmentsResponse, error) {
+	encashments, err := svc.DB.ListEncashments(ctx, req)
+	return encashments, err
+}
+
+func (svc Server) ListLossOfPay(ctx context.Context, req *pb.ListLossOfPayRequest) (*pb.ListLossOfPayResponse, error) {
+	lossOfPay, err := svc.DB.ListLossOfPay(ctx, req)
+	return lossOfPay, err
+}
+
+func (svc Server) AdjustBalance(ctx context.Context, req *pb.AdjustBalanceRequest) (*pb.AdjustBalanceResponse, error) {
+	adjustment, err := svc.DB.AdjustBalance(ctx, req)
+	return adjustment, err
+}
+
+func (svc Server) ListBalanceAdjustments(ctx context.Context, req *pb.ListBalanceAdjustmentsRequest) (*pb.ListBalanceAdjustmentsResponse, error) {
+	adjustments, err := svc.DB.ListBalanceAdjustments(ctx, req)
+	return adjustments, err
+}
+
+func (svc Server) RecordExit(ctx context.Context, req *pb.RecordExitRequest) (*pb.RecordExitResponse, error) {
+	settlement, err := svc.DB.RecordExit(ctx, req)
+	return settlement, err
+}
+
+func (svc Server) GetFinalSettlement(ctx context.Context, req *pb.GetFinalSettlementRequest) (*pb.GetFinalSettlementResponse, error) {
+	settlement, err := svc.DB.GetFinalSettlement(ctx, req)
+	return settlement, err
 }
@@ -0,0 +1,82 @@
+package health
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	// LivenessService reports SERVING for as long as the process is able to
+	// answer RPCs. The empty service name reports the same status.
+	LivenessService = "liveness"
+	// ReadinessService reports SERVING only while the database answers pings.
+	ReadinessService = "readiness"
+)
+
+// Pinger is the part of models.DatabaseIF the readiness check depends on.
+type Pinger interface {
+	Test() error
+}
+
+// Checker keeps the standard gRPC health service in sync with the database.
+type Checker struct {
+	Server   *health.Server
+	DB       Pinger
+	Interval time.Duration
+	// Services lists additional service names that follow readiness, such
+	// as the leave management service itself.
+	Services []string
+}
+
+func NewChecker(db Pinger, interval time.Duration, services ...string) *Checker {
+	checker := &Checker{
+		Server:   health.NewServer(),
+		DB:       db,
+		Interval: interval,
+		Services: services,
+	}
+	checker.Server.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	checker.Server.SetServingStatus(LivenessService, healthpb.HealthCheckResponse_SERVING)
+	checker.setReadiness(healthpb.HealthCheckResponse_NOT_SERVING)
+	return checker
+}
+
+// Check pings the database once and updates the readiness status.
+func (c *Checker) Check() {
+	status := healthpb.HealthCheckResponse_SERVING
+	if err := c.DB.Test(); err != nil {
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	c.setReadiness(status)
+}
+
+// Run checks readiness every Interval until ctx is done.
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.Interval)
+	defer ticker.Stop()
+	c.Check()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.Check()
+		}
+	}
+}
+
+// Shutdown marks every service NOT_SERVING so load balancers stop routing new
+// RPCs while in-flight ones drain. Later status updates are ignored.
+func (c *Checker) Shutdown() {
+	c.Server.Shutdown()
+}
+
+func (c *Checker) setReadiness(status healthpb.HealthCheckResponse_ServingStatus) {
+	c.Server.SetServingStatus(ReadinessService, status)
+	for _, service := range c.Services {
+		c.Server.SetServingStatus(service, status)
+	}
+}
@@ -0,0 +1,79 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type testPinger struct {
+	err error
+}
+
+func (p *testPinger) Test() error {
+	return p.err
+}
+
+func getStatus(t *testing.T, checker *Checker, service string) healthpb.HealthCheckResponse_ServingStatus {
+	resp, err := checker.Server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		t.Fatalf("Check(%q) got error %v", service, err)
+	}
+	return resp.Status
+}
+
+func TestChecker(t *testing.T) {
+	tests := []struct {
+		description string
+		pingErr     error
+		service     string
+		expected    healthpb.HealthCheckResponse_ServingStatus
+	}{
+		{
+			description: "ready",
+			service:     ReadinessService,
+			expected:    healthpb.HealthCheckResponse_SERVING,
+		},
+		{
+			description: "ready service",
+			service:     "leaveManagement.leaveManagementSerivce",
+			expected:    healthpb.HealthCheckResponse_SERVING,
+		},
+		{
+			description: "database down",
+			pingErr:     errors.New("ping to mysql DB failed"),
+			service:     ReadinessService,
+			expected:    healthpb.HealthCheckResponse_NOT_SERVING,
+		},
+		{
+			description: "alive while database down",
+			pingErr:     errors.New("ping to mysql DB failed"),
+			service:     LivenessService,
+			expected:    healthpb.HealthCheckResponse_SERVING,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			checker := NewChecker(&testPinger{err: test.pingErr}, time.Second, "leaveManagement.leaveManagementSerivce")
+			checker.Check()
+			if got := getStatus(t, checker, test.service); got != test.expected {
+				t.Errorf("expected %v: got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestChecker_Shutdown(t *testing.T) {
+	checker := NewChecker(&testPinger{}, time.Second)
+	checker.Check()
+	checker.Shutdown()
+	checker.Check()
+	for _, service := range []string{"", LivenessService, ReadinessService} {
+		if got := getStatus(t, checker, service); got != healthpb.HealthCheckResponse_NOT_SERVING {
+			t.Errorf("expected %v: got %v", healthpb.HealthCheckResponse_NOT_SERVING, got)
+		}
+	}
+}
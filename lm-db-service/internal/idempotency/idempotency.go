@@ -0,0 +1,193 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const (
+	// Header is the metadata key carrying the client supplied idempotency key.
+	Header = "idempotency-key"
+	// ReplayedHeader is set to "true" in the response header when the
+	// response is the stored result of an earlier call.
+	ReplayedHeader = "idempotency-replayed"
+	// MaxKeyLength is the longest key accepted.
+	MaxKeyLength = 100
+)
+
+// releaseTimeout bounds the call that frees a key after a failed RPC. It does
+// not use the RPC context, which may already be done.
+const releaseTimeout = 5 * time.Second
+
+// Key identifies a request. Keys are scoped to the caller and the RPC, so two
+// employees or two RPCs never share a result.
+type Key struct {
+	EmployeeId string
+	Method     string
+	Key        string
+}
+
+// Record is the stored state of a key.
+type Record struct {
+	RequestHash string
+	// Completed is false while the first request is still running.
+	Completed bool
+	Response  []byte
+}
+
+// Store keeps idempotency records.
+type Store interface {
+	// ReserveIdempotencyKey claims key for a new request and returns nil, or
+	// returns the record of an earlier request with the same key. A
+	// reservation expires after window.
+	ReserveIdempotencyKey(ctx context.Context, key Key, requestHash string, window time.Duration) (*Record, error)
+	// CompleteIdempotencyKey stores the response of the request holding key.
+	CompleteIdempotencyKey(ctx context.Context, key Key, response []byte) error
+	// ReleaseIdempotencyKey frees a key whose request failed so it can be
+	// retried.
+	ReleaseIdempotencyKey(ctx context.Context, key Key) error
+}
+
+// callerRequest is implemented by every request that identifies the employee
+// making the call.
+type callerRequest interface {
+	GetEmployeeId() string
+}
+
+// UnaryServer makes the given methods idempotent for callers that send an
+// idempotency-key header. The first successful response is stored for window
+// and returned to every retry carrying the same key and request. A retry with
+// a different request fails with INVALID_ARGUMENT and one made while the first
+// call is still running fails with ABORTED. Failed calls are not stored, so
+// they can be retried with the same key.
+func UnaryServer(store Store, window time.Duration, methods ...string) grpc.UnaryServerInterceptor {
+	idempotent := map[string]bool{}
+	for _, method := range methods {
+		idempotent[method] = true
+	}
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !idempotent[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		value := keyFromContext(ctx)
+		if value == "" {
+			return handler(ctx, req)
+		}
+		if len(value) > MaxKeyLength {
+			return nil, status.Errorf(codes.InvalidArgument, "idempotency key is longer than %d characters", MaxKeyLength)
+		}
+		message, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+		key := Key{Method: info.FullMethod, Key: value}
+		if caller, ok := req.(callerRequest); ok {
+			key.EmployeeId = caller.GetEmployeeId()
+		}
+		requestHash, err := hashRequest(message)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		record, err := store.ReserveIdempotencyKey(ctx, key, requestHash, window)
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "could not check idempotency key: %v", err)
+		}
+		if record != nil {
+			return replay(ctx, info.FullMethod, record, requestHash)
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			releaseCtx, cancel := context.WithTimeout(context.Background(), releaseTimeout)
+			defer cancel()
+			store.ReleaseIdempotencyKey(releaseCtx, key)
+			return resp, err
+		}
+		if response, ok := resp.(proto.Message); ok {
+			data, err := proto.MarshalOptions{Deterministic: true}.Marshal(response)
+			if err == nil {
+				// When the result cannot be stored the key stays reserved,
+				// so retries are refused until it expires instead of being
+				// applied twice.
+				store.CompleteIdempotencyKey(ctx, key, data)
+			}
+		}
+		return resp, nil
+	}
+}
+
+func keyFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(Header); len(values) > 0 {
+		return strings.TrimSpace(values[0])
+	}
+	return ""
+}
+
+func hashRequest(req proto.Message) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func replay(ctx context.Context, fullMethod string, record *Record, requestHash string) (interface{}, error) {
+	if record.RequestHash != requestHash {
+		return nil, status.Error(codes.InvalidArgument, "idempotency key was already used with a different request")
+	}
+	if !record.Completed {
+		return nil, status.Error(codes.Aborted, "a request with this idempotency key is still in progress")
+	}
+	responseType, err := responseType(fullMethod)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	response := responseType.New().Interface()
+	if err := proto.Unmarshal(record.Response, response); err != nil {
+		return nil, status.Errorf(codes.Internal, "could not decode stored response: %v", err)
+	}
+	grpc.SetHeader(ctx, metadata.Pairs(ReplayedHeader, "true"))
+	return response, nil
+}
+
+// responseType looks up the response message of a method such as
+// /leaveManagement.leaveManagementSerivce/ApplyLeave in the registry filled
+// by the generated pb package.
+func responseType(fullMethod string) (protoreflect.MessageType, error) {
+	name := strings.TrimPrefix(fullMethod, "/")
+	i := strings.LastIndex(name, "/")
+	if i < 0 {
+		return nil, fmt.Errorf("invalid method name %q", fullMethod)
+	}
+	descriptor, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name[:i]))
+	if err != nil {
+		return nil, err
+	}
+	service, ok := descriptor.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%v is not a service", name[:i])
+	}
+	method := service.Methods().ByName(protoreflect.Name(name[i+1:]))
+	if method == nil {
+		return nil, fmt.Errorf("unknown method %q", fullMethod)
+	}
+	return protoregistry.GlobalTypes.FindMessageByName(method.Output().FullName())
+}
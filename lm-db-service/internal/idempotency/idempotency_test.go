@@ -0,0 +1,182 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"leavemanagement/lm-db-service/pkg/pb"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const applyLeaveMethod = "/leaveManagement.leaveManagementSerivce/ApplyLeave"
+
+type testStore struct {
+	records map[Key]*Record
+}
+
+func (s *testStore) ReserveIdempotencyKey(ctx context.Context, key Key, requestHash string, window time.Duration) (*Record, error) {
+	if record, ok := s.records[key]; ok {
+		return record, nil
+	}
+	s.records[key] = &Record{RequestHash: requestHash}
+	return nil, nil
+}
+
+func (s *testStore) CompleteIdempotencyKey(ctx context.Context, key Key, response []byte) error {
+	s.records[key].Completed = true
+	s.records[key].Response = response
+	return nil
+}
+
+func (s *testStore) ReleaseIdempotencyKey(ctx context.Context, key Key) error {
+	delete(s.records, key)
+	return nil
+}
+
+func withKey(key string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(Header, key))
+}
+
+func TestUnaryServer(t *testing.T) {
+	request := &pb.ApplyLeaveRequest{EmployeeId: "1", LeaveTypeId: "1", FromDate: "2022-04-20", ToDate: "2022-04-21", Comment: "Fever"}
+	otherRequest := &pb.ApplyLeaveRequest{EmployeeId: "1", LeaveTypeId: "1", FromDate: "2022-04-22", ToDate: "2022-04-22", Comment: "Fever"}
+	tests := []struct {
+		description string
+		method      string
+		contexts    []context.Context
+		requests    []*pb.ApplyLeaveRequest
+		handlerErr  error
+		calls       int
+		// code is the status of the last call.
+		code codes.Code
+	}{
+		{
+			description: "retry replays the first response",
+			method:      applyLeaveMethod,
+			contexts:    []context.Context{withKey("a1"), withKey("a1")},
+			requests:    []*pb.ApplyLeaveRequest{request, request},
+			calls:       1,
+			code:        codes.OK,
+		},
+		{
+			description: "without key every call runs",
+			method:      applyLeaveMethod,
+			contexts:    []context.Context{context.Background(), context.Background()},
+			requests:    []*pb.ApplyLeaveRequest{request, request},
+			calls:       2,
+			code:        codes.OK,
+		},
+		{
+			description: "different keys run separately",
+			method:      applyLeaveMethod,
+			contexts:    []context.Context{withKey("a1"), withKey("a2")},
+			requests:    []*pb.ApplyLeaveRequest{request, request},
+			calls:       2,
+			code:        codes.OK,
+		},
+		{
+			description: "key reused for another request",
+			method:      applyLeaveMethod,
+			contexts:    []context.Context{withKey("a1"), withKey("a1")},
+			requests:    []*pb.ApplyLeaveRequest{request, otherRequest},
+			calls:       1,
+			code:        codes.InvalidArgument,
+		},
+		{
+			description: "failed call can be retried",
+			method:      applyLeaveMethod,
+			contexts:    []context.Context{withKey("a1"), withKey("a1")},
+			requests:    []*pb.ApplyLeaveRequest{request, request},
+			handlerErr:  errors.New("leaves not remaining"),
+			calls:       2,
+			code:        codes.Unknown,
+		},
+		{
+			description: "method not idempotent",
+			method:      "/leaveManagement.leaveManagementSerivce/LeavesList",
+			contexts:    []context.Context{withKey("a1"), withKey("a1")},
+			requests:    []*pb.ApplyLeaveRequest{request, request},
+			calls:       2,
+			code:        codes.OK,
+		},
+		{
+			description: "key too long",
+			method:      applyLeaveMethod,
+			contexts:    []context.Context{withKey(strings.Repeat("a", MaxKeyLength+1))},
+			requests:    []*pb.ApplyLeaveRequest{request},
+			calls:       0,
+			code:        codes.InvalidArgument,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			store := &testStore{records: map[Key]*Record{}}
+			interceptor := UnaryServer(store, time.Hour, applyLeaveMethod)
+			calls := 0
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				calls++
+				if test.handlerErr != nil {
+					return nil, test.handlerErr
+				}
+				return &pb.ApplyLeaveResponse{ApplicationId: "4"}, nil
+			}
+			var resp interface{}
+			var err error
+			for i, ctx := range test.contexts {
+				resp, err = interceptor(ctx, test.requests[i], &grpc.UnaryServerInfo{FullMethod: test.method}, handler)
+			}
+			if calls != test.calls {
+				t.Errorf("expected %v: got %v", test.calls, calls)
+			}
+			if status.Code(err) != test.code {
+				t.Errorf("got error %v: want error: %v", err, test.code)
+			}
+			if test.code == codes.OK && !proto.Equal(resp.(proto.Message), &pb.ApplyLeaveResponse{ApplicationId: "4"}) {
+				t.Errorf("expected %v: got %v", "applicationId:4", resp)
+			}
+		})
+	}
+}
+
+func TestUnaryServer_InProgress(t *testing.T) {
+	request := &pb.ApplyLeaveRequest{EmployeeId: "1", LeaveTypeId: "1", FromDate: "2022-04-20", ToDate: "2022-04-21", Comment: "Fever"}
+	requestHash, _ := hashRequest(request)
+	store := &testStore{records: map[Key]*Record{
+		{EmployeeId: "1", Method: applyLeaveMethod, Key: "a1"}: {RequestHash: requestHash},
+	}}
+	interceptor := UnaryServer(store, time.Hour, applyLeaveMethod)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		t.Error("handler called while the first request is in progress")
+		return &pb.ApplyLeaveResponse{}, nil
+	}
+	_, err := interceptor(withKey("a1"), request, &grpc.UnaryServerInfo{FullMethod: applyLeaveMethod}, handler)
+	if status.Code(err) != codes.Aborted {
+		t.Errorf("got error %v: want error: %v", err, codes.Aborted)
+	}
+}
+
+func TestUnaryServer_KeysScopedToCaller(t *testing.T) {
+	store := &testStore{records: map[Key]*Record{}}
+	interceptor := UnaryServer(store, time.Hour, applyLeaveMethod)
+	calls := 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		return &pb.ApplyLeaveResponse{ApplicationId: "4"}, nil
+	}
+	for _, employeeId := range []string{"1", "2"} {
+		request := &pb.ApplyLeaveRequest{EmployeeId: employeeId, LeaveTypeId: "1", FromDate: "2022-04-20", ToDate: "2022-04-21", Comment: "Fever"}
+		if _, err := interceptor(withKey("a1"), request, &grpc.UnaryServerInfo{FullMethod: applyLeaveMethod}, handler); err != nil {
+			t.Errorf("got error %v: want error: %v", err, false)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("expected %v: got %v", 2, calls)
+	}
+}
@@ -0,0 +1,154 @@
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// RequestIdHeader is the metadata key carrying the request id. It is read from
+// incoming metadata when present and echoed back in the response header.
+const RequestIdHeader = "x-request-id"
+
+const redacted = "[REDACTED]"
+
+// sensitiveFields are the lower cased field names whose values never reach
+// the logs.
+var sensitiveFields = map[string]bool{
+	"email":          true,
+	"emailaddress":   true,
+	"email_address":  true,
+	"contactnumber":  true,
+	"contact_number": true,
+	"phone":          true,
+}
+
+// New returns a JSON logger writing to stderr at level, which is one of debug,
+// info, warn or error.
+func New(level string) (*zap.Logger, error) {
+	var zapLevel zapcore.Level
+	if err := zapLevel.UnmarshalText([]byte(level)); err != nil {
+		return nil, err
+	}
+	config := zap.NewProductionConfig()
+	config.Level = zap.NewAtomicLevelAt(zapLevel)
+	config.EncoderConfig.TimeKey = "time"
+	config.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+	return config.Build()
+}
+
+// callerRequest is implemented by every request that identifies the employee
+// making the call.
+type callerRequest interface {
+	GetEmployeeId() string
+}
+
+// UnaryServer logs one entry per unary RPC with the request id, caller id,
+// method, duration and status code. Failed RPCs are logged at warn or error
+// level and the request payload, with personal fields redacted, is added at
+// debug level.
+func UnaryServer(logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		requestId := incomingRequestId(ctx)
+		grpc.SetHeader(ctx, metadata.Pairs(RequestIdHeader, requestId))
+
+		resp, err := handler(ctx, req)
+
+		code := status.Code(err)
+		fields := []zap.Field{
+			zap.String("request_id", requestId),
+			zap.String("rpc", info.FullMethod),
+			zap.Duration("duration", time.Since(start)),
+			zap.String("code", code.String()),
+		}
+		if caller, ok := req.(callerRequest); ok {
+			fields = append(fields, zap.String("caller_id", caller.GetEmployeeId()))
+		}
+		if spanContext := trace.SpanContextFromContext(ctx); spanContext.HasTraceID() {
+			fields = append(fields, zap.String("trace_id", spanContext.TraceID().String()))
+		}
+		if logger.Core().Enabled(zapcore.DebugLevel) {
+			if msg, ok := req.(proto.Message); ok {
+				fields = append(fields, zap.Any("request", Redact(msg)))
+			}
+		}
+		if err != nil {
+			fields = append(fields, zap.String("error", status.Convert(err).Message()))
+		}
+		logger.Check(levelFor(code), "rpc finished").Write(fields...)
+		return resp, err
+	}
+}
+
+// Redact returns msg as a JSON object with every sensitive field replaced.
+func Redact(msg proto.Message) map[string]interface{} {
+	data, err := protojson.Marshal(msg)
+	if err != nil {
+		return nil
+	}
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil
+	}
+	redactValue(fields)
+	return fields
+}
+
+func redactValue(value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if sensitiveFields[strings.ToLower(key)] {
+				v[key] = redacted
+				continue
+			}
+			redactValue(field)
+		}
+	case []interface{}:
+		for _, item := range v {
+			redactValue(item)
+		}
+	}
+}
+
+func incomingRequestId(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(RequestIdHeader); len(values) > 0 && values[0] != "" {
+			return values[0]
+		}
+	}
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(id)
+}
+
+// levelFor logs caller mistakes at warn level and server side failures at
+// error level.
+func levelFor(code codes.Code) zapcore.Level {
+	switch code {
+	case codes.OK:
+		return zapcore.InfoLevel
+	case codes.Canceled, codes.InvalidArgument, codes.NotFound, codes.AlreadyExists,
+		codes.PermissionDenied, codes.FailedPrecondition, codes.Aborted, codes.OutOfRange,
+		codes.Unauthenticated:
+		return zapcore.WarnLevel
+	default:
+		return zapcore.ErrorLevel
+	}
+}
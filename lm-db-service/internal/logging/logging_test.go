@@ -0,0 +1,116 @@
+package logging
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"leavemanagement/lm-db-service/pkg/pb"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestUnaryServer(t *testing.T) {
+	tests := []struct {
+		description string
+		requestId   string
+		err         error
+		level       zapcore.Level
+		code        string
+	}{
+		{
+			description: "success",
+			requestId:   "req-1",
+			level:       zapcore.InfoLevel,
+			code:        "OK",
+		},
+		{
+			description: "caller error",
+			requestId:   "req-2",
+			err:         status.Error(codes.PermissionDenied, "access denied"),
+			level:       zapcore.WarnLevel,
+			code:        "PermissionDenied",
+		},
+		{
+			description: "server error",
+			err:         errors.New("ping to mysql DB failed"),
+			level:       zapcore.ErrorLevel,
+			code:        "Unknown",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			core, logs := observer.New(zapcore.DebugLevel)
+			interceptor := UnaryServer(zap.New(core))
+			ctx := context.Background()
+			if test.requestId != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(RequestIdHeader, test.requestId))
+			}
+			req := &pb.DeleteLeaveRequest{EmployeeId: "7", ApplicationId: "2"}
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return &pb.DeleteLeaveResponse{}, test.err
+			}
+			info := &grpc.UnaryServerInfo{FullMethod: "/leaveManagement.leaveManagementSerivce/DeleteLeave"}
+			if _, err := interceptor(ctx, req, info, handler); err != test.err {
+				t.Errorf("got error %v: want error: %v", err, test.err)
+			}
+			if logs.Len() != 1 {
+				t.Fatalf("expected %v: got %v", 1, logs.Len())
+			}
+			entry := logs.All()[0]
+			if entry.Level != test.level {
+				t.Errorf("expected %v: got %v", test.level, entry.Level)
+			}
+			fields := entry.ContextMap()
+			if fields["code"] != test.code || fields["caller_id"] != "7" || fields["rpc"] != info.FullMethod {
+				t.Errorf("unexpected fields %v", fields)
+			}
+			if test.requestId != "" && fields["request_id"] != test.requestId {
+				t.Errorf("expected %v: got %v", test.requestId, fields["request_id"])
+			}
+			if test.requestId == "" && fields["request_id"] == "" {
+				t.Error("expected a generated request id")
+			}
+		})
+	}
+}
+
+func TestRedact(t *testing.T) {
+	msg, err := structpb.NewStruct(map[string]interface{}{
+		"employeeId":   "7",
+		"emailAddress": "saurabh@example.com",
+		"employees": []interface{}{
+			map[string]interface{}{"contactNumber": "9999999999", "firstName": "Saurabh"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{
+		"employeeId":   "7",
+		"emailAddress": redacted,
+		"employees": []interface{}{
+			map[string]interface{}{"contactNumber": redacted, "firstName": "Saurabh"},
+		},
+	}
+	if actual := Redact(msg); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("expected %v: got %v", expected, actual)
+	}
+}
+
+func TestNew(t *testing.T) {
+	if _, err := New("debug"); err != nil {
+		t.Errorf("got error %v: want error: %v", err, false)
+	}
+	if _, err := New("verbose"); err == nil {
+		t.Errorf("got error %v: want error: %v", err, true)
+	}
+}
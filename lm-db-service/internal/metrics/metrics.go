@@ -0,0 +1,124 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const namespace = "leave_management"
+
+var (
+	rpcRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "requests_total",
+		Help:      "Number of RPCs handled, by method and status code.",
+	}, []string{"method", "code"})
+	rpcDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "request_duration_seconds",
+		Help:      "Time taken to handle RPCs, by method and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "code"})
+	leavesSubmitted = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "applications_submitted_total",
+		Help:      "Number of leave applications submitted, by leave type.",
+	}, []string{"leave_type"})
+	leavesApproved = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "applications_approved_total",
+		Help:      "Number of leave applications approved, by leave type.",
+	}, []string{"leave_type"})
+	leavesRejected = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "applications_rejected_total",
+		Help:      "Number of leave applications rejected, by leave type.",
+	}, []string{"leave_type"})
+)
+
+func init() {
+	prometheus.MustRegister(rpcRequests, rpcDuration, leavesSubmitted, leavesApproved, leavesRejected)
+}
+
+// UnaryServer records the count, latency and status code of every unary RPC.
+func UnaryServer() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		code := status.Code(err).String()
+		rpcRequests.WithLabelValues(info.FullMethod, code).Inc()
+		rpcDuration.WithLabelValues(info.FullMethod, code).Observe(time.Since(start).Seconds())
+		return resp, err
+	}
+}
+
+// LeaveSubmitted counts a new leave application of leaveTypeId.
+func LeaveSubmitted(leaveTypeId string) {
+	leavesSubmitted.WithLabelValues(leaveTypeId).Inc()
+}
+
+// LeaveApproved counts an approval of a leave application of leaveTypeId.
+func LeaveApproved(leaveTypeId string) {
+	leavesApproved.WithLabelValues(leaveTypeId).Inc()
+}
+
+// LeaveRejected counts a rejection of a leave application of leaveTypeId.
+func LeaveRejected(leaveTypeId string) {
+	leavesRejected.WithLabelValues(leaveTypeId).Inc()
+}
+
+// RegisterDB exports the connection pool statistics of db.
+func RegisterDB(db *sql.DB, dbName string) error {
+	return prometheus.Register(collectors.NewDBStatsCollector(db, dbName))
+}
+
+// PendingCounter counts pending applications submitted before now minus
+// olderThan.
+type PendingCounter func(ctx context.Context, olderThan time.Duration) (int, error)
+
+// PendingCollector reports the number of applications that have been waiting
+// for a decision for longer than OlderThan. The database is queried on scrape.
+type PendingCollector struct {
+	Count     PendingCounter
+	OlderThan time.Duration
+	Timeout   time.Duration
+	desc      *prometheus.Desc
+}
+
+func NewPendingCollector(count PendingCounter, olderThanDays int, timeout time.Duration) *PendingCollector {
+	return &PendingCollector{
+		Count:     count,
+		OlderThan: time.Duration(olderThanDays) * 24 * time.Hour,
+		Timeout:   timeout,
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "applications_pending_stale"),
+			"Number of leave applications pending for longer than older_than_days.",
+			nil,
+			prometheus.Labels{"older_than_days": strconv.Itoa(olderThanDays)},
+		),
+	}
+}
+
+func (c *PendingCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *PendingCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.Timeout)
+	defer cancel()
+	count, err := c.Count(ctx, c.OlderThan)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(c.desc, err)
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(count))
+}
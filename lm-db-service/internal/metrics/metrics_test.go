@@ -0,0 +1,99 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryServer(t *testing.T) {
+	tests := []struct {
+		description string
+		method      string
+		err         error
+		code        string
+	}{
+		{
+			description: "success",
+			method:      "/leaveManagement.leaveManagementSerivce/ApplyLeave",
+			code:        "OK",
+		},
+		{
+			description: "status error",
+			method:      "/leaveManagement.leaveManagementSerivce/DeleteLeave",
+			err:         status.Error(codes.PermissionDenied, "access denied"),
+			code:        "PermissionDenied",
+		},
+		{
+			description: "plain error",
+			method:      "/leaveManagement.leaveManagementSerivce/LeavesList",
+			err:         errors.New("access denied"),
+			code:        "Unknown",
+		},
+	}
+	interceptor := UnaryServer()
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			before := testutil.ToFloat64(rpcRequests.WithLabelValues(test.method, test.code))
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, test.err
+			}
+			_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: test.method}, handler)
+			if err != test.err {
+				t.Errorf("got error %v: want error: %v", err, test.err)
+			}
+			after := testutil.ToFloat64(rpcRequests.WithLabelValues(test.method, test.code))
+			if after != before+1 {
+				t.Errorf("expected %v: got %v", before+1, after)
+			}
+		})
+	}
+}
+
+func TestLeaveCounters(t *testing.T) {
+	LeaveSubmitted("2")
+	LeaveApproved("2")
+	LeaveRejected("3")
+	if got := testutil.ToFloat64(leavesSubmitted.WithLabelValues("2")); got != 1 {
+		t.Errorf("expected %v: got %v", 1, got)
+	}
+	if got := testutil.ToFloat64(leavesApproved.WithLabelValues("2")); got != 1 {
+		t.Errorf("expected %v: got %v", 1, got)
+	}
+	if got := testutil.ToFloat64(leavesRejected.WithLabelValues("3")); got != 1 {
+		t.Errorf("expected %v: got %v", 1, got)
+	}
+}
+
+func TestPendingCollector(t *testing.T) {
+	var olderThan time.Duration
+	collector := NewPendingCollector(func(ctx context.Context, d time.Duration) (int, error) {
+		olderThan = d
+		return 4, nil
+	}, 3, time.Second)
+	expected := `
+# HELP leave_management_applications_pending_stale Number of leave applications pending for longer than older_than_days.
+# TYPE leave_management_applications_pending_stale gauge
+leave_management_applications_pending_stale{older_than_days="3"} 4
+`
+	if err := testutil.CollectAndCompare(collector, strings.NewReader(expected)); err != nil {
+		t.Error(err)
+	}
+	if olderThan != 72*time.Hour {
+		t.Errorf("expected %v: got %v", 72*time.Hour, olderThan)
+	}
+
+	failing := NewPendingCollector(func(ctx context.Context, d time.Duration) (int, error) {
+		return 0, errors.New("error")
+	}, 3, time.Second)
+	if err := testutil.CollectAndCompare(failing, strings.NewReader(expected)); err == nil {
+		t.Errorf("got error %v: want error: %v", err, true)
+	}
+}
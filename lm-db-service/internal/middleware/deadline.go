@@ -0,0 +1,29 @@
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// UnaryDeadline applies a server side deadline to every unary RPC. The timeout
+// is looked up by full method name in perMethod and falls back to
+// defaultTimeout. A deadline sent by the client is kept when it is earlier.
+func UnaryDeadline(defaultTimeout time.Duration, perMethod map[string]time.Duration) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		timeout, ok := perMethod[info.FullMethod]
+		if !ok {
+			timeout = defaultTimeout
+		}
+		if timeout <= 0 {
+			return handler(ctx, req)
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= timeout {
+			return handler(ctx, req)
+		}
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return handler(ctx, req)
+	}
+}
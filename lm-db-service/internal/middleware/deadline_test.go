@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+func TestUnaryDeadline(t *testing.T) {
+	tests := []struct {
+		description    string
+		method         string
+		clientTimeout  time.Duration
+		expectDeadline bool
+		maxRemaining   time.Duration
+	}{
+		{
+			description:    "default timeout",
+			method:         "/leaveManagement.leaveManagementSerivce/ApplyLeave",
+			expectDeadline: true,
+			maxRemaining:   time.Second,
+		},
+		{
+			description:    "per method timeout",
+			method:         "/leaveManagement.leaveManagementSerivce/LeavesList",
+			expectDeadline: true,
+			maxRemaining:   3 * time.Second,
+		},
+		{
+			description:    "earlier client deadline kept",
+			method:         "/leaveManagement.leaveManagementSerivce/LeavesList",
+			clientTimeout:  100 * time.Millisecond,
+			expectDeadline: true,
+			maxRemaining:   100 * time.Millisecond,
+		},
+		{
+			description:    "disabled for method",
+			method:         "/leaveManagement.leaveManagementSerivce/DeleteLeave",
+			expectDeadline: false,
+		},
+	}
+	interceptor := UnaryDeadline(time.Second, map[string]time.Duration{
+		"/leaveManagement.leaveManagementSerivce/LeavesList":  3 * time.Second,
+		"/leaveManagement.leaveManagementSerivce/DeleteLeave": 0,
+	})
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			ctx := context.Background()
+			if test.clientTimeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, test.clientTimeout)
+				defer cancel()
+			}
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				deadline, ok := ctx.Deadline()
+				if ok != test.expectDeadline {
+					t.Errorf("got deadline %v: want deadline: %v", ok, test.expectDeadline)
+				}
+				if ok && time.Until(deadline) > test.maxRemaining {
+					t.Errorf("got remaining %v: want at most: %v", time.Until(deadline), test.maxRemaining)
+				}
+				return nil, nil
+			}
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: test.method}, handler)
+			if err != nil {
+				t.Errorf("got error %v: want error: %v", err, false)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package policy
+
+import (
+	"sort"
+	"time"
+)
+
+// Credit is a comp-off day earned by working on a weekend or holiday. It can
+// be spent on leave starting after the day worked and on or before the day it
+// expires.
+type Credit struct {
+	Id         string
+	WorkedDate time.Time
+	ExpiresOn  time.Time
+}
+
+// Spend is comp-off leave to be paid for with credits.
+type Spend struct {
+	// Dates are the days counted as leave, in order.
+	Dates []time.Time
+	// Pending is set while the leave awaits a decision: the credits it takes
+	// are reserved for it but not used yet.
+	Pending bool
+}
+
+// Allocation is the outcome of AllocateCredits.
+type Allocation struct {
+	// Used are the ids of the credits spent.
+	Used map[string]bool
+	// Reserved are the ids of the credits of Used spent on pending leave.
+	Reserved map[string]bool
+	// Uncovered is the number of days of leave no credit was left for.
+	Uncovered int
+}
+
+// AllocateCredits spends the credits on the leave in order of from date,
+// each day of leave taking the credit that expires first among those earned
+// before the leave and not expired on that day.
+func AllocateCredits(credits []Credit, spends []Spend) Allocation {
+	credits = append([]Credit(nil), credits...)
+	sort.SliceStable(credits, func(i, j int) bool {
+		return credits[i].ExpiresOn.Before(credits[j].ExpiresOn)
+	})
+	spends = append([]Spend(nil), spends...)
+	sort.SliceStable(spends, func(i, j int) bool {
+		return len(spends[j].Dates) > 0 && (len(spends[i].Dates) == 0 || spends[i].Dates[0].Before(spends[j].Dates[0]))
+	})
+	allocation := Allocation{Used: map[string]bool{}, Reserved: map[string]bool{}}
+	for _, spend := range spends {
+		for _, date := range spend.Dates {
+			covered := false
+			for _, credit := range credits {
+				if allocation.Used[credit.Id] || !credit.WorkedDate.Before(spend.Dates[0]) || credit.ExpiresOn.Before(date) {
+					continue
+				}
+				allocation.Used[credit.Id] = true
+				if spend.Pending {
+					allocation.Reserved[credit.Id] = true
+				}
+				covered = true
+				break
+			}
+			if !covered {
+				allocation.Uncovered++
+			}
+		}
+	}
+	return allocation
+}
+
+// Available returns the credits left unused that can still be spent on leave
+// starting on date.
+func (a Allocation) Available(credits []Credit, date time.Time) int {
+	available := 0
+	for _, credit := range credits {
+		if !a.Used[credit.Id] && !credit.ExpiresOn.Before(date) {
+			available++
+		}
+	}
+	return available
+}
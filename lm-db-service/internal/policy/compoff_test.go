@@ -0,0 +1,111 @@
+package policy
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// days returns n consecutive dates from from.
+func days(from string, n int) []time.Time {
+	var dates []time.Time
+	for i := 0; i < n; i++ {
+		dates = append(dates, date(from).AddDate(0, 0, i))
+	}
+	return dates
+}
+
+func TestAllocateCredits(t *testing.T) {
+	credits := []Credit{
+		{Id: "1", WorkedDate: date("2022-04-02"), ExpiresOn: date("2022-05-02")},
+		{Id: "2", WorkedDate: date("2022-04-09"), ExpiresOn: date("2022-05-09")},
+		{Id: "3", WorkedDate: date("2022-03-26"), ExpiresOn: date("2022-04-25")},
+	}
+	tests := []struct {
+		description string
+		spends      []Spend
+		used        map[string]bool
+		reserved    map[string]bool
+		uncovered   int
+		available   int
+	}{
+		{
+			description: "nothing spent",
+			used:        map[string]bool{},
+			available:   3,
+		},
+		{
+			description: "pending leave reserves credits",
+			spends: []Spend{
+				{Dates: days("2022-04-20", 1), Pending: true},
+				{Dates: days("2022-04-22", 1)},
+			},
+			used:      map[string]bool{"3": true, "1": true},
+			reserved:  map[string]bool{"3": true},
+			available: 1,
+		},
+		{
+			description: "first expiring first",
+			spends:      []Spend{{Dates: days("2022-04-20", 2)}},
+			used:        map[string]bool{"3": true, "1": true},
+			available:   1,
+		},
+		{
+			description: "expired credit skipped",
+			spends:      []Spend{{Dates: days("2022-04-28", 1)}},
+			used:        map[string]bool{"1": true},
+			available:   2,
+		},
+		{
+			description: "credit earned after the leave skipped",
+			spends:      []Spend{{Dates: days("2022-04-05", 3)}},
+			used:        map[string]bool{"3": true, "1": true},
+			uncovered:   1,
+			available:   1,
+		},
+		{
+			description: "earlier leave served first",
+			spends: []Spend{
+				{Dates: days("2022-05-05", 1)},
+				{Dates: days("2022-04-28", 2)},
+			},
+			used:      map[string]bool{"1": true, "2": true},
+			uncovered: 1,
+			available: 1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			allocation := AllocateCredits(credits, test.spends)
+			if !reflect.DeepEqual(allocation.Used, test.used) {
+				t.Errorf("expected %v: got %v", test.used, allocation.Used)
+			}
+			if test.reserved == nil {
+				test.reserved = map[string]bool{}
+			}
+			if !reflect.DeepEqual(allocation.Reserved, test.reserved) {
+				t.Errorf("expected %v: got %v", test.reserved, allocation.Reserved)
+			}
+			if allocation.Uncovered != test.uncovered {
+				t.Errorf("expected %v: got %v", test.uncovered, allocation.Uncovered)
+			}
+			if available := allocation.Available(credits, date("2022-04-20")); available != test.available {
+				t.Errorf("expected %v: got %v", test.available, available)
+			}
+		})
+	}
+}
+
+func TestAllocateCreditsExpiringDuringLeave(t *testing.T) {
+	credits := []Credit{
+		{Id: "1", WorkedDate: date("2022-03-26"), ExpiresOn: date("2022-04-25")},
+		{Id: "2", WorkedDate: date("2022-03-27"), ExpiresOn: date("2022-04-25")},
+	}
+	allocation := AllocateCredits(credits, []Spend{{Dates: days("2022-04-25", 2)}})
+	if expected := map[string]bool{"1": true}; !reflect.DeepEqual(allocation.Used, expected) {
+		t.Errorf("expected %v: got %v", expected, allocation.Used)
+	}
+	if allocation.Uncovered != 1 {
+		t.Errorf("expected %v: got %v", 1, allocation.Uncovered)
+	}
+}
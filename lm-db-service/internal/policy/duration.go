@@ -0,0 +1,143 @@
+package policy
+
+import "time"
+
+// Kinds of day in a Duration.
+const (
+	DayWorking = "WORKING"
+	DayWeekend = "WEEKEND"
+	DayHoliday = "HOLIDAY"
+)
+
+// Day is a day of a Duration and whether it is counted as leave.
+type Day struct {
+	Date    time.Time
+	Kind    string
+	Counted bool
+}
+
+// Duration breaks down the days an application counts as leave.
+type Duration struct {
+	// Days are the days of the application in order, preceded and followed
+	// by the weekends and holidays sandwiched between it and the
+	// applications adjoining it.
+	Days []Day
+	// SandwichBefore and SandwichAfter are the days counted before the from
+	// date and after the to date.
+	SandwichBefore int
+	SandwichAfter  int
+}
+
+// Counted returns the number of days counted as leave.
+func (d Duration) Counted() int {
+	counted := 0
+	for _, day := range d.Days {
+		if day.Counted {
+			counted++
+		}
+	}
+	return counted
+}
+
+// CountedDates returns the dates of the days counted as leave.
+func (d Duration) CountedDates() []time.Time {
+	var dates []time.Time
+	for _, day := range d.Days {
+		if day.Counted {
+			dates = append(dates, day.Date)
+		}
+	}
+	return dates
+}
+
+// LeaveDates returns the days a saved application counted as leave: those of
+// its own dates counted by CountDays and the weekends and holidays it counted
+// before its from date and after its to date.
+func LeaveDates(p Policy, leave Leave, holidays map[string]string) []time.Time {
+	var dates []time.Time
+	for i := leave.SandwichBefore; i > 0; i-- {
+		dates = append(dates, leave.FromDate.Add(-time.Duration(i)*day))
+	}
+	dates = append(dates, CountDays(p, Application{Period: leave.Period}, holidays).CountedDates()...)
+	for i := 1; i <= leave.SandwichAfter; i++ {
+		dates = append(dates, leave.ToDate.Add(time.Duration(i)*day))
+	}
+	return dates
+}
+
+// CountDays returns the days of the application counted as leave. Every day
+// counts unless the policy counts working days only. Then under the sandwich
+// rule a weekend or holiday counts too when leave is taken on both sides of
+// it, in this application or in an adjoining one that has not counted it
+// already. holidays holds the holiday dates formatted as YYYY-MM-DD.
+func CountDays(p Policy, a Application, holidays map[string]string) Duration {
+	kind := func(date time.Time) string {
+		if _, ok := holidays[date.Format("2006-01-02")]; ok {
+			return DayHoliday
+		}
+		if date.Weekday() == time.Saturday || date.Weekday() == time.Sunday {
+			return DayWeekend
+		}
+		return DayWorking
+	}
+	duration := Duration{}
+	first, last := -1, -1
+	for date := a.FromDate; !date.After(a.ToDate); date = date.Add(day) {
+		d := Day{Date: date, Kind: kind(date), Counted: !p.WorkingDays || kind(date) == DayWorking}
+		if d.Counted {
+			if first < 0 {
+				first = len(duration.Days)
+			}
+			last = len(duration.Days)
+		}
+		duration.Days = append(duration.Days, d)
+	}
+	if !p.WorkingDays || !p.Sandwich || first < 0 {
+		return duration
+	}
+	for i := first; i <= last; i++ {
+		duration.Days[i].Counted = true
+	}
+
+	// Weekends and holidays from the from date back to an adjoining
+	// application.
+	var gap []Day
+	date := a.FromDate.Add(-day)
+	for kind(date) != DayWorking && a.covering(date) == nil {
+		gap = append([]Day{{Date: date, Kind: kind(date), Counted: true}}, gap...)
+		date = date.Add(-day)
+	}
+	if other := a.covering(date); other != nil && (len(gap) == 0 || other.SandwichAfter == 0) {
+		for i := 0; i < first; i++ {
+			duration.Days[i].Counted = true
+		}
+		duration.Days = append(gap, duration.Days...)
+		duration.SandwichBefore = len(gap)
+	}
+
+	// And from the to date forward.
+	gap = nil
+	date = a.ToDate.Add(day)
+	for kind(date) != DayWorking && a.covering(date) == nil {
+		gap = append(gap, Day{Date: date, Kind: kind(date), Counted: true})
+		date = date.Add(day)
+	}
+	if other := a.covering(date); other != nil && (len(gap) == 0 || other.SandwichBefore == 0) {
+		for i := duration.SandwichBefore + last + 1; i < len(duration.Days); i++ {
+			duration.Days[i].Counted = true
+		}
+		duration.Days = append(duration.Days, gap...)
+		duration.SandwichAfter = len(gap)
+	}
+	return duration
+}
+
+// covering returns the other application on leave on date, or nil.
+func (a Application) covering(date time.Time) *Leave {
+	for i, other := range a.Others {
+		if !date.Before(other.FromDate) && !date.After(other.ToDate) {
+			return &a.Others[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package policy
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCountDays(t *testing.T) {
+	workingDays := Policy{WorkingDays: true}
+	sandwich := Policy{WorkingDays: true, Sandwich: true}
+	holidays := map[string]string{"2022-04-14": "Ambedkar Jayanti"}
+	tests := []struct {
+		description    string
+		policy         Policy
+		application    Application
+		counted        int
+		days           int
+		sandwichBefore int
+		sandwichAfter  int
+	}{
+		{
+			description: "calendar days",
+			policy:      Policy{},
+			application: Application{Period: Period{date("2022-04-13"), date("2022-04-18")}},
+			counted:     6,
+			days:        6,
+		},
+		{
+			description: "sandwich without working days",
+			policy:      Policy{Sandwich: true},
+			application: Application{
+				Period: Period{date("2022-04-18"), date("2022-04-19")},
+				Others: []Leave{{Period: Period{date("2022-04-15"), date("2022-04-15")}}},
+			},
+			counted: 2,
+			days:    2,
+		},
+		{
+			description: "weekend not counted",
+			policy:      workingDays,
+			application: Application{Period: Period{date("2022-04-15"), date("2022-04-18")}},
+			counted:     2,
+			days:        4,
+		},
+		{
+			description: "weekend and holiday sandwiched",
+			policy:      sandwich,
+			application: Application{Period: Period{date("2022-04-13"), date("2022-04-18")}},
+			counted:     6,
+			days:        6,
+		},
+		{
+			description: "trailing weekend not sandwiched",
+			policy:      sandwich,
+			application: Application{Period: Period{date("2022-04-15"), date("2022-04-17")}},
+			counted:     1,
+			days:        3,
+		},
+		{
+			description: "weekend between two applications",
+			policy:      sandwich,
+			application: Application{
+				Period: Period{date("2022-04-18"), date("2022-04-19")},
+				Others: []Leave{{Period: Period{date("2022-04-15"), date("2022-04-15")}}},
+			},
+			counted:        4,
+			days:           4,
+			sandwichBefore: 2,
+		},
+		{
+			description: "weekend already counted by the next application",
+			policy:      sandwich,
+			application: Application{
+				Period: Period{date("2022-04-15"), date("2022-04-15")},
+				Others: []Leave{{Period: Period{date("2022-04-18"), date("2022-04-19")}, SandwichBefore: 2}},
+			},
+			counted: 1,
+			days:    1,
+		},
+		{
+			description: "weekend starting the application after another",
+			policy:      sandwich,
+			application: Application{
+				Period: Period{date("2022-04-16"), date("2022-04-18")},
+				Others: []Leave{{Period: Period{date("2022-04-15"), date("2022-04-15")}, SandwichAfter: 5}},
+			},
+			counted: 3,
+			days:    3,
+		},
+		{
+			description: "working day before the next application",
+			policy:      sandwich,
+			application: Application{
+				Period: Period{date("2022-04-12"), date("2022-04-13")},
+				Others: []Leave{{Period: Period{date("2022-04-18"), date("2022-04-18")}}},
+			},
+			counted:       2,
+			days:          2,
+			sandwichAfter: 0,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			actual := CountDays(test.policy, test.application, holidays)
+			if actual.Counted() != test.counted {
+				t.Errorf("expected %v: got %v", test.counted, actual.Counted())
+			}
+			if len(actual.Days) != test.days {
+				t.Errorf("expected %v: got %v", test.days, len(actual.Days))
+			}
+			if actual.SandwichBefore != test.sandwichBefore || actual.SandwichAfter != test.sandwichAfter {
+				t.Errorf("expected %v, %v: got %v, %v", test.sandwichBefore, test.sandwichAfter, actual.SandwichBefore, actual.SandwichAfter)
+			}
+		})
+	}
+}
+
+func TestLeaveDates(t *testing.T) {
+	leave := Leave{Period: Period{date("2022-04-18"), date("2022-04-19")}, SandwichBefore: 2}
+	expected := []time.Time{date("2022-04-16"), date("2022-04-17"), date("2022-04-18"), date("2022-04-19")}
+	if actual := LeaveDates(Policy{WorkingDays: true, Sandwich: true}, leave, nil); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v: got %v", expected, actual)
+	}
+}
@@ -0,0 +1,114 @@
+package policy
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Names of the eligibility rules, reported as the type of each violation.
+const (
+	RuleGender         = "GENDER"
+	RuleDesignation    = "DESIGNATION"
+	RuleMinTenure      = "MIN_TENURE"
+	RuleEmploymentType = "EMPLOYMENT_TYPE"
+	RuleProbation      = "PROBATION"
+)
+
+// Eligibility limits who may take a leave type at all. An empty setting is
+// not enforced.
+type Eligibility struct {
+	Gender          *string
+	DesignationIds  []string
+	MinTenureDays   *int
+	EmploymentTypes []string
+	// ExcludeProbation keeps employees still on probation out.
+	ExcludeProbation bool
+}
+
+// Restricted reports whether any setting is enforced.
+func (e Eligibility) Restricted() bool {
+	return e.Gender != nil || len(e.DesignationIds) > 0 || e.MinTenureDays != nil ||
+		len(e.EmploymentTypes) > 0 || e.ExcludeProbation
+}
+
+// Employee is what the eligibility rules know of an employee. A nil date is
+// not known.
+type Employee struct {
+	Gender           string
+	DesignationId    string
+	JoiningDate      *time.Time
+	EmploymentType   string
+	ProbationEndDate *time.Time
+}
+
+// EligibilityRule checks one setting of an Eligibility for an employee on a
+// day and returns nil when the employee satisfies it.
+type EligibilityRule func(e Eligibility, employee Employee, on time.Time) *Violation
+
+// EligibilityRules are evaluated in order by CheckEligibility.
+var EligibilityRules = []EligibilityRule{
+	checkGender,
+	checkDesignation,
+	checkMinTenure,
+	checkEmploymentType,
+	checkProbation,
+}
+
+// CheckEligibility returns every eligibility rule the employee breaks on the
+// day on.
+func CheckEligibility(e Eligibility, employee Employee, on time.Time) []Violation {
+	var violations []Violation
+	for _, rule := range EligibilityRules {
+		if violation := rule(e, employee, on); violation != nil {
+			violations = append(violations, *violation)
+		}
+	}
+	return violations
+}
+
+func checkGender(e Eligibility, employee Employee, on time.Time) *Violation {
+	if e.Gender == nil || employee.Gender == *e.Gender {
+		return nil
+	}
+	return &Violation{Rule: RuleGender, Description: "leave type is not available for the gender of the employee"}
+}
+
+func checkDesignation(e Eligibility, employee Employee, on time.Time) *Violation {
+	if len(e.DesignationIds) == 0 || contains(e.DesignationIds, employee.DesignationId) {
+		return nil
+	}
+	return &Violation{Rule: RuleDesignation, Description: "leave type is not available for the designation of the employee"}
+}
+
+func checkMinTenure(e Eligibility, employee Employee, on time.Time) *Violation {
+	if e.MinTenureDays == nil {
+		return nil
+	}
+	if employee.JoiningDate != nil && !on.Before(employee.JoiningDate.Add(time.Duration(*e.MinTenureDays)*day)) {
+		return nil
+	}
+	return &Violation{Rule: RuleMinTenure, Description: fmt.Sprintf("leave type is available after %d days of service", *e.MinTenureDays)}
+}
+
+func checkEmploymentType(e Eligibility, employee Employee, on time.Time) *Violation {
+	if len(e.EmploymentTypes) == 0 || contains(e.EmploymentTypes, employee.EmploymentType) {
+		return nil
+	}
+	return &Violation{Rule: RuleEmploymentType, Description: fmt.Sprintf("leave type is only available for %s employment", strings.Join(e.EmploymentTypes, ", "))}
+}
+
+func checkProbation(e Eligibility, employee Employee, on time.Time) *Violation {
+	if !e.ExcludeProbation || employee.ProbationEndDate == nil || on.After(*employee.ProbationEndDate) {
+		return nil
+	}
+	return &Violation{Rule: RuleProbation, Description: "leave type is not available during probation"}
+}
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
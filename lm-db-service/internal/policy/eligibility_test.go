@@ -0,0 +1,84 @@
+package policy
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCheckEligibility(t *testing.T) {
+	female := "1"
+	joined := date("2022-01-10")
+	probationEnds := date("2022-07-09")
+	employee := Employee{
+		Gender:           "0",
+		DesignationId:    "1",
+		JoiningDate:      &joined,
+		EmploymentType:   "PERMANENT",
+		ProbationEndDate: &probationEnds,
+	}
+	tests := []struct {
+		description string
+		eligibility Eligibility
+		employee    Employee
+		on          time.Time
+		expected    []string
+	}{
+		{
+			description: "no settings",
+			employee:    employee,
+			on:          date("2022-04-20"),
+		},
+		{
+			description: "maternity",
+			eligibility: Eligibility{Gender: &female, MinTenureDays: limit(80)},
+			employee:    employee,
+			on:          date("2022-04-20"),
+			expected:    []string{RuleGender},
+		},
+		{
+			description: "tenure reached on the day",
+			eligibility: Eligibility{MinTenureDays: limit(100)},
+			employee:    employee,
+			on:          date("2022-04-20"),
+		},
+		{
+			description: "tenure not reached",
+			eligibility: Eligibility{MinTenureDays: limit(101)},
+			employee:    employee,
+			on:          date("2022-04-20"),
+			expected:    []string{RuleMinTenure},
+		},
+		{
+			description: "joining date unknown",
+			eligibility: Eligibility{MinTenureDays: limit(1)},
+			employee:    Employee{},
+			on:          date("2022-04-20"),
+			expected:    []string{RuleMinTenure},
+		},
+		{
+			description: "on probation, wrong designation and employment type",
+			eligibility: Eligibility{DesignationIds: []string{"2", "3"}, EmploymentTypes: []string{"CONTRACT"}, ExcludeProbation: true},
+			employee:    employee,
+			on:          date("2022-07-09"),
+			expected:    []string{RuleDesignation, RuleEmploymentType, RuleProbation},
+		},
+		{
+			description: "after probation",
+			eligibility: Eligibility{DesignationIds: []string{"1"}, EmploymentTypes: []string{"PERMANENT"}, ExcludeProbation: true},
+			employee:    employee,
+			on:          date("2022-07-10"),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			var actual []string
+			for _, violation := range CheckEligibility(test.eligibility, test.employee, test.on) {
+				actual = append(actual, violation.Rule)
+			}
+			if !reflect.DeepEqual(actual, test.expected) {
+				t.Errorf("expected %v: got %v", test.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package policy
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// EncashmentRate works out the pay for an encashed day from the monthly
+// salary: Percent of it divided by Divisor, such as 100% over 30 days.
+// Amounts are in hundredths of the currency unit.
+type EncashmentRate struct {
+	Percent int
+	Divisor int
+}
+
+// DayRate returns the pay for one encashed day, rounded half up.
+func (r EncashmentRate) DayRate(monthlySalary int64) (int64, error) {
+	if r.Divisor <= 0 {
+		return 0, errors.New("encash rate divisor must be greater than 0")
+	}
+	scaled := monthlySalary * int64(r.Percent)
+	divisor := int64(100 * r.Divisor)
+	return (scaled + divisor/2) / divisor, nil
+}
+
+// Formula describes how dayRate was worked out from monthlySalary.
+func (r EncashmentRate) Formula(monthlySalary, dayRate int64) string {
+	return fmt.Sprintf("%s x %d%% / %d = %s per day", FormatAmount(monthlySalary), r.Percent, r.Divisor, FormatAmount(dayRate))
+}
+
+// ParseAmount parses a decimal amount with up to two decimals, such as
+// 52000.5, into hundredths.
+func ParseAmount(value string) (int64, error) {
+	units, fraction, found := strings.Cut(value, ".")
+	if units == "" || len(fraction) > 2 || (found && fraction == "") {
+		return 0, errors.New("invalid amount")
+	}
+	fraction += strings.Repeat("0", 2-len(fraction))
+	amount, err := strconv.ParseInt(units+fraction, 10, 64)
+	if err != nil || amount < 0 {
+		return 0, errors.New("invalid amount")
+	}
+	return amount, nil
+}
+
+// FormatAmount formats hundredths with two decimals.
+func FormatAmount(amount int64) string {
+	return fmt.Sprintf("%d.%02d", amount/100, amount%100)
+}
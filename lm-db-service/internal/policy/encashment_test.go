@@ -0,0 +1,82 @@
+package policy
+
+import "testing"
+
+func TestEncashmentRate_DayRate(t *testing.T) {
+	tests := []struct {
+		description   string
+		rate          EncashmentRate
+		monthlySalary int64
+		expected      int64
+		formula       string
+		isError       bool
+	}{
+		{
+			description:   "full salary over 30 days",
+			rate:          EncashmentRate{Percent: 100, Divisor: 30},
+			monthlySalary: 4500000,
+			expected:      150000,
+			formula:       "45000.00 x 100% / 30 = 1500.00 per day",
+		},
+		{
+			description:   "basic over 26 days rounded half up",
+			rate:          EncashmentRate{Percent: 40, Divisor: 26},
+			monthlySalary: 5000050,
+			expected:      76924,
+			formula:       "50000.50 x 40% / 26 = 769.24 per day",
+		},
+		{
+			description:   "no divisor",
+			rate:          EncashmentRate{Percent: 100, Divisor: 0},
+			monthlySalary: 4500000,
+			isError:       true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			actual, err := test.rate.DayRate(test.monthlySalary)
+			if test.isError {
+				if err == nil {
+					t.Errorf("got error %v: want error: %v", err, true)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("got error %v: want error: %v", err, false)
+			}
+			if actual != test.expected {
+				t.Errorf("expected %v: got %v", test.expected, actual)
+			}
+			if formula := test.rate.Formula(test.monthlySalary, actual); formula != test.formula {
+				t.Errorf("expected %v: got %v", test.formula, formula)
+			}
+		})
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected int64
+		isError  bool
+	}{
+		{value: "52000", expected: 5200000},
+		{value: "52000.5", expected: 5200050},
+		{value: "52000.05", expected: 5200005},
+		{value: "52000.055", isError: true},
+		{value: "52000.", isError: true},
+		{value: "-1", isError: true},
+		{value: "", isError: true},
+	}
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			actual, err := ParseAmount(test.value)
+			if test.isError != (err != nil) {
+				t.Errorf("got error %v: want error: %v", err, test.isError)
+			}
+			if actual != test.expected {
+				t.Errorf("expected %v: got %v", test.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package policy
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Names of the rules, reported as the type of each violation.
+const (
+	RuleDateOrder          = "DATE_ORDER"
+	RuleBackdating         = "BACKDATING"
+	RuleMinNotice          = "MIN_NOTICE"
+	RuleMinDaysPerRequest  = "MIN_DAYS_PER_REQUEST"
+	RuleMaxDaysPerRequest  = "MAX_DAYS_PER_REQUEST"
+	RuleMaxConsecutiveDays = "MAX_CONSECUTIVE_DAYS"
+)
+
+const day = 24 * time.Hour
+
+// Policy holds the settings of a leave type. A nil limit is not enforced.
+type Policy struct {
+	MinNoticeDays      *int
+	MaxConsecutiveDays *int
+	MinDaysPerRequest  *int
+	MaxDaysPerRequest  *int
+	AllowBackdated     bool
+	// WorkingDays counts only the working days of an application as leave.
+	// Otherwise every calendar day from the from date to the to date counts.
+	WorkingDays bool
+	// Sandwich counts weekends and holidays between two days of leave as
+	// leave. It applies with WorkingDays only.
+	Sandwich bool
+	// CompOffValidityDays is set for the comp-off leave type, whose balance
+	// is the credits earned by working on weekends and holidays, each
+	// expiring this many days after the day worked.
+	CompOffValidityDays *int
+	Eligibility
+}
+
+// Period is a range of days, both included.
+type Period struct {
+	FromDate time.Time
+	ToDate   time.Time
+}
+
+// Leave is another application of the employee.
+type Leave struct {
+	Period
+	// SandwichBefore and SandwichAfter are the weekends and holidays it
+	// counted before its from date and after its to date.
+	SandwichBefore int
+	SandwichAfter  int
+}
+
+// Application is a leave request checked against a Policy. Dates are
+// midnights in the same location.
+type Application struct {
+	Period
+	// AppliedOn is the day the application is made or changed.
+	AppliedOn time.Time
+	// Employee is the applicant, checked against the eligibility settings
+	// on the from date.
+	Employee Employee
+	// Others are the other applications of the employee for the same leave
+	// type, used to find leave taken back to back and the weekends and
+	// holidays sandwiched between applications.
+	Others []Leave
+}
+
+// Days returns the number of days of the application.
+func (a Application) Days() int {
+	return int(a.ToDate.Sub(a.FromDate)/day) + 1
+}
+
+// ConsecutiveDays returns the days of the application together with the
+// other applications adjoining it without a gap.
+func (a Application) ConsecutiveDays() int {
+	fromDate, toDate := a.FromDate, a.ToDate
+	for extended := true; extended; {
+		extended = false
+		for _, other := range a.Others {
+			if !other.FromDate.Before(fromDate) && !other.ToDate.After(toDate) {
+				continue
+			}
+			if other.ToDate.Before(fromDate.Add(-day)) || other.FromDate.After(toDate.Add(day)) {
+				continue
+			}
+			if other.FromDate.Before(fromDate) {
+				fromDate = other.FromDate
+			}
+			if other.ToDate.After(toDate) {
+				toDate = other.ToDate
+			}
+			extended = true
+		}
+	}
+	return int(toDate.Sub(fromDate)/day) + 1
+}
+
+// Violation is a rule an application breaks.
+type Violation struct {
+	Rule        string
+	Description string
+}
+
+// Rule checks one setting of a policy and returns nil when the application
+// satisfies it.
+type Rule func(p Policy, a Application) *Violation
+
+// Rules are evaluated in order by Evaluate.
+var Rules = []Rule{
+	checkBackdating,
+	checkMinNotice,
+	checkMinDaysPerRequest,
+	checkMaxDaysPerRequest,
+	checkMaxConsecutiveDays,
+}
+
+// Evaluate returns every eligibility and policy rule the application breaks.
+// An application ending before it starts breaks no other rule.
+func Evaluate(p Policy, a Application) []Violation {
+	if a.ToDate.Before(a.FromDate) {
+		return []Violation{{Rule: RuleDateOrder, Description: "to date is before from date"}}
+	}
+	violations := CheckEligibility(p.Eligibility, a.Employee, a.FromDate)
+	for _, rule := range Rules {
+		if violation := rule(p, a); violation != nil {
+			violations = append(violations, *violation)
+		}
+	}
+	return violations
+}
+
+func checkBackdating(p Policy, a Application) *Violation {
+	if p.AllowBackdated || !a.FromDate.Before(a.AppliedOn) {
+		return nil
+	}
+	return &Violation{Rule: RuleBackdating, Description: "leave cannot start in the past"}
+}
+
+func checkMinNotice(p Policy, a Application) *Violation {
+	if p.MinNoticeDays == nil || !a.FromDate.Before(a.AppliedOn.Add(time.Duration(*p.MinNoticeDays)*day)) {
+		return nil
+	}
+	return &Violation{Rule: RuleMinNotice, Description: fmt.Sprintf("leave must be applied for at least %d days in advance", *p.MinNoticeDays)}
+}
+
+func checkMinDaysPerRequest(p Policy, a Application) *Violation {
+	if p.MinDaysPerRequest == nil || a.Days() >= *p.MinDaysPerRequest {
+		return nil
+	}
+	return &Violation{Rule: RuleMinDaysPerRequest, Description: fmt.Sprintf("leave must be at least %d days", *p.MinDaysPerRequest)}
+}
+
+func checkMaxDaysPerRequest(p Policy, a Application) *Violation {
+	if p.MaxDaysPerRequest == nil || a.Days() <= *p.MaxDaysPerRequest {
+		return nil
+	}
+	return &Violation{Rule: RuleMaxDaysPerRequest, Description: fmt.Sprintf("leave must be at most %d days", *p.MaxDaysPerRequest)}
+}
+
+func checkMaxConsecutiveDays(p Policy, a Application) *Violation {
+	if p.MaxConsecutiveDays == nil || a.ConsecutiveDays() <= *p.MaxConsecutiveDays {
+		return nil
+	}
+	return &Violation{Rule: RuleMaxConsecutiveDays, Description: fmt.Sprintf("leave cannot exceed %d consecutive days", *p.MaxConsecutiveDays)}
+}
+
+// ViolationError reports every rule an application breaks. It converts to a
+// FAILED_PRECONDITION status carrying one PreconditionFailure violation per
+// rule.
+type ViolationError struct {
+	Violations []Violation
+}
+
+func (e *ViolationError) Error() string {
+	descriptions := make([]string, len(e.Violations))
+	for i, violation := range e.Violations {
+		descriptions[i] = violation.Description
+	}
+	return "leave policy violated: " + strings.Join(descriptions, "; ")
+}
+
+func (e *ViolationError) GRPCStatus() *status.Status {
+	st := status.New(codes.FailedPrecondition, e.Error())
+	failure := &errdetails.PreconditionFailure{}
+	for _, violation := range e.Violations {
+		failure.Violations = append(failure.Violations, &errdetails.PreconditionFailure_Violation{
+			Type:        violation.Rule,
+			Subject:     "leave application",
+			Description: violation.Description,
+		})
+	}
+	withDetails, err := st.WithDetails(failure)
+	if err != nil {
+		return st
+	}
+	return withDetails
+}
@@ -0,0 +1,118 @@
+package policy
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func date(value string) time.Time {
+	parsed, _ := time.Parse("2006-01-02", value)
+	return parsed
+}
+
+func limit(days int) *int {
+	return &days
+}
+
+func TestEvaluate(t *testing.T) {
+	annual := Policy{
+		MinNoticeDays:      limit(14),
+		MaxConsecutiveDays: limit(15),
+		MinDaysPerRequest:  limit(1),
+		MaxDaysPerRequest:  limit(10),
+	}
+	tests := []struct {
+		description string
+		policy      Policy
+		application Application
+		expected    []string
+	}{
+		{
+			description: "no settings",
+			policy:      Policy{AllowBackdated: true},
+			application: Application{Period: Period{date("2022-04-01"), date("2022-04-30")}, AppliedOn: date("2022-04-20")},
+		},
+		{
+			description: "within policy",
+			policy:      annual,
+			application: Application{Period: Period{date("2022-05-10"), date("2022-05-12")}, AppliedOn: date("2022-04-20")},
+		},
+		{
+			description: "to date before from date",
+			policy:      annual,
+			application: Application{Period: Period{date("2022-05-12"), date("2022-05-10")}, AppliedOn: date("2022-04-20")},
+			expected:    []string{RuleDateOrder},
+		},
+		{
+			description: "back dated",
+			policy:      Policy{},
+			application: Application{Period: Period{date("2022-04-19"), date("2022-04-20")}, AppliedOn: date("2022-04-20")},
+			expected:    []string{RuleBackdating},
+		},
+		{
+			description: "short notice and too long",
+			policy:      annual,
+			application: Application{Period: Period{date("2022-04-25"), date("2022-05-10")}, AppliedOn: date("2022-04-20")},
+			expected:    []string{RuleMinNotice, RuleMaxDaysPerRequest, RuleMaxConsecutiveDays},
+		},
+		{
+			description: "back to back with another application",
+			policy:      annual,
+			application: Application{
+				Period:    Period{date("2022-05-10"), date("2022-05-19")},
+				AppliedOn: date("2022-04-20"),
+				Others: []Leave{
+					{Period: Period{date("2022-05-20"), date("2022-05-22")}},
+					{Period: Period{date("2022-05-23"), date("2022-05-25")}},
+					{Period: Period{date("2022-06-01"), date("2022-06-05")}},
+				},
+			},
+			expected: []string{RuleMaxConsecutiveDays},
+		},
+		{
+			description: "too short",
+			policy:      Policy{MinDaysPerRequest: limit(3), AllowBackdated: true},
+			application: Application{Period: Period{date("2022-05-10"), date("2022-05-11")}, AppliedOn: date("2022-04-20")},
+			expected:    []string{RuleMinDaysPerRequest},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			var actual []string
+			for _, violation := range Evaluate(test.policy, test.application) {
+				actual = append(actual, violation.Rule)
+			}
+			if !reflect.DeepEqual(actual, test.expected) {
+				t.Errorf("expected %v: got %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestViolationError_GRPCStatus(t *testing.T) {
+	err := &ViolationError{Violations: []Violation{
+		{Rule: RuleMinNotice, Description: "leave must be applied for at least 14 days in advance"},
+		{Rule: RuleMaxDaysPerRequest, Description: "leave must be at most 10 days"},
+	}}
+	st := status.Convert(err)
+	if st.Code() != codes.FailedPrecondition {
+		t.Errorf("expected %v: got %v", codes.FailedPrecondition, st.Code())
+	}
+	expected := "leave policy violated: leave must be applied for at least 14 days in advance; leave must be at most 10 days"
+	if st.Message() != expected {
+		t.Errorf("expected %v: got %v", expected, st.Message())
+	}
+	details := st.Details()
+	if len(details) != 1 {
+		t.Fatalf("expected %v: got %v", 1, len(details))
+	}
+	failure, ok := details[0].(*errdetails.PreconditionFailure)
+	if !ok || len(failure.Violations) != 2 || failure.Violations[1].Type != RuleMaxDaysPerRequest {
+		t.Errorf("expected %v: got %v", err.Violations, details[0])
+	}
+}
@@ -0,0 +1,53 @@
+package policy
+
+import "time"
+
+// Rounding is how a pro-rated entitlement is rounded to whole days.
+type Rounding string
+
+const (
+	// RoundNone does not pro-rate: the whole entitlement is earned.
+	RoundNone Rounding = "NONE"
+	// RoundDown drops a part of a day.
+	RoundDown Rounding = "DOWN"
+	// RoundUp counts a part of a day as a whole day.
+	RoundUp Rounding = "UP"
+	// RoundNearest rounds to the nearest day, halves up.
+	RoundNearest Rounding = "NEAREST"
+)
+
+// ProRate returns the days of allowed earned in the leave year from
+// yearStart to yearEnd by an employee who joined on joining and left on
+// exit, either of which is nil when not known. Days are earned in proportion
+// to the days of the year in service, so a joiner or leaver earns part of
+// the entitlement and someone not in service that year none.
+func ProRate(allowed int, rounding Rounding, yearStart, yearEnd time.Time, joining, exit *time.Time) int {
+	if rounding == RoundNone {
+		return allowed
+	}
+	from, to := yearStart, yearEnd
+	if joining != nil && joining.After(from) {
+		from = *joining
+	}
+	if exit != nil && exit.Before(to) {
+		to = *exit
+	}
+	if to.Before(from) {
+		return 0
+	}
+	served := daysBetween(from, to) + 1
+	total := daysBetween(yearStart, yearEnd) + 1
+	switch rounding {
+	case RoundDown:
+		return allowed * served / total
+	case RoundUp:
+		return (allowed*served + total - 1) / total
+	default:
+		return (2*allowed*served + total) / (2 * total)
+	}
+}
+
+// daysBetween returns the number of days from from to to.
+func daysBetween(from, to time.Time) int {
+	return int(to.Sub(from).Hours()/24 + 0.5)
+}
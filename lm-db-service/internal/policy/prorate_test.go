@@ -0,0 +1,42 @@
+package policy
+
+import (
+	"testing"
+	"time"
+)
+
+func TestProRate(t *testing.T) {
+	date := func(value string) *time.Time {
+		d, _ := time.Parse("2006-01-02", value)
+		return &d
+	}
+	yearStart, yearEnd := *date("2022-01-01"), *date("2022-12-31")
+	tests := []struct {
+		description string
+		rounding    Rounding
+		joining     *time.Time
+		exit        *time.Time
+		expected    int
+	}{
+		{description: "whole year", rounding: RoundNearest, expected: 12},
+		{description: "joined before the year", rounding: RoundNearest, joining: date("2020-06-15"), expected: 12},
+		{description: "joined mid year rounded down", rounding: RoundDown, joining: date("2022-07-01"), expected: 6},
+		{description: "joined mid year rounded up", rounding: RoundUp, joining: date("2022-07-01"), expected: 7},
+		{description: "joined mid year rounded to nearest", rounding: RoundNearest, joining: date("2022-07-01"), expected: 6},
+		{description: "left mid year rounded down", rounding: RoundDown, exit: date("2022-06-30"), expected: 5},
+		{description: "left mid year rounded to nearest", rounding: RoundNearest, exit: date("2022-06-30"), expected: 6},
+		{description: "joined and left in the year", rounding: RoundNearest, joining: date("2022-03-15"), exit: date("2022-09-14"),
+			expected: 6},
+		{description: "joined after the year", rounding: RoundNearest, joining: date("2023-01-10"), expected: 0},
+		{description: "left before the year", rounding: RoundNearest, exit: date("2021-12-31"), expected: 0},
+		{description: "not pro-rated", rounding: RoundNone, joining: date("2022-07-01"), expected: 12},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			actual := ProRate(12, test.rounding, yearStart, yearEnd, test.joining, test.exit)
+			if actual != test.expected {
+				t.Errorf("expected %v: got %v", test.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package retention
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Purger permanently removes records older than olderThan, such as soft
+// deleted leave applications, and returns how many it removed. It may remove
+// only part of them per call.
+type Purger func(ctx context.Context, olderThan time.Duration) (int, error)
+
+// Job periodically removes records once they are older than Retention.
+type Job struct {
+	// Name identifies the job in logs.
+	Name      string
+	Purge     Purger
+	Retention time.Duration
+	Interval  time.Duration
+	// Timeout bounds a single call to Purge.
+	Timeout time.Duration
+	Logger  *zap.Logger
+}
+
+func NewJob(name string, purge Purger, retention, interval, timeout time.Duration, logger *zap.Logger) *Job {
+	return &Job{
+		Name:      name,
+		Purge:     purge,
+		Retention: retention,
+		Interval:  interval,
+		Timeout:   timeout,
+		Logger:    logger,
+	}
+}
+
+// RunOnce calls Purge until nothing is left to remove and returns the total
+// number of applications removed.
+func (j *Job) RunOnce(ctx context.Context) (int, error) {
+	total := 0
+	for ctx.Err() == nil {
+		purgeCtx, cancel := context.WithTimeout(ctx, j.Timeout)
+		purged, err := j.Purge(purgeCtx, j.Retention)
+		cancel()
+		total += purged
+		if err != nil {
+			return total, err
+		}
+		if purged == 0 {
+			break
+		}
+	}
+	return total, ctx.Err()
+}
+
+// Run purges every Interval until ctx is done.
+func (j *Job) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.Interval)
+	defer ticker.Stop()
+	for {
+		purged, err := j.RunOnce(ctx)
+		if err != nil && ctx.Err() == nil {
+			j.Logger.Error("purge failed", zap.String("job", j.Name), zap.Int("purged", purged), zap.Error(err))
+		} else if purged > 0 {
+			j.Logger.Info("purged", zap.String("job", j.Name), zap.Int("purged", purged), zap.Duration("retention", j.Retention))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type testPurger struct {
+	batches   []int
+	err       error
+	calls     int
+	olderThan time.Duration
+}
+
+func (p *testPurger) purge(ctx context.Context, olderThan time.Duration) (int, error) {
+	p.olderThan = olderThan
+	if p.calls >= len(p.batches) {
+		return 0, p.err
+	}
+	purged := p.batches[p.calls]
+	p.calls++
+	return purged, nil
+}
+
+func TestJob_RunOnce(t *testing.T) {
+	tests := []struct {
+		description string
+		purger      *testPurger
+		expected    int
+		calls       int
+		isError     bool
+	}{
+		{
+			description: "nothing to purge",
+			purger:      &testPurger{},
+			expected:    0,
+			calls:       0,
+			isError:     false,
+		},
+		{
+			description: "several batches",
+			purger:      &testPurger{batches: []int{500, 500, 12}},
+			expected:    1012,
+			calls:       3,
+			isError:     false,
+		},
+		{
+			description: "purge failed",
+			purger:      &testPurger{batches: []int{500}, err: errors.New("error")},
+			expected:    500,
+			calls:       1,
+			isError:     true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			job := NewJob("deleted leaves", test.purger.purge, 90*24*time.Hour, time.Hour, time.Second, zap.NewNop())
+			purged, err := job.RunOnce(context.Background())
+			if test.isError == false && err != nil {
+				t.Errorf("got error %v: want error: %v", err, false)
+			}
+			if test.isError == true && err == nil {
+				t.Errorf("got error %v: want error: %v", err, true)
+			}
+			if purged != test.expected {
+				t.Errorf("expected %v: got %v", test.expected, purged)
+			}
+			if test.purger.calls != test.calls {
+				t.Errorf("expected %v: got %v", test.calls, test.purger.calls)
+			}
+			if test.purger.olderThan != 90*24*time.Hour {
+				t.Errorf("expected %v: got %v", 90*24*time.Hour, test.purger.olderThan)
+			}
+		})
+	}
+}
+
+func TestJob_RunStops(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	purger := &testPurger{}
+	job := NewJob("deleted leaves", purger.purge, time.Hour, time.Millisecond, time.Second, zap.NewNop())
+	done := make(chan struct{})
+	go func() {
+		job.Run(ctx)
+		close(done)
+	}()
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Error("Run did not return after the context was cancelled")
+	}
+}
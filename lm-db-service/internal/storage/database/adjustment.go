@@ -0,0 +1,213 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"leavemanagement/lm-db-service/internal/tracing"
+	"leavemanagement/lm-db-service/models"
+	"leavemanagement/lm-db-service/pkg/pb"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator"
+)
+
+// AdjustBalance credits days to the balance of an employee for a leave type
+// and year, or debits them when days is negative, to correct a mistake. Only
+// HR has access to it and a reason is required. Adjustments are kept, never
+// edited, and count in every later balance check; a wrong one is undone with
+// another adjustment.
+func (d MysqlDB) AdjustBalance(ctx context.Context, req *pb.AdjustBalanceRequest) (*pb.AdjustBalanceResponse, error) {
+	year := req.Year
+	if year == "" {
+		year = strconv.Itoa(time.Now().Year())
+	}
+	days, err := strconv.Atoi(req.Days)
+	if err != nil {
+		return &pb.AdjustBalanceResponse{}, errors.New("days must be a whole number")
+	}
+	validate := validator.New()
+	fields := models.ValidateAdjustBalance{
+		EmployeeId:       req.EmployeeId,
+		TargetEmployeeId: req.TargetEmployeeId,
+		LeaveTypeId:      req.LeaveTypeId,
+		Year:             year,
+		Days:             days,
+		Reason:           req.Reason,
+	}
+	err = validate.Struct(fields)
+	if err != nil {
+		return &pb.AdjustBalanceResponse{}, errors.New("invalid input")
+	}
+
+	designationId, err := d.getDesignationId(ctx, req.EmployeeId)
+	if err != nil {
+		return &pb.AdjustBalanceResponse{}, err
+	}
+	if designationId != hrId {
+		return &pb.AdjustBalanceResponse{}, errors.New("access denied")
+	}
+	_, _, err = d.getServiceDates(ctx, req.TargetEmployeeId)
+	if err != nil {
+		return &pb.AdjustBalanceResponse{}, err
+	}
+	compOff, err := d.isCompOffLeaveType(ctx, req.LeaveTypeId)
+	if err != nil {
+		return &pb.AdjustBalanceResponse{}, err
+	}
+	if compOff {
+		return &pb.AdjustBalanceResponse{}, errors.New("comp-off balance cannot be adjusted")
+	}
+
+	adjustment := &pb.BalanceAdjustment{
+		EmployeeId:  req.TargetEmployeeId,
+		LeaveTypeId: req.LeaveTypeId,
+		Year:        year,
+		Days:        strconv.Itoa(days),
+		Reason:      req.Reason,
+		AdjustedBy:  req.EmployeeId,
+		AdjustedAt:  time.Now().Format(dateTimeFormat),
+	}
+	err = d.withTx(ctx, func(tx *sql.Tx) error {
+		adjustBalanceQuery := `
+						INSERT INTO lm_balance_adjustment (
+							employee_id,
+							leave_type_id,
+							year,
+							days,
+							reason,
+							adjusted_by,
+							adjusted_at)
+						VALUES (?, ?, ?, ?, ?, ?, ?)`
+		execCtx, span, end := d.startQuery(ctx, "adjustBalance", "INSERT", "lm_balance_adjustment")
+		defer end()
+		result, err := tx.ExecContext(execCtx, adjustBalanceQuery, adjustment.EmployeeId, adjustment.LeaveTypeId, adjustment.Year,
+			days, adjustment.Reason, adjustment.AdjustedBy, adjustment.AdjustedAt)
+		tracing.RecordResult(span, result)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return err
+		}
+		adjustmentId, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		adjustment.AdjustmentId = strconv.FormatInt(adjustmentId, 10)
+		return d.appendRecordAuditEvent(ctx, tx, auditSubjectAdjustment, adjustment.AdjustmentId, adjustment.EmployeeId,
+			req.EmployeeId, auditAdjust, nil, adjustment)
+	})
+	if err != nil {
+		return &pb.AdjustBalanceResponse{}, err
+	}
+	return &pb.AdjustBalanceResponse{Adjustment: adjustment}, nil
+}
+
+// ListBalanceAdjustments returns the history of balance adjustments, oldest
+// first, optionally only those of a leave type or year. Employees see their
+// own, managers those of their team and HR everyone's; HR can leave the
+// target employee empty to list all employees' adjustments.
+func (d MysqlDB) ListBalanceAdjustments(ctx context.Context, req *pb.ListBalanceAdjustmentsRequest) (*pb.ListBalanceAdjustmentsResponse, error) {
+	validate := validator.New()
+	fields := models.ValidateListBalanceAdjustments{
+		EmployeeId:       req.EmployeeId,
+		TargetEmployeeId: req.TargetEmployeeId,
+		LeaveTypeId:      req.LeaveTypeId,
+		Year:             req.Year,
+	}
+	err := validate.Struct(fields)
+	if err != nil {
+		return &pb.ListBalanceAdjustmentsResponse{}, errors.New("invalid input")
+	}
+
+	targetEmployeeId := req.TargetEmployeeId
+	if targetEmployeeId == "" {
+		designationId, err := d.getDesignationId(ctx, req.EmployeeId)
+		if err != nil {
+			return &pb.ListBalanceAdjustmentsResponse{}, err
+		}
+		if designationId != hrId {
+			targetEmployeeId = req.EmployeeId
+		}
+	} else {
+		err = d.canViewEmployee(ctx, req.EmployeeId, targetEmployeeId)
+		if err != nil {
+			return &pb.ListBalanceAdjustmentsResponse{}, err
+		}
+	}
+
+	listBalanceAdjustmentsQuery := `
+					SELECT
+						adjustment_id,
+						employee_id,
+						leave_type_id,
+						year,
+						days,
+						reason,
+						adjusted_by,
+						adjusted_at
+					FROM lm_balance_adjustment
+					WHERE (?="" OR employee_id=?) AND (?="" OR leave_type_id=?) AND (?="" OR year=?)
+					ORDER BY adjustment_id`
+	ctx, span, end := d.startQuery(ctx, "listBalanceAdjustments", "SELECT", "lm_balance_adjustment")
+	defer end()
+	rows, err := d.DB.QueryContext(ctx, listBalanceAdjustmentsQuery, targetEmployeeId, targetEmployeeId, req.LeaveTypeId,
+		req.LeaveTypeId, req.Year, req.Year)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return &pb.ListBalanceAdjustmentsResponse{}, err
+	}
+	defer rows.Close()
+	adjustments := &pb.ListBalanceAdjustmentsResponse{}
+	for rows.Next() {
+		adjustment := &pb.BalanceAdjustment{}
+		err = rows.Scan(&adjustment.AdjustmentId, &adjustment.EmployeeId, &adjustment.LeaveTypeId, &adjustment.Year,
+			&adjustment.Days, &adjustment.Reason, &adjustment.AdjustedBy, &adjustment.AdjustedAt)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return &pb.ListBalanceAdjustmentsResponse{}, err
+		}
+		adjustments.Adjustments = append(adjustments.Adjustments, adjustment)
+	}
+	if err = rows.Err(); err != nil {
+		tracing.RecordError(span, err)
+		return &pb.ListBalanceAdjustmentsResponse{}, err
+	}
+	return adjustments, nil
+}
+
+// isCompOffLeaveType reports whether leaveTypeId is the comp-off leave type,
+// whose balance is made of credits rather than a yearly allowance.
+func (d MysqlDB) isCompOffLeaveType(ctx context.Context, leaveTypeId string) (bool, error) {
+	var compOff bool
+	compOffLeaveTypeQuery := `SELECT comp_off_validity_days IS NOT NULL FROM lm_leave_type WHERE leave_type_id=?`
+	ctx, span, end := d.startQuery(ctx, "isCompOffLeaveType", "SELECT", "lm_leave_type")
+	defer end()
+	err := d.DB.QueryRowContext(ctx, compOffLeaveTypeQuery, leaveTypeId).Scan(&compOff)
+	if err != nil {
+		tracing.RecordError(span, err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, errors.New("leave type not found")
+		}
+		return false, err
+	}
+	return compOff, nil
+}
+
+// getAdjustedDays returns the days HR credited to the balance of leaveTypeId
+// of the employee in the leave year containing date, less those debited.
+func (d MysqlDB) getAdjustedDays(ctx context.Context, q queryer, employeeId, leaveTypeId, date string) (int, error) {
+	var adjustedDays int
+	adjustedDaysQuery := `
+					SELECT IFNULL(SUM(days),0)
+					FROM lm_balance_adjustment
+					WHERE employee_id=? AND leave_type_id=? AND year=?`
+	ctx, span, end := d.startQuery(ctx, "adjustedDays", "SELECT", "lm_balance_adjustment")
+	defer end()
+	err := q.QueryRowContext(ctx, adjustedDaysQuery, employeeId, leaveTypeId, date[:4]).Scan(&adjustedDays)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return 0, err
+	}
+	return adjustedDays, nil
+}
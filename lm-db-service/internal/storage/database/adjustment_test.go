@@ -0,0 +1,252 @@
+package database
+
+import (
+	"context"
+	"database/sql/driver"
+	"leavemanagement/lm-db-service/pkg/pb"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"google.golang.org/protobuf/proto"
+)
+
+var balanceAdjustmentColumns = []string{"adjustment_id", "employee_id", "leave_type_id", "year", "days", "reason", "adjusted_by",
+	"adjusted_at"}
+
+// expectAdjustedDays expects the days HR adjusted the balance of leaveTypeId
+// of the employee by in year.
+func expectAdjustedDays(mock sqlmock.Sqlmock, employeeId, leaveTypeId, year string, days int) {
+	mock.ExpectQuery(`SELECT IFNULL\(SUM\(days\),0\)\s+FROM lm_balance_adjustment`).WithArgs(employeeId, leaveTypeId, year).
+		WillReturnRows(sqlmock.NewRows([]string{"days"}).AddRow(days))
+}
+
+func TestMySqlMock_AdjustBalance(t *testing.T) {
+	compOffLeaveTypeQuery := `SELECT comp_off_validity_days IS NOT NULL FROM lm_leave_type WHERE leave_type_id=\?`
+	adjustBalanceQuery := `INSERT INTO lm_balance_adjustment`
+	tests := []struct {
+		description string
+		request     *pb.AdjustBalanceRequest
+		expect      func(mock sqlmock.Sqlmock)
+		isError     string
+	}{
+		{
+			description: "credit",
+			request: &pb.AdjustBalanceRequest{EmployeeId: "2", TargetEmployeeId: "1", LeaveTypeId: "1", Year: "2022", Days: "2",
+				Reason: "sick days entered twice"},
+			expect: func(mock sqlmock.Sqlmock) {
+				expectDesignation(mock, "2", hrId)
+				expectServiceDates(mock, "1", nil, nil)
+				mock.ExpectQuery(compOffLeaveTypeQuery).WithArgs("1").
+					WillReturnRows(sqlmock.NewRows([]string{"comp_off"}).AddRow(false))
+				mock.ExpectBegin()
+				mock.ExpectExec(adjustBalanceQuery).
+					WithArgs("1", "1", "2022", 2, "sick days entered twice", "2", time.Now().Format(dateTimeFormat)).
+					WillReturnResult(sqlmock.NewResult(3, 1))
+				expectRecordAuditEvent(mock, auditSubjectAdjustment, "3", "1", "2", auditAdjust)
+				mock.ExpectCommit()
+			},
+		},
+		{
+			description: "debit",
+			request: &pb.AdjustBalanceRequest{EmployeeId: "2", TargetEmployeeId: "1", LeaveTypeId: "1", Year: "2022", Days: "-1",
+				Reason: "leave taken before joining the system"},
+			expect: func(mock sqlmock.Sqlmock) {
+				expectDesignation(mock, "2", hrId)
+				expectServiceDates(mock, "1", nil, nil)
+				mock.ExpectQuery(compOffLeaveTypeQuery).WithArgs("1").
+					WillReturnRows(sqlmock.NewRows([]string{"comp_off"}).AddRow(false))
+				mock.ExpectBegin()
+				mock.ExpectExec(adjustBalanceQuery).
+					WithArgs("1", "1", "2022", -1, "leave taken before joining the system", "2", time.Now().Format(dateTimeFormat)).
+					WillReturnResult(sqlmock.NewResult(3, 1))
+				expectRecordAuditEvent(mock, auditSubjectAdjustment, "3", "1", "2", auditAdjust)
+				mock.ExpectCommit()
+			},
+		},
+		{
+			description: "comp-off leave type",
+			request: &pb.AdjustBalanceRequest{EmployeeId: "2", TargetEmployeeId: "1", LeaveTypeId: "6", Year: "2022", Days: "1",
+				Reason: "missed credit"},
+			expect: func(mock sqlmock.Sqlmock) {
+				expectDesignation(mock, "2", hrId)
+				expectServiceDates(mock, "1", nil, nil)
+				mock.ExpectQuery(compOffLeaveTypeQuery).WithArgs("6").
+					WillReturnRows(sqlmock.NewRows([]string{"comp_off"}).AddRow(true))
+			},
+			isError: "comp-off balance cannot be adjusted",
+		},
+		{
+			description: "unknown leave type",
+			request: &pb.AdjustBalanceRequest{EmployeeId: "2", TargetEmployeeId: "1", LeaveTypeId: "9", Year: "2022", Days: "1",
+				Reason: "typo"},
+			expect: func(mock sqlmock.Sqlmock) {
+				expectDesignation(mock, "2", hrId)
+				expectServiceDates(mock, "1", nil, nil)
+				mock.ExpectQuery(compOffLeaveTypeQuery).WithArgs("9").WillReturnRows(sqlmock.NewRows([]string{"comp_off"}))
+			},
+			isError: "leave type not found",
+		},
+		{
+			description: "unknown employee",
+			request: &pb.AdjustBalanceRequest{EmployeeId: "2", TargetEmployeeId: "99", LeaveTypeId: "1", Year: "2022", Days: "1",
+				Reason: "typo"},
+			expect: func(mock sqlmock.Sqlmock) {
+				expectDesignation(mock, "2", hrId)
+				mock.ExpectQuery(`SELECT date_of_joining, date_of_exit FROM lm_employee WHERE employee_id=\?`).WithArgs("99").
+					WillReturnRows(sqlmock.NewRows([]string{"date_of_joining", "date_of_exit"}))
+			},
+			isError: "employee not found",
+		},
+		{
+			description: "not HR",
+			request: &pb.AdjustBalanceRequest{EmployeeId: "8", TargetEmployeeId: "1", LeaveTypeId: "1", Year: "2022", Days: "1",
+				Reason: "typo"},
+			expect: func(mock sqlmock.Sqlmock) {
+				expectDesignation(mock, "8", managerId)
+			},
+			isError: "access denied",
+		},
+		{
+			description: "days not a whole number",
+			request: &pb.AdjustBalanceRequest{EmployeeId: "2", TargetEmployeeId: "1", LeaveTypeId: "1", Year: "2022", Days: "1.5",
+				Reason: "half day missed"},
+			expect:  func(mock sqlmock.Sqlmock) {},
+			isError: "days must be a whole number",
+		},
+		{
+			description: "no reason",
+			request:     &pb.AdjustBalanceRequest{EmployeeId: "2", TargetEmployeeId: "1", LeaveTypeId: "1", Year: "2022", Days: "1"},
+			expect:      func(mock sqlmock.Sqlmock) {},
+			isError:     "invalid input",
+		},
+		{
+			description: "no days",
+			request: &pb.AdjustBalanceRequest{EmployeeId: "2", TargetEmployeeId: "1", LeaveTypeId: "1", Year: "2022", Days: "0",
+				Reason: "typo"},
+			expect:  func(mock sqlmock.Sqlmock) {},
+			isError: "invalid input",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			testDB, mock := getTestMysqlDB(t)
+			test.expect(mock)
+			got, err := testDB.AdjustBalance(context.Background(), test.request)
+			if test.isError != "" {
+				if err == nil || err.Error() != test.isError {
+					t.Errorf("got error %v: want error: %v", err, test.isError)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("got error %v: want error: %v", err, false)
+				}
+				if got.Adjustment.AdjustmentId != "3" || got.Adjustment.Days != test.request.Days {
+					t.Errorf("expected %v: got %v", "3 "+test.request.Days, got.Adjustment)
+				}
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestMySqlMock_ListBalanceAdjustments(t *testing.T) {
+	listBalanceAdjustmentsQuery := `FROM lm_balance_adjustment\s+WHERE \(\?="" OR employee_id=\?\) AND \(\?="" OR leave_type_id=\?\) AND \(\?="" OR year=\?\)`
+	row := []driver.Value{"3", "1", "1", "2022", "2", "sick days entered twice", "2", "2022-05-02 10:00:00"}
+	expected := &pb.ListBalanceAdjustmentsResponse{Adjustments: []*pb.BalanceAdjustment{{
+		AdjustmentId: "3", EmployeeId: "1", LeaveTypeId: "1", Year: "2022", Days: "2", Reason: "sick days entered twice",
+		AdjustedBy: "2", AdjustedAt: "2022-05-02 10:00:00",
+	}}}
+	tests := []struct {
+		description string
+		request     *pb.ListBalanceAdjustmentsRequest
+		expect      func(mock sqlmock.Sqlmock)
+		isError     string
+	}{
+		{
+			description: "HR lists everyone's",
+			request:     &pb.ListBalanceAdjustmentsRequest{EmployeeId: "2", Year: "2022"},
+			expect: func(mock sqlmock.Sqlmock) {
+				expectDesignation(mock, "2", hrId)
+				mock.ExpectQuery(listBalanceAdjustmentsQuery).WithArgs("", "", "", "", "2022", "2022").
+					WillReturnRows(sqlmock.NewRows(balanceAdjustmentColumns).AddRow(row...))
+			},
+		},
+		{
+			description: "employee lists their own",
+			request:     &pb.ListBalanceAdjustmentsRequest{EmployeeId: "1", LeaveTypeId: "1"},
+			expect: func(mock sqlmock.Sqlmock) {
+				expectDesignation(mock, "1", employeeId)
+				mock.ExpectQuery(listBalanceAdjustmentsQuery).WithArgs("1", "1", "1", "1", "", "").
+					WillReturnRows(sqlmock.NewRows(balanceAdjustmentColumns).AddRow(row...))
+			},
+		},
+		{
+			description: "another employee",
+			request:     &pb.ListBalanceAdjustmentsRequest{EmployeeId: "3", TargetEmployeeId: "1"},
+			expect: func(mock sqlmock.Sqlmock) {
+				expectDesignation(mock, "3", employeeId)
+			},
+			isError: "access denied",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			testDB, mock := getTestMysqlDB(t)
+			test.expect(mock)
+			got, err := testDB.ListBalanceAdjustments(context.Background(), test.request)
+			if test.isError != "" {
+				if err == nil || err.Error() != test.isError {
+					t.Errorf("got error %v: want error: %v", err, test.isError)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("got error %v: want error: %v", err, false)
+				}
+				if !proto.Equal(got, expected) {
+					t.Errorf("expected %v: got %v", expected, got)
+				}
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestMySqlMock_ApplyLeaveAdjusted(t *testing.T) {
+	testDB, mock := getTestMysqlDB(t)
+	expectLeavePolicy(mock, "1", "2022-04-20", "2022-04-21")
+	expectBlackoutPeriods(mock, "1", "1", "2022-04-20", "2022-04-21")
+	mock.ExpectBegin()
+	expectLockEmployee(mock, "1")
+	expectAllowedDays(mock, "1", "1", 3)
+	mock.ExpectQuery(`SELECT IFNULL\(SUM\(IF\(from_date>=\?, no_of_days-loss_of_pay_days, advance_days\)\),0\)`).
+		WithArgs("2022-01-01", "1", "1", declined, "2021-01-01", "2022-12-31").
+		WillReturnRows(sqlmock.NewRows([]string{"no_of_days"}).AddRow(3))
+	expectAdvancesBefore(mock, "1", "1", "2021-01-01")
+	expectEncashedDays(mock, "1", "1", "2022", 0)
+	expectAdjustedDays(mock, "1", "1", "2022", 2)
+	expectCoverageRules(mock, "1")
+	mock.ExpectExec(`INSERT INTO lm_leave_application`).
+		WithArgs("1", 1, time.Now().Format(dateTimeFormat), "2022-04-20", "2022-04-21", 2, 0, "Fever", 0, 0, 0, 0).
+		WillReturnResult(sqlmock.NewResult(4, 1))
+	expectLeaveSnapshot(mock, "4", "1", pending)
+	expectAuditEvent(mock, "4", "1", "1", auditApply)
+	mock.ExpectCommit()
+	_, err := testDB.ApplyLeave(context.Background(), &pb.ApplyLeaveRequest{
+		EmployeeId:  "1",
+		LeaveTypeId: "1",
+		FromDate:    "2022-04-20",
+		ToDate:      "2022-04-21",
+		Comment:     "Fever",
+	})
+	if err != nil {
+		t.Errorf("got error %v: want error: %v", err, false)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,303 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"leavemanagement/lm-db-service/pkg/pb"
+	"strconv"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+)
+
+// expectNegativeBalanceLimit expects the lookup of how far below zero the
+// balance of leaveTypeId may go.
+func expectNegativeBalanceLimit(mock sqlmock.Sqlmock, leaveTypeId string, limit int) {
+	mock.ExpectQuery(`SELECT negative_balance_limit FROM lm_leave_type WHERE leave_type_id=\?`).WithArgs(leaveTypeId).
+		WillReturnRows(sqlmock.NewRows([]string{"negative_balance_limit"}).AddRow(limit))
+}
+
+func TestMySqlMock_ApplyLeaveAdvance(t *testing.T) {
+	tests := []struct {
+		description     string
+		taken           int
+		limit           int
+		acceptLossOfPay bool
+		leaveBalance    int
+		advanceDays     int
+		lossOfPayDays   int
+		lockError       error
+		isError         error
+	}{
+		{
+			description:  "taken in advance",
+			taken:        2,
+			limit:        5,
+			leaveBalance: -1,
+			advanceDays:  1,
+		},
+		{
+			description:  "already in advance",
+			taken:        4,
+			limit:        3,
+			leaveBalance: -3,
+			advanceDays:  2,
+		},
+		{
+			description:     "limit reached, rest as loss of pay",
+			taken:           3,
+			limit:           1,
+			acceptLossOfPay: true,
+			leaveBalance:    -1,
+			advanceDays:     1,
+			lossOfPayDays:   1,
+		},
+		{
+			description: "beyond the limit",
+			taken:       3,
+			limit:       1,
+			isError:     errors.New("leaves not remaining"),
+		},
+		{
+			description: "lock not taken",
+			lockError:   errors.New("lock wait timeout exceeded"),
+			isError:     errors.New("lock wait timeout exceeded"),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			testDB, mock := getTestMysqlDB(t)
+			expectLeavePolicy(mock, "1", "2022-04-20", "2022-04-21")
+			expectBlackoutPeriods(mock, "1", "1", "2022-04-20", "2022-04-21")
+			mock.ExpectBegin()
+			// The advance is checked only once the employee is locked.
+			if test.lockError != nil {
+				mock.ExpectQuery(`SELECT employee_id FROM lm_employee WHERE employee_id=\? FOR UPDATE`).WithArgs("1").
+					WillReturnError(test.lockError)
+				mock.ExpectRollback()
+			} else {
+				expectLockEmployee(mock, "1")
+				expectAllowedDays(mock, "1", "1", 3)
+				mock.ExpectQuery(`SELECT IFNULL\(SUM\(IF\(from_date>=\?, no_of_days-loss_of_pay_days, advance_days\)\),0\)`).
+					WithArgs("2022-01-01", "1", "1", declined, "2021-01-01", "2022-12-31").
+					WillReturnRows(sqlmock.NewRows([]string{"no_of_days"}).AddRow(test.taken))
+				expectAdvancesBefore(mock, "1", "1", "2021-01-01")
+				expectEncashedDays(mock, "1", "1", "2022", 0)
+				expectAdjustedDays(mock, "1", "1", "2022", 0)
+				expectNegativeBalanceLimit(mock, "1", test.limit)
+				if test.isError != nil {
+					mock.ExpectRollback()
+				} else {
+					expectCoverageRules(mock, "1")
+					mock.ExpectExec(`INSERT INTO lm_leave_application`).
+						WithArgs("1", 1, time.Now().Format(dateTimeFormat), "2022-04-20", "2022-04-21", 2, test.leaveBalance, "Fever", 0, 0,
+							test.lossOfPayDays, test.advanceDays).
+						WillReturnResult(sqlmock.NewResult(4, 1))
+					expectLeaveSnapshot(mock, "4", "1", pending)
+					expectAuditEvent(mock, "4", "1", "1", auditApply)
+					mock.ExpectCommit()
+				}
+			}
+			got, err := testDB.ApplyLeave(context.Background(), &pb.ApplyLeaveRequest{
+				EmployeeId:      "1",
+				LeaveTypeId:     "1",
+				FromDate:        "2022-04-20",
+				ToDate:          "2022-04-21",
+				Comment:         "Fever",
+				AcceptLossOfPay: test.acceptLossOfPay,
+			})
+			if test.isError == nil {
+				if err != nil {
+					t.Errorf("got error %v: want error: %v", err, false)
+				}
+				if expected := strconv.Itoa(test.advanceDays); got.AdvanceDays != expected {
+					t.Errorf("expected %v: got %v", expected, got.AdvanceDays)
+				}
+				if expected := strconv.Itoa(test.lossOfPayDays); got.LossOfPayDays != expected {
+					t.Errorf("expected %v: got %v", expected, got.LossOfPayDays)
+				}
+			} else if err == nil || err.Error() != test.isError.Error() {
+				t.Errorf("got error %v: want error: %v", err, test.isError)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestMySqlMock_ChangeLeaveStatusAdvance(t *testing.T) {
+	changeLeaveStatusQuery := `UPDATE lm_leave_application\s+SET\s+leave_status=\?`
+	advanceApprovalQuery := `UPDATE lm_leave_application\s+SET\s+advance_approved_by=\?`
+	tests := []struct {
+		description       string
+		request           *pb.ChangeLeaveStatusRequest
+		designationId     string
+		advanceDays       string
+		advanceApprovedBy string
+		expect            func(mock sqlmock.Sqlmock)
+		isError           string
+	}{
+		{
+			description:   "manager approves first",
+			request:       &pb.ChangeLeaveStatusRequest{EmployeeId: "8", ApplicationId: "2", LeaveStatus: approved, Version: "1"},
+			designationId: managerId,
+			advanceDays:   "1",
+			expect: func(mock sqlmock.Sqlmock) {
+				expectCoverageRules(mock, "1")
+				mock.ExpectExec(advanceApprovalQuery).WithArgs("8", "2").WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery(leaveSnapshotQuery).WithArgs("2").
+					WillReturnRows(advanceLeaveSnapshotRows("2", "1", pending, "1", "8"))
+				expectAuditEvent(mock, "2", "1", "8", auditChangeStatus)
+			},
+		},
+		{
+			description:       "manager approves again",
+			request:           &pb.ChangeLeaveStatusRequest{EmployeeId: "8", ApplicationId: "2", LeaveStatus: approved, Version: "1"},
+			designationId:     managerId,
+			advanceDays:       "1",
+			advanceApprovedBy: "8",
+			isError:           "advance leave awaits HR approval",
+		},
+		{
+			description:   "manager declines",
+			request:       &pb.ChangeLeaveStatusRequest{EmployeeId: "8", ApplicationId: "2", LeaveStatus: declined, Version: "1"},
+			designationId: managerId,
+			advanceDays:   "1",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(changeLeaveStatusQuery).WithArgs(declined, time.Now().Format(dateTimeFormat), "", "2").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				expectLeaveSnapshot(mock, "2", "1", declined)
+				expectAuditEvent(mock, "2", "1", "8", auditChangeStatus)
+			},
+		},
+		{
+			description:   "hr before the manager",
+			request:       &pb.ChangeLeaveStatusRequest{EmployeeId: "7", ApplicationId: "2", LeaveStatus: approved, Version: "1"},
+			designationId: hrId,
+			advanceDays:   "1",
+			isError:       "advance leave needs manager approval first",
+		},
+		{
+			description:       "hr approves after the manager",
+			request:           &pb.ChangeLeaveStatusRequest{EmployeeId: "7", ApplicationId: "2", LeaveStatus: approved, Version: "1"},
+			designationId:     hrId,
+			advanceDays:       "1",
+			advanceApprovedBy: "8",
+			expect: func(mock sqlmock.Sqlmock) {
+				expectCoverageRules(mock, "1")
+				mock.ExpectExec(changeLeaveStatusQuery).WithArgs(approved, time.Now().Format(dateTimeFormat), "", "2").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery(leaveSnapshotQuery).WithArgs("2").
+					WillReturnRows(advanceLeaveSnapshotRows("2", "1", approved, "1", "8"))
+				expectAuditEvent(mock, "2", "1", "7", auditChangeStatus)
+			},
+		},
+		{
+			description:   "hr without advance",
+			request:       &pb.ChangeLeaveStatusRequest{EmployeeId: "7", ApplicationId: "2", LeaveStatus: approved, Version: "1"},
+			designationId: hrId,
+			advanceDays:   "0",
+			isError:       "access denied",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			testDB, mock := getTestMysqlDB(t)
+			expectDesignation(mock, test.request.EmployeeId, test.designationId)
+			mock.ExpectBegin()
+			if test.request.LeaveStatus == approved {
+				expectLockApplicant(mock, "2", "1")
+			}
+			mock.ExpectQuery(leaveSnapshotQuery).WithArgs("2").
+				WillReturnRows(advanceLeaveSnapshotRows("2", "1", pending, test.advanceDays, test.advanceApprovedBy))
+			if test.isError == "" {
+				test.expect(mock)
+				mock.ExpectCommit()
+			} else {
+				mock.ExpectRollback()
+			}
+			err := testDB.ChangeLeaveStatus(context.Background(), test.request)
+			if test.isError == "" {
+				if err != nil {
+					t.Errorf("got error %v: want error: %v", err, false)
+				}
+			} else if err == nil || err.Error() != test.isError {
+				t.Errorf("got error %v: want error: %v", err, test.isError)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestMySqlMock_ChangeLeaveStatusReapproval(t *testing.T) {
+	reapprovalQuery := `UPDATE lm_leave_application\s+SET\s+leave_status=\?,\s+date_of_approval=\?,\s+coverage_override_reason=NULLIF\(\?,""\),\s+leave_balance=\?,\s+loss_of_pay_days=\?,\s+advance_days=\?`
+	tests := []struct {
+		description string
+		allowed     int
+		taken       int
+		expect      func(mock sqlmock.Sqlmock)
+		isError     string
+	}{
+		{
+			description: "balance remaining",
+			allowed:     12,
+			taken:       5,
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(reapprovalQuery).WithArgs(approved, time.Now().Format(dateTimeFormat), "", 5, 0, 0, "2").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				expectLeaveSnapshot(mock, "2", "1", approved)
+				expectAuditEvent(mock, "2", "1", "8", auditChangeStatus)
+			},
+		},
+		{
+			description: "balance taken since it was declined",
+			allowed:     3,
+			taken:       3,
+			expect: func(mock sqlmock.Sqlmock) {
+				expectNegativeBalanceLimit(mock, "1", 0)
+			},
+			isError: "leaves not remaining",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			testDB, mock := getTestMysqlDB(t)
+			expectDesignation(mock, "8", managerId)
+			mock.ExpectBegin()
+			expectLockApplicant(mock, "2", "1")
+			expectLeaveSnapshot(mock, "2", "1", declined)
+			expectCoverageRules(mock, "1")
+			mock.ExpectQuery(`SELECT comp_off_validity_days IS NOT NULL FROM lm_leave_type WHERE leave_type_id=\?`).WithArgs("1").
+				WillReturnRows(sqlmock.NewRows([]string{"comp_off"}).AddRow(false))
+			expectAllowedDays(mock, "1", "1", test.allowed)
+			mock.ExpectQuery(`IFNULL\(SUM\(IF\(from_date>=\?, no_of_days-loss_of_pay_days, advance_days\)\),0\)`).
+				WithArgs("2022-01-01", "1", "1", declined, "2021-01-01", "2022-12-31").
+				WillReturnRows(sqlmock.NewRows([]string{"no_of_days"}).AddRow(test.taken))
+			expectAdvancesBefore(mock, "1", "1", "2021-01-01")
+			expectEncashedDays(mock, "1", "1", "2022", 0)
+			expectAdjustedDays(mock, "1", "1", "2022", 0)
+			test.expect(mock)
+			if test.isError == "" {
+				mock.ExpectCommit()
+			} else {
+				mock.ExpectRollback()
+			}
+			err := testDB.ChangeLeaveStatus(context.Background(),
+				&pb.ChangeLeaveStatusRequest{EmployeeId: "8", ApplicationId: "2", LeaveStatus: approved, Version: "1"})
+			if test.isError == "" {
+				if err != nil {
+					t.Errorf("got error %v: want error: %v", err, false)
+				}
+			} else if err == nil || err.Error() != test.isError {
+				t.Errorf("got error %v: want error: %v", err, test.isError)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
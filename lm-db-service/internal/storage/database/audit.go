@@ -0,0 +1,460 @@
+package database
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"leavemanagement/lm-db-service/internal/tracing"
+	"leavemanagement/lm-db-service/models"
+	"leavemanagement/lm-db-service/pkg/pb"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator"
+)
+
+const (
+	auditApply        = "APPLY"
+	auditUpdate       = "UPDATE"
+	auditChangeStatus = "CHANGE_STATUS"
+	auditDelete       = "DELETE"
+	auditRestore      = "RESTORE"
+	auditPurge        = "PURGE"
+	auditRequest      = "REQUEST"
+	auditSettle       = "SETTLE"
+	auditCreate       = "CREATE"
+	auditAdjust       = "ADJUST"
+	auditExit         = "EXIT"
+)
+
+// Subjects of the audit events of records other than leave applications.
+// Their events carry the id of the record in subject_id, 0 as the
+// application and the employee concerned, if any, as the applicant.
+const (
+	auditSubjectCompOff    = "COMP_OFF"
+	auditSubjectEncashment = "ENCASHMENT"
+	auditSubjectAdjustment = "BALANCE_ADJUSTMENT"
+	auditSubjectBlackout   = "BLACKOUT_PERIOD"
+	auditSubjectEmployee   = "EMPLOYEE"
+)
+
+// leaveSnapshot is the state of a row of lm_leave_application as recorded in
+// the before and after columns of the audit trail.
+type leaveSnapshot struct {
+	ApplicationId     string `json:"applicationId"`
+	EmployeeId        string `json:"employeeId"`
+	LeaveTypeId       string `json:"leaveTypeId"`
+	DateOfApplication string `json:"dateOfApplication"`
+	FromDate          string `json:"fromDate"`
+	ToDate            string `json:"toDate"`
+	NoOfDays          string `json:"noOfDays"`
+	LeaveBalance      string `json:"leaveBalance"`
+	LeaveStatus       string `json:"leaveStatus"`
+	Comment           string `json:"comment"`
+	DateOfApproval    string `json:"dateOfApproval"`
+	Version           string `json:"version"`
+	LossOfPayDays     string `json:"lossOfPayDays"`
+	AdvanceDays       string `json:"advanceDays"`
+	SandwichBefore    string `json:"sandwichBefore"`
+	SandwichAfter     string `json:"sandwichAfter"`
+	// AdvanceApprovedBy is the manager who approved an application in
+	// advance, pending HR's approval.
+	AdvanceApprovedBy string `json:"advanceApprovedBy,omitempty"`
+	DeletedAt         string `json:"deletedAt,omitempty"`
+	DeletedBy         string `json:"deletedBy,omitempty"`
+	DeleteReason      string `json:"deleteReason,omitempty"`
+	// CoverageOverrideReason is set when HR approved the application over a
+	// coverage limit.
+	CoverageOverrideReason string `json:"coverageOverrideReason,omitempty"`
+}
+
+func (s *leaveSnapshot) deleted() bool {
+	return s.DeletedAt != ""
+}
+
+// auditEvent is a row of lm_audit_event. Hash covers every other field and
+// the hash of the previous event, so editing or removing a row breaks the
+// chain from that point on.
+type auditEvent struct {
+	EventId       int64  `json:"-"`
+	ApplicationId string `json:"applicationId"`
+	ApplicantId   string `json:"applicantId"`
+	ActorId       string `json:"actorId"`
+	Action        string `json:"action"`
+	Before        string `json:"before"`
+	After         string `json:"after"`
+	CreatedAt     string `json:"createdAt"`
+	PrevHash      string `json:"prevHash"`
+	Hash          string `json:"-"`
+	// Subject and SubjectId are empty for the events of leave applications,
+	// which keeps the hashes of events written before they were added.
+	Subject   string `json:"subject,omitempty"`
+	SubjectId string `json:"subjectId,omitempty"`
+}
+
+// AuditTamperError reports the first audit event that does not match the
+// hash chain.
+type AuditTamperError struct {
+	EventId int64
+	Reason  string
+}
+
+func (e *AuditTamperError) Error() string {
+	return fmt.Sprintf("audit trail tampered at event %d: %s", e.EventId, e.Reason)
+}
+
+func (e auditEvent) computeHash() string {
+	data, _ := json.Marshal(e)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func (s *leaveSnapshot) json() string {
+	if s == nil {
+		return ""
+	}
+	data, _ := json.Marshal(s)
+	return string(data)
+}
+
+// withTx runs fn in a transaction and commits it when fn returns nil.
+func (d MysqlDB) withTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := d.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// getLeaveSnapshot reads and locks an application inside tx.
+func (d MysqlDB) getLeaveSnapshot(ctx context.Context, tx *sql.Tx, applicationId string) (*leaveSnapshot, error) {
+	leave := &leaveSnapshot{}
+	leaveSnapshotQuery := `
+					SELECT
+						application_id,
+						employee_id,
+						leave_type_id,
+						date_of_application,
+						from_date,
+						to_date,
+						no_of_days,
+						leave_balance,
+						leave_status,
+						comment,
+						IFNULL(date_of_approval,"N/A"),
+						version,
+						loss_of_pay_days,
+						advance_days,
+						sandwich_before,
+						sandwich_after,
+						IFNULL(advance_approved_by,""),
+						IFNULL(deleted_at,""),
+						IFNULL(deleted_by,""),
+						IFNULL(delete_reason,""),
+						IFNULL(coverage_override_reason,"")
+					FROM lm_leave_application
+					WHERE application_id=?
+					FOR UPDATE`
+	ctx, span, end := d.startQuery(ctx, "leaveSnapshot", "SELECT", "lm_leave_application")
+	defer end()
+	err := tx.QueryRowContext(ctx, leaveSnapshotQuery, applicationId).Scan(
+		&leave.ApplicationId,
+		&leave.EmployeeId,
+		&leave.LeaveTypeId,
+		&leave.DateOfApplication,
+		&leave.FromDate,
+		&leave.ToDate,
+		&leave.NoOfDays,
+		&leave.LeaveBalance,
+		&leave.LeaveStatus,
+		&leave.Comment,
+		&leave.DateOfApproval,
+		&leave.Version,
+		&leave.LossOfPayDays,
+		&leave.AdvanceDays,
+		&leave.SandwichBefore,
+		&leave.SandwichAfter,
+		&leave.AdvanceApprovedBy,
+		&leave.DeletedAt,
+		&leave.DeletedBy,
+		&leave.DeleteReason,
+		&leave.CoverageOverrideReason)
+	if err != nil {
+		tracing.RecordError(span, err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.New("leave application not found")
+		}
+		return nil, err
+	}
+	return leave, nil
+}
+
+// appendAuditEvent records a mutation of an application inside the same
+// transaction as the mutation.
+func (d MysqlDB) appendAuditEvent(ctx context.Context, tx *sql.Tx, actorId, action string, before, after *leaveSnapshot) error {
+	event := auditEvent{
+		ActorId:   actorId,
+		Action:    action,
+		Before:    before.json(),
+		After:     after.json(),
+		CreatedAt: time.Now().UTC().Truncate(time.Microsecond).Format(time.RFC3339Nano),
+	}
+	for _, snapshot := range []*leaveSnapshot{before, after} {
+		if snapshot != nil {
+			event.ApplicationId = snapshot.ApplicationId
+			event.ApplicantId = snapshot.EmployeeId
+		}
+	}
+	return d.insertAuditEvent(ctx, tx, event)
+}
+
+// appendRecordAuditEvent records a mutation of a record other than a leave
+// application, such as a comp-off or a blackout period, inside the same
+// transaction as the mutation. before and after are stored as JSON, nil for
+// a record created or removed.
+func (d MysqlDB) appendRecordAuditEvent(ctx context.Context, tx *sql.Tx, subject, subjectId, employeeId, actorId, action string, before, after interface{}) error {
+	if employeeId == "" {
+		employeeId = "0"
+	}
+	event := auditEvent{
+		ApplicationId: "0",
+		ApplicantId:   employeeId,
+		ActorId:       actorId,
+		Action:        action,
+		Before:        recordJSON(before),
+		After:         recordJSON(after),
+		CreatedAt:     time.Now().UTC().Truncate(time.Microsecond).Format(time.RFC3339Nano),
+		Subject:       subject,
+		SubjectId:     subjectId,
+	}
+	return d.insertAuditEvent(ctx, tx, event)
+}
+
+func recordJSON(record interface{}) string {
+	if record == nil {
+		return ""
+	}
+	data, _ := json.Marshal(record)
+	return string(data)
+}
+
+// insertAuditEvent chains event to the latest one and stores it. The chain
+// head row is locked so concurrent writers append one after the other.
+func (d MysqlDB) insertAuditEvent(ctx context.Context, tx *sql.Tx, event auditEvent) error {
+	chainHeadQuery := `SELECT last_hash FROM lm_audit_chain_head WHERE id=1 FOR UPDATE`
+	headCtx, span, end := d.startQuery(ctx, "auditChainHead", "SELECT", "lm_audit_chain_head")
+	defer end()
+	err := tx.QueryRowContext(headCtx, chainHeadQuery).Scan(&event.PrevHash)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return err
+	}
+	event.Hash = event.computeHash()
+
+	insertAuditEventQuery := `
+					INSERT INTO lm_audit_event (
+						application_id,
+						applicant_id,
+						actor_id,
+						action,
+						before_snapshot,
+						after_snapshot,
+						created_at,
+						prev_hash,
+						hash,
+						subject,
+						subject_id)
+					VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, NULLIF(?,""))`
+	insertCtx, span, end := d.startQuery(ctx, "insertAuditEvent", "INSERT", "lm_audit_event")
+	defer end()
+	result, err := tx.ExecContext(insertCtx, insertAuditEventQuery, event.ApplicationId, event.ApplicantId, event.ActorId,
+		event.Action, event.Before, event.After, event.CreatedAt, event.PrevHash, event.Hash, event.Subject, event.SubjectId)
+	tracing.RecordResult(span, result)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return err
+	}
+
+	updateChainHeadQuery := `UPDATE lm_audit_chain_head SET last_hash=? WHERE id=1`
+	updateCtx, span, end := d.startQuery(ctx, "updateAuditChainHead", "UPDATE", "lm_audit_chain_head")
+	defer end()
+	result, err = tx.ExecContext(updateCtx, updateChainHeadQuery, event.Hash)
+	tracing.RecordResult(span, result)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+const selectAuditEventsQuery = `
+					SELECT
+						event_id,
+						application_id,
+						applicant_id,
+						actor_id,
+						action,
+						before_snapshot,
+						after_snapshot,
+						created_at,
+						prev_hash,
+						hash,
+						subject,
+						IFNULL(subject_id,"")
+					FROM lm_audit_event`
+
+func scanAuditEvents(rows *sql.Rows) ([]auditEvent, error) {
+	var events []auditEvent
+	for rows.Next() {
+		event := auditEvent{}
+		err := rows.Scan(
+			&event.EventId,
+			&event.ApplicationId,
+			&event.ApplicantId,
+			&event.ActorId,
+			&event.Action,
+			&event.Before,
+			&event.After,
+			&event.CreatedAt,
+			&event.PrevHash,
+			&event.Hash,
+			&event.Subject,
+			&event.SubjectId)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, rows.Err()
+}
+
+func (d MysqlDB) ListAuditEvents(ctx context.Context, req *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	validate := validator.New()
+	fields := models.ValidateListAuditEvents{
+		EmployeeId: req.EmployeeId,
+	}
+	err := validate.Struct(fields)
+	if err != nil {
+		return &pb.ListAuditEventsResponse{}, errors.New("invalid input")
+	}
+
+	designationId, err := d.getDesignationId(ctx, req.EmployeeId)
+	if err != nil {
+		return &pb.ListAuditEventsResponse{}, err
+	}
+	if designationId != hrId {
+		return &pb.ListAuditEventsResponse{}, errors.New("access denied")
+	}
+
+	var conditions []string
+	var args []interface{}
+	filters := []struct {
+		column string
+		value  string
+	}{
+		{"application_id", req.ApplicationId},
+		{"applicant_id", req.ApplicantId},
+		{"actor_id", req.ActorId},
+		{"subject", req.Subject},
+		{"subject_id", req.SubjectId},
+	}
+	for _, filter := range filters {
+		if filter.value != "" {
+			conditions = append(conditions, filter.column+"=?")
+			args = append(args, filter.value)
+		}
+	}
+	listAuditEventsQuery := selectAuditEventsQuery
+	if len(conditions) > 0 {
+		listAuditEventsQuery = fmt.Sprintf("%v WHERE %v", listAuditEventsQuery, strings.Join(conditions, " AND "))
+	}
+	listAuditEventsQuery += " ORDER BY event_id"
+
+	ctx, span, end := d.startQuery(ctx, "listAuditEvents", "SELECT", "lm_audit_event")
+	defer end()
+	rows, err := d.DB.QueryContext(ctx, listAuditEventsQuery, args...)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return &pb.ListAuditEventsResponse{}, err
+	}
+	defer rows.Close()
+	events, err := scanAuditEvents(rows)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return &pb.ListAuditEventsResponse{}, err
+	}
+	response := &pb.ListAuditEventsResponse{}
+	for _, event := range events {
+		response.AuditEvents = append(response.AuditEvents, &pb.AuditEvent{
+			EventId:       fmt.Sprint(event.EventId),
+			ApplicationId: event.ApplicationId,
+			ApplicantId:   event.ApplicantId,
+			ActorId:       event.ActorId,
+			Action:        event.Action,
+			Before:        event.Before,
+			After:         event.After,
+			CreatedAt:     event.CreatedAt,
+			PrevHash:      event.PrevHash,
+			Hash:          event.Hash,
+			Subject:       event.Subject,
+			SubjectId:     event.SubjectId,
+		})
+	}
+	return response, nil
+}
+
+// VerifyAuditTrail walks the whole audit trail and recomputes the hash chain.
+// It returns the number of events checked, or an *AuditTamperError for the
+// first event that was modified, removed or inserted out of band.
+func (d MysqlDB) VerifyAuditTrail(ctx context.Context) (int, error) {
+	queryCtx, span, end := d.startQuery(ctx, "verifyAuditTrail", "SELECT", "lm_audit_event")
+	defer end()
+	rows, err := d.DB.QueryContext(queryCtx, selectAuditEventsQuery+" ORDER BY event_id")
+	if err != nil {
+		tracing.RecordError(span, err)
+		return 0, err
+	}
+	defer rows.Close()
+	events, err := scanAuditEvents(rows)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return 0, err
+	}
+
+	prevHash := ""
+	for _, event := range events {
+		if event.PrevHash != prevHash {
+			return 0, &AuditTamperError{EventId: event.EventId, Reason: "previous hash does not match, an earlier event was removed or changed"}
+		}
+		if event.computeHash() != event.Hash {
+			return 0, &AuditTamperError{EventId: event.EventId, Reason: "hash does not match event contents"}
+		}
+		prevHash = event.Hash
+	}
+
+	var lastHash string
+	chainHeadQuery := `SELECT last_hash FROM lm_audit_chain_head WHERE id=1`
+	headCtx, span, end := d.startQuery(ctx, "auditChainHead", "SELECT", "lm_audit_chain_head")
+	defer end()
+	err = d.DB.QueryRowContext(headCtx, chainHeadQuery).Scan(&lastHash)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return 0, err
+	}
+	if lastHash != prevHash {
+		var eventId int64
+		if len(events) > 0 {
+			eventId = events[len(events)-1].EventId
+		}
+		return 0, &AuditTamperError{EventId: eventId, Reason: "chain head does not match the last event, later events were removed"}
+	}
+	return len(events), nil
+}
@@ -0,0 +1,300 @@
+package database
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"leavemanagement/lm-db-service/pkg/pb"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+)
+
+var leaveSnapshotColumns = []string{
+	"application_id",
+	"employee_id",
+	"leave_type_id",
+	"date_of_application",
+	"from_date",
+	"to_date",
+	"no_of_days",
+	"leave_balance",
+	"leave_status",
+	"comment",
+	"date_of_approval",
+	"version",
+	"loss_of_pay_days",
+	"advance_days",
+	"sandwich_before",
+	"sandwich_after",
+	"advance_approved_by",
+	"deleted_at",
+	"deleted_by",
+	"delete_reason",
+	"coverage_override_reason",
+}
+
+var auditEventColumns = []string{
+	"event_id",
+	"application_id",
+	"applicant_id",
+	"actor_id",
+	"action",
+	"before_snapshot",
+	"after_snapshot",
+	"created_at",
+	"prev_hash",
+	"hash",
+	"subject",
+	"subject_id",
+}
+
+const leaveSnapshotQuery = `FROM lm_leave_application\s+WHERE application_id=\?\s+FOR UPDATE`
+
+func leaveSnapshotRows(applicationId, employeeId, leaveStatus string) *sqlmock.Rows {
+	return deletedLeaveSnapshotRows(applicationId, employeeId, leaveStatus, "")
+}
+
+// deletedLeaveSnapshotRows returns a soft deleted application when deletedAt
+// is set.
+func deletedLeaveSnapshotRows(applicationId, employeeId, leaveStatus, deletedAt string) *sqlmock.Rows {
+	return snapshotRows(applicationId, employeeId, leaveStatus, deletedAt, "0", "")
+}
+
+// advanceLeaveSnapshotRows returns an application with advanceDays taken in
+// advance, approved by advanceApprovedBy when set.
+func advanceLeaveSnapshotRows(applicationId, employeeId, leaveStatus, advanceDays, advanceApprovedBy string) *sqlmock.Rows {
+	return snapshotRows(applicationId, employeeId, leaveStatus, "", advanceDays, advanceApprovedBy)
+}
+
+func snapshotRows(applicationId, employeeId, leaveStatus, deletedAt, advanceDays, advanceApprovedBy string) *sqlmock.Rows {
+	deletedBy, deleteReason := "", ""
+	if deletedAt != "" {
+		deletedBy, deleteReason = "7", "duplicate"
+	}
+	return sqlmock.NewRows(leaveSnapshotColumns).AddRow(
+		applicationId,
+		employeeId,
+		"1",
+		"2022-04-07T23:19:53+05:30",
+		"2022-04-20T00:00:00+05:30",
+		"2022-04-21T00:00:00+05:30",
+		"2",
+		"1",
+		leaveStatus,
+		"Fever",
+		"N/A",
+		"1",
+		"0",
+		advanceDays,
+		"0",
+		"0",
+		advanceApprovedBy,
+		deletedAt,
+		deletedBy,
+		deleteReason,
+		"",
+	)
+}
+
+func expectLeaveSnapshot(mock sqlmock.Sqlmock, applicationId, employeeId, leaveStatus string) {
+	mock.ExpectQuery(leaveSnapshotQuery).WithArgs(applicationId).
+		WillReturnRows(leaveSnapshotRows(applicationId, employeeId, leaveStatus))
+}
+
+func expectAuditEvent(mock sqlmock.Sqlmock, applicationId, applicantId, actorId, action string) {
+	mock.ExpectQuery(`SELECT last_hash FROM lm_audit_chain_head WHERE id=1 FOR UPDATE`).
+		WillReturnRows(sqlmock.NewRows([]string{"last_hash"}).AddRow(""))
+	mock.ExpectExec(`INSERT INTO lm_audit_event`).
+		WithArgs(applicationId, applicantId, actorId, action, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), "", sqlmock.AnyArg(), "", "").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`UPDATE lm_audit_chain_head SET last_hash=\? WHERE id=1`).WithArgs(sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+// expectRecordAuditEvent expects the audit event of a record other than a
+// leave application.
+func expectRecordAuditEvent(mock sqlmock.Sqlmock, subject, subjectId, applicantId, actorId, action string) {
+	mock.ExpectQuery(`SELECT last_hash FROM lm_audit_chain_head WHERE id=1 FOR UPDATE`).
+		WillReturnRows(sqlmock.NewRows([]string{"last_hash"}).AddRow(""))
+	mock.ExpectExec(`INSERT INTO lm_audit_event`).
+		WithArgs("0", applicantId, actorId, action, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), "", sqlmock.AnyArg(), subject, subjectId).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`UPDATE lm_audit_chain_head SET last_hash=\? WHERE id=1`).WithArgs(sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+// testAuditChain returns four correctly chained events, the last of an
+// employee exit rather than an application.
+func testAuditChain() []auditEvent {
+	events := []auditEvent{
+		{EventId: 1, ApplicationId: "2", ApplicantId: "1", ActorId: "1", Action: auditApply, After: `{"applicationId":"2"}`, CreatedAt: "2022-04-07T17:49:53Z"},
+		{EventId: 2, ApplicationId: "2", ApplicantId: "1", ActorId: "8", Action: auditChangeStatus, Before: `{"applicationId":"2"}`, After: `{"applicationId":"2","leaveStatus":"1"}`, CreatedAt: "2022-04-08T10:00:00Z"},
+		{EventId: 3, ApplicationId: "2", ApplicantId: "1", ActorId: "7", Action: auditDelete, Before: `{"applicationId":"2","leaveStatus":"1"}`, CreatedAt: "2022-04-09T10:00:00Z"},
+		{EventId: 4, ApplicationId: "0", ApplicantId: "1", ActorId: "2", Action: auditExit, Before: `{"employeeId":"1"}`, After: `{"employeeId":"1","dateOfExit":"2022-06-30"}`, CreatedAt: "2022-04-10T10:00:00Z", Subject: auditSubjectEmployee, SubjectId: "1"},
+	}
+	prevHash := ""
+	for i := range events {
+		events[i].PrevHash = prevHash
+		events[i].Hash = events[i].computeHash()
+		prevHash = events[i].Hash
+	}
+	return events
+}
+
+func auditEventRows(events []auditEvent) *sqlmock.Rows {
+	rows := sqlmock.NewRows(auditEventColumns)
+	for _, event := range events {
+		rows.AddRow(event.EventId, event.ApplicationId, event.ApplicantId, event.ActorId, event.Action,
+			event.Before, event.After, event.CreatedAt, event.PrevHash, event.Hash, event.Subject, event.SubjectId)
+	}
+	return rows
+}
+
+func TestMySqlMock_VerifyAuditTrail(t *testing.T) {
+	tests := []struct {
+		description string
+		tamper      func(events []auditEvent) []auditEvent
+		lastHash    func(events []auditEvent) string
+		isError     bool
+	}{
+		{
+			description: "intact",
+			tamper:      func(events []auditEvent) []auditEvent { return events },
+			isError:     false,
+		},
+		{
+			description: "edited snapshot",
+			tamper: func(events []auditEvent) []auditEvent {
+				events[1].After = `{"applicationId":"2","leaveStatus":"2"}`
+				return events
+			},
+			isError: true,
+		},
+		{
+			description: "edited exit date",
+			tamper: func(events []auditEvent) []auditEvent {
+				events[3].After = `{"employeeId":"1","dateOfExit":"2022-12-31"}`
+				return events
+			},
+			isError: true,
+		},
+		{
+			description: "removed event",
+			tamper: func(events []auditEvent) []auditEvent {
+				return append(events[:1], events[2:]...)
+			},
+			isError: true,
+		},
+		{
+			description: "removed last event",
+			tamper: func(events []auditEvent) []auditEvent {
+				return events[:3]
+			},
+			lastHash: func(events []auditEvent) string { return testAuditChain()[3].Hash },
+			isError:  true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			testDB, mock := getTestMysqlDB(t)
+			events := test.tamper(testAuditChain())
+			lastHash := events[len(events)-1].Hash
+			if test.lastHash != nil {
+				lastHash = test.lastHash(events)
+			}
+			mock.ExpectQuery(`FROM lm_audit_event ORDER BY event_id`).WillReturnRows(auditEventRows(events))
+			mock.ExpectQuery(`SELECT last_hash FROM lm_audit_chain_head WHERE id=1`).
+				WillReturnRows(sqlmock.NewRows([]string{"last_hash"}).AddRow(lastHash))
+			count, err := testDB.VerifyAuditTrail(context.Background())
+			if test.isError == false {
+				if err != nil {
+					t.Errorf("got error %v: want error: %v", err, false)
+				}
+				if count != 4 {
+					t.Errorf("expected %v: got %v", 4, count)
+				}
+			} else if test.isError == true {
+				var tamperErr *AuditTamperError
+				if !errors.As(err, &tamperErr) {
+					t.Errorf("got error %v: want error: %v", err, "AuditTamperError")
+				}
+			}
+		})
+	}
+}
+
+func TestMySqlMock_ListAuditEvents(t *testing.T) {
+	tests := []struct {
+		description   string
+		request       *pb.ListAuditEventsRequest
+		designationId string
+		expectedSql   string
+		args          []driver.Value
+		isError       bool
+	}{
+		{
+			description:   "all events",
+			request:       &pb.ListAuditEventsRequest{EmployeeId: "7"},
+			designationId: "2",
+			expectedSql:   `FROM lm_audit_event ORDER BY event_id`,
+			isError:       false,
+		},
+		{
+			description:   "filtered",
+			request:       &pb.ListAuditEventsRequest{EmployeeId: "7", ApplicationId: "2", ActorId: "8"},
+			designationId: "2",
+			expectedSql:   `FROM lm_audit_event WHERE application_id=\? AND actor_id=\? ORDER BY event_id`,
+			args:          []driver.Value{"2", "8"},
+			isError:       false,
+		},
+		{
+			description:   "filtered by subject",
+			request:       &pb.ListAuditEventsRequest{EmployeeId: "7", Subject: auditSubjectEmployee, SubjectId: "1"},
+			designationId: "2",
+			expectedSql:   `FROM lm_audit_event WHERE subject=\? AND subject_id=\? ORDER BY event_id`,
+			args:          []driver.Value{auditSubjectEmployee, "1"},
+			isError:       false,
+		},
+		{
+			description:   "access denied",
+			request:       &pb.ListAuditEventsRequest{EmployeeId: "8"},
+			designationId: "3",
+			isError:       true,
+		},
+		{
+			description: "invalid input",
+			request:     &pb.ListAuditEventsRequest{},
+			isError:     true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			testDB, mock := getTestMysqlDB(t)
+			if test.designationId != "" {
+				mock.ExpectQuery(`SELECT designation_id FROM lm_employee where employee_id=\?`).WithArgs(test.request.EmployeeId).
+					WillReturnRows(sqlmock.NewRows([]string{"designation_id"}).AddRow(test.designationId))
+			}
+			if test.expectedSql != "" {
+				mock.ExpectQuery(test.expectedSql).WithArgs(test.args...).WillReturnRows(auditEventRows(testAuditChain()))
+			}
+			actual, err := testDB.ListAuditEvents(context.Background(), test.request)
+			if test.isError == false {
+				if err != nil {
+					t.Errorf("got error %v: want error: %v", err, false)
+				}
+				if len(actual.AuditEvents) != 4 || actual.AuditEvents[2].Action != auditDelete ||
+					actual.AuditEvents[3].Subject != auditSubjectEmployee {
+					t.Errorf("expected %v: got %v", testAuditChain(), actual.AuditEvents)
+				}
+			} else if test.isError == true {
+				if err == nil {
+					t.Errorf("got error %v: want error: %v", err, true)
+				}
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
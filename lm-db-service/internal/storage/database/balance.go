@@ -0,0 +1,239 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"leavemanagement/lm-db-service/internal/policy"
+	"leavemanagement/lm-db-service/internal/tracing"
+	"leavemanagement/lm-db-service/models"
+	"leavemanagement/lm-db-service/pkg/pb"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator"
+)
+
+// GetLeaveBalances returns the balance of every leave type of an employee for
+// a leave year. An application counts towards the leave year its from date
+// falls in. Days taken in advance are recovered from the leave years after, so
+// available goes below zero until then. Entitlements are pro-rated for
+// employees who joined or left during the leave year, except comp-off, whose
+// entitlement is the credits earned in the leave year that have not lapsed.
+func (d MysqlDB) GetLeaveBalances(ctx context.Context, req *pb.GetLeaveBalancesRequest) (*pb.GetLeaveBalancesResponse, error) {
+	targetEmployeeId := req.TargetEmployeeId
+	if targetEmployeeId == "" {
+		targetEmployeeId = req.EmployeeId
+	}
+	year := req.Year
+	if year == "" {
+		year = strconv.Itoa(time.Now().Year())
+	}
+	validate := validator.New()
+	fields := models.ValidateGetLeaveBalances{
+		EmployeeId:       req.EmployeeId,
+		TargetEmployeeId: targetEmployeeId,
+		Year:             year,
+	}
+	err := validate.Struct(fields)
+	if err != nil {
+		return &pb.GetLeaveBalancesResponse{}, errors.New("invalid input")
+	}
+
+	err = d.canViewEmployee(ctx, req.EmployeeId, targetEmployeeId)
+	if err != nil {
+		return &pb.GetLeaveBalancesResponse{}, err
+	}
+
+	leaveBalances, err := d.leaveBalances(ctx, targetEmployeeId, year)
+	if err != nil {
+		return &pb.GetLeaveBalancesResponse{}, err
+	}
+	balances := &pb.GetLeaveBalancesResponse{
+		EmployeeId: targetEmployeeId,
+		Year:       year,
+	}
+	for _, balance := range leaveBalances {
+		balances.LeaveBalances = append(balances.LeaveBalances, &pb.LeaveBalance{
+			LeaveTypeId:      balance.leaveTypeId,
+			LeaveName:        balance.leaveName,
+			Entitlement:      strconv.Itoa(balance.entitlement),
+			Taken:            strconv.Itoa(balance.taken),
+			Scheduled:        strconv.Itoa(balance.scheduled),
+			Pending:          strconv.Itoa(balance.pending),
+			Available:        strconv.Itoa(balance.available()),
+			Encashed:         strconv.Itoa(balance.encashed),
+			LossOfPay:        strconv.Itoa(balance.lossOfPay),
+			Advance:          strconv.Itoa(balance.advance),
+			AdvanceRecovered: strconv.Itoa(balance.advanceRecovered),
+			Adjusted:         strconv.Itoa(balance.adjusted),
+		})
+	}
+	return balances, nil
+}
+
+// leaveBalance is the balance of a leave type of an employee for a leave
+// year, in days.
+type leaveBalance struct {
+	leaveTypeId      string
+	leaveName        string
+	entitlement      int
+	taken            int
+	scheduled        int
+	pending          int
+	encashed         int
+	lossOfPay        int
+	advance          int
+	advanceRecovered int
+	adjusted         int
+}
+
+// available returns the days of the entitlement left to apply for.
+func (b leaveBalance) available() int {
+	return b.entitlement + b.adjusted - b.advanceRecovered - b.taken - b.scheduled - b.pending - b.encashed
+}
+
+// compOffEarnedQuery counts the comp-off credits of an employee earned in a
+// leave year that have not lapsed.
+const compOffEarnedQuery = `
+	SELECT COUNT(*) 
+	FROM lm_comp_off 
+	WHERE lm_comp_off.employee_id=? 
+		AND lm_comp_off.status IN (?, ?) 
+		AND worked_date BETWEEN ? AND ?`
+
+// encashedDaysQuery sums the days of a leave type an employee encashed, or
+// asked to, for a leave year.
+const encashedDaysQuery = `
+	SELECT IFNULL(SUM(days),0) 
+	FROM lm_encashment 
+	WHERE lm_encashment.employee_id=? 
+		AND lm_encashment.leave_type_id=lm_leave_type.leave_type_id 
+		AND lm_encashment.status<>? 
+		AND lm_encashment.year=?`
+
+// advanceRecoveredQuery sums the days of a leave type an employee took in
+// advance in the previous leave year. Those advanced earlier and not
+// recovered yet are added by getUnrecoveredAdvance.
+const advanceRecoveredQuery = `
+	SELECT IFNULL(SUM(advance_days),0) 
+	FROM lm_leave_application AS advanced 
+	WHERE advanced.employee_id=? 
+		AND advanced.leave_type_id=lm_leave_type.leave_type_id 
+		AND advanced.leave_status<>? 
+		AND advanced.deleted_at IS NULL 
+		AND advanced.from_date BETWEEN ? AND ?`
+
+// adjustedDaysQuery sums the adjustments HR made to the balance of a leave
+// type of an employee for a leave year.
+const adjustedDaysQuery = `
+	SELECT IFNULL(SUM(days),0) 
+	FROM lm_balance_adjustment 
+	WHERE lm_balance_adjustment.employee_id=? 
+		AND lm_balance_adjustment.leave_type_id=lm_leave_type.leave_type_id 
+		AND lm_balance_adjustment.year=?`
+
+// leaveBalancesQuery reads the balance columns of every leave type of an
+// employee for a leave year, in the order of leaveBalance. Its arguments are
+// appended in the same order by leaveBalances.
+const leaveBalancesQuery = `
+	SELECT 
+		lm_leave_type.leave_type_id, 
+		leave_name, 
+		IF(comp_off_validity_days IS NULL, proration_rounding, ?), 
+		IF(comp_off_validity_days IS NULL, number_of_days_allowed, (` + compOffEarnedQuery + `)), 
+		IFNULL(SUM(CASE WHEN leave_status=? AND from_date<=? THEN no_of_days-loss_of_pay_days END),0), 
+		IFNULL(SUM(CASE WHEN leave_status=? AND from_date>? THEN no_of_days-loss_of_pay_days END),0), 
+		IFNULL(SUM(CASE WHEN leave_status=? THEN no_of_days-loss_of_pay_days END),0), 
+		(` + encashedDaysQuery + `), 
+		IFNULL(SUM(CASE WHEN leave_status IN (?, ?) THEN loss_of_pay_days END),0), 
+		IFNULL(SUM(CASE WHEN leave_status IN (?, ?) THEN advance_days END),0), 
+		(` + advanceRecoveredQuery + `), 
+		(` + adjustedDaysQuery + `) 
+	FROM lm_leave_type 
+	LEFT JOIN lm_leave_application 
+		ON lm_leave_application.leave_type_id=lm_leave_type.leave_type_id 
+		AND employee_id=? 
+		AND deleted_at IS NULL 
+		AND from_date BETWEEN ? AND ? 
+	GROUP BY lm_leave_type.leave_type_id, leave_name, proration_rounding, number_of_days_allowed, comp_off_validity_days 
+	ORDER BY lm_leave_type.leave_type_id`
+
+// leaveBalances returns the balance of every leave type of an employee for a
+// leave year.
+func (d MysqlDB) leaveBalances(ctx context.Context, targetEmployeeId, year string) ([]leaveBalance, error) {
+	joining, exit, err := d.getServiceDates(ctx, targetEmployeeId)
+	if err != nil {
+		return nil, err
+	}
+
+	today := time.Now().Format(dateFormat)
+	yearStart, yearEnd := leaveYear(year)
+	previousYearStart, previousYearEnd := leaveYear(previousLeaveYear(yearStart))
+	// rounding and the comp-off entitlement
+	args := []interface{}{string(policy.RoundNone), targetEmployeeId, approved, compOffUsed, yearStart, yearEnd}
+	// taken, scheduled and pending
+	args = append(args, approved, today, approved, today, pending)
+	// encashed
+	args = append(args, targetEmployeeId, declined, year)
+	// loss of pay and advance
+	args = append(args, approved, pending, approved, pending)
+	// advance recovered
+	args = append(args, targetEmployeeId, declined, previousYearStart, previousYearEnd)
+	// adjusted
+	args = append(args, targetEmployeeId, year)
+	// the applications joined
+	args = append(args, targetEmployeeId, yearStart, yearEnd)
+	ctx, span, end := d.startQuery(ctx, "leaveBalances", "SELECT", "lm_leave_type")
+	defer end()
+	rows, err := d.DB.QueryContext(ctx, leaveBalancesQuery, args...)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	defer rows.Close()
+	var balances []leaveBalance
+	for rows.Next() {
+		var balance leaveBalance
+		var rounding string
+		err = rows.Scan(&balance.leaveTypeId, &balance.leaveName, &rounding, &balance.entitlement, &balance.taken,
+			&balance.scheduled, &balance.pending, &balance.encashed, &balance.lossOfPay, &balance.advance,
+			&balance.advanceRecovered, &balance.adjusted)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return nil, err
+		}
+		balance.entitlement = proRated(balance.entitlement, rounding, yearStart, joining, exit)
+		balances = append(balances, balance)
+	}
+	if err = rows.Err(); err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	for i := range balances {
+		unrecovered, err := d.getUnrecoveredAdvance(ctx, d.DB, targetEmployeeId, balances[i].leaveTypeId, yearStart)
+		if err != nil {
+			return nil, err
+		}
+		balances[i].advanceRecovered += unrecovered
+	}
+	return balances, nil
+}
+
+// getServiceDates returns the joining and exit dates of an employee, nil when
+// not known.
+func (d MysqlDB) getServiceDates(ctx context.Context, employeeId string) (*time.Time, *time.Time, error) {
+	var joining, exit sql.NullString
+	serviceDatesQuery := `SELECT date_of_joining, date_of_exit FROM lm_employee WHERE employee_id=?`
+	ctx, span, end := d.startQuery(ctx, "serviceDates", "SELECT", "lm_employee")
+	defer end()
+	err := d.DB.QueryRowContext(ctx, serviceDatesQuery, employeeId).Scan(&joining, &exit)
+	if err != nil {
+		tracing.RecordError(span, err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, errors.New("employee not found")
+		}
+		return nil, nil, err
+	}
+	return nullableDate(joining), nullableDate(exit), nil
+}
@@ -0,0 +1,143 @@
+package database
+
+import (
+	"context"
+	"leavemanagement/lm-db-service/pkg/pb"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"google.golang.org/protobuf/proto"
+)
+
+// expectDesignation expects the designation lookup of employeeId.
+func expectDesignation(mock sqlmock.Sqlmock, employeeId, designationId string) {
+	mock.ExpectQuery(`SELECT designation_id FROM lm_employee where employee_id=\?`).WithArgs(employeeId).
+		WillReturnRows(sqlmock.NewRows([]string{"designation_id"}).AddRow(designationId))
+}
+
+// expectManager expects the manager lookup of employeeId.
+func expectManager(mock sqlmock.Sqlmock, employeeId, managerId string) {
+	mock.ExpectQuery(`SELECT IFNULL\(manager_id,""\) FROM lm_employee where employee_id=\?`).WithArgs(employeeId).
+		WillReturnRows(sqlmock.NewRows([]string{"manager_id"}).AddRow(managerId))
+}
+
+var leaveBalanceColumns = []string{"leave_type_id", "leave_name", "proration_rounding", "number_of_days_allowed", "taken",
+	"scheduled", "pending", "encashed", "loss_of_pay", "advance", "advance_recovered", "adjusted"}
+
+func TestMySqlMock_GetLeaveBalances(t *testing.T) {
+	tests := []struct {
+		description string
+		request     *pb.GetLeaveBalancesRequest
+		access      func(mock sqlmock.Sqlmock)
+		isError     bool
+	}{
+		{
+			description: "own balances",
+			request:     &pb.GetLeaveBalancesRequest{EmployeeId: "1", Year: "2022"},
+			access:      func(mock sqlmock.Sqlmock) {},
+			isError:     false,
+		},
+		{
+			description: "hr",
+			request:     &pb.GetLeaveBalancesRequest{EmployeeId: "7", TargetEmployeeId: "1", Year: "2022"},
+			access: func(mock sqlmock.Sqlmock) {
+				expectDesignation(mock, "7", hrId)
+			},
+			isError: false,
+		},
+		{
+			description: "manager of the employee",
+			request:     &pb.GetLeaveBalancesRequest{EmployeeId: "8", TargetEmployeeId: "1", Year: "2022"},
+			access: func(mock sqlmock.Sqlmock) {
+				expectDesignation(mock, "8", managerId)
+				expectManager(mock, "1", "8")
+			},
+			isError: false,
+		},
+		{
+			description: "manager of another team",
+			request:     &pb.GetLeaveBalancesRequest{EmployeeId: "9", TargetEmployeeId: "1", Year: "2022"},
+			access: func(mock sqlmock.Sqlmock) {
+				expectDesignation(mock, "9", managerId)
+				expectManager(mock, "1", "8")
+			},
+			isError: true,
+		},
+		{
+			description: "another employee",
+			request:     &pb.GetLeaveBalancesRequest{EmployeeId: "2", TargetEmployeeId: "1", Year: "2022"},
+			access: func(mock sqlmock.Sqlmock) {
+				expectDesignation(mock, "2", employeeId)
+			},
+			isError: true,
+		},
+		{
+			description: "invalid year",
+			request:     &pb.GetLeaveBalancesRequest{EmployeeId: "1", Year: "22"},
+			isError:     true,
+		},
+	}
+	leaveBalancesQuery := `
+				FROM lm_leave_type 
+				LEFT JOIN lm_leave_application 
+					ON lm_leave_application.leave_type_id=lm_leave_type.leave_type_id 
+					AND employee_id=\? 
+					AND deleted_at IS NULL 
+					AND from_date BETWEEN \? AND \?`
+	expected := &pb.GetLeaveBalancesResponse{
+		EmployeeId: "1",
+		Year:       "2022",
+		LeaveBalances: []*pb.LeaveBalance{
+			{LeaveTypeId: "1", LeaveName: "Sick", Entitlement: "12", Taken: "3", Scheduled: "2", Pending: "1", Available: "6", Encashed: "0", LossOfPay: "2",
+				Advance: "0", AdvanceRecovered: "0", Adjusted: "0"},
+			{LeaveTypeId: "2", LeaveName: "Casual", Entitlement: "10", Taken: "0", Scheduled: "0", Pending: "0", Available: "6", Encashed: "4", LossOfPay: "0",
+				Advance: "0", AdvanceRecovered: "3", Adjusted: "3"},
+			{LeaveTypeId: "3", LeaveName: "Earned", Entitlement: "5", Taken: "4", Scheduled: "2", Pending: "1", Available: "-2", Encashed: "0", LossOfPay: "0",
+				Advance: "2", AdvanceRecovered: "0", Adjusted: "0"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			testDB, mock := getTestMysqlDB(t)
+			if test.access != nil {
+				test.access(mock)
+			}
+			if !test.isError {
+				expectServiceDates(mock, "1", nil, nil)
+				rows := sqlmock.NewRows(leaveBalanceColumns).
+					AddRow("1", "Sick", "NEAREST", 12, 3, 2, 1, 0, 2, 0, 0, 0).
+					AddRow("2", "Casual", "NEAREST", 10, 0, 0, 0, 4, 0, 0, 2, 3).
+					AddRow("3", "Earned", "NEAREST", 5, 4, 2, 1, 0, 0, 2, 0, 0)
+				mock.ExpectQuery(leaveBalancesQuery).
+					WithArgs("NONE", "1", approved, compOffUsed, "2022-01-01", "2022-12-31",
+						approved, sqlmock.AnyArg(), approved, sqlmock.AnyArg(), pending, "1", declined, "2022", approved, pending, approved, pending,
+						"1", declined, "2021-01-01", "2021-12-31", "1", "2022", "1", "2022-01-01", "2022-12-31").
+					WillReturnRows(rows)
+				expectAdvancesBefore(mock, "1", "1", "2021-01-01")
+				// Of the 4 days of Casual advanced in 2020, 3 were recovered
+				// in 2021 and the last is recovered in 2022.
+				mock.ExpectQuery(advancesBeforeQuery).WithArgs("1", "2", declined, "2021-01-01").
+					WillReturnRows(sqlmock.NewRows([]string{"year", "advance_days"}).AddRow("2020", 4))
+				expectAllowedDays(mock, "1", "2", 3)
+				expectAdjustedDays(mock, "1", "2", "2021", 0)
+				expectAdvancesBefore(mock, "1", "3", "2021-01-01")
+			}
+			actual, err := testDB.GetLeaveBalances(context.Background(), test.request)
+			if test.isError == false {
+				if err != nil {
+					t.Errorf("got error %v: want error: %v", err, false)
+				}
+				if !proto.Equal(actual, expected) {
+					t.Errorf("expected %v: got %v", expected, actual)
+				}
+			} else if test.isError == true {
+				if err == nil {
+					t.Errorf("got error %v: want error: %v", err, true)
+				}
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
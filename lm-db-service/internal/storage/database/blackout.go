@@ -0,0 +1,334 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"leavemanagement/lm-db-service/internal/storage/validation"
+	"leavemanagement/lm-db-service/internal/tracing"
+	"leavemanagement/lm-db-service/models"
+	"leavemanagement/lm-db-service/pkg/pb"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	blackoutBlock = "BLOCK"
+	blackoutWarn  = "WARN"
+)
+
+// blackoutColumns are read by scanBlackoutPeriod.
+const blackoutColumns = `
+						blackout_id,
+						name,
+						from_date,
+						to_date,
+						IFNULL(lm_blackout_period.designation_id,""),
+						IFNULL(lm_blackout_period.manager_id,""),
+						IFNULL(lm_blackout_period.leave_type_id,""),
+						exempt_leave_type_ids,
+						enforcement`
+
+func scanBlackoutPeriod(rows *sql.Rows) (*pb.BlackoutPeriod, error) {
+	period := &pb.BlackoutPeriod{}
+	var exemptLeaveTypeIds string
+	err := rows.Scan(
+		&period.BlackoutId,
+		&period.Name,
+		&period.FromDate,
+		&period.ToDate,
+		&period.DesignationId,
+		&period.ManagerId,
+		&period.LeaveTypeId,
+		&exemptLeaveTypeIds,
+		&period.Enforcement)
+	if err != nil {
+		return nil, err
+	}
+	period.FromDate, period.ToDate = dateOnly(period.FromDate), dateOnly(period.ToDate)
+	if exemptLeaveTypeIds != "" {
+		period.ExemptLeaveTypeIds = strings.Split(exemptLeaveTypeIds, ",")
+	}
+	return period, nil
+}
+
+func blackoutDescription(period *pb.BlackoutPeriod) string {
+	return fmt.Sprintf("leave overlaps blackout period %s (%s to %s)", period.Name, period.FromDate, period.ToDate)
+}
+
+// CreateBlackoutPeriod adds a blackout period, only HR has access to it.
+func (d MysqlDB) CreateBlackoutPeriod(ctx context.Context, req *pb.CreateBlackoutPeriodRequest) (*pb.CreateBlackoutPeriodResponse, error) {
+	period := req.GetBlackoutPeriod()
+	if period == nil {
+		return &pb.CreateBlackoutPeriodResponse{}, errors.New("invalid input")
+	}
+	enforcement := period.Enforcement
+	if enforcement == "" {
+		enforcement = blackoutBlock
+	}
+	validate := validator.New()
+	fields := models.ValidateCreateBlackoutPeriod{
+		EmployeeId:         req.EmployeeId,
+		Name:               period.Name,
+		FromDate:           period.FromDate,
+		ToDate:             period.ToDate,
+		DesignationId:      period.DesignationId,
+		ManagerId:          period.ManagerId,
+		LeaveTypeId:        period.LeaveTypeId,
+		ExemptLeaveTypeIds: period.ExemptLeaveTypeIds,
+		Enforcement:        enforcement,
+	}
+	err := validate.Struct(fields)
+	if err != nil {
+		return &pb.CreateBlackoutPeriodResponse{}, errors.New("invalid input")
+	}
+	err = validation.ValidateFromDate(period.FromDate)
+	if err != nil {
+		return &pb.CreateBlackoutPeriodResponse{}, err
+	}
+	err = validation.ValidateToDate(period.ToDate)
+	if err != nil {
+		return &pb.CreateBlackoutPeriodResponse{}, err
+	}
+	fromDate, err := time.Parse(dateFormat, period.FromDate)
+	if err != nil {
+		return &pb.CreateBlackoutPeriodResponse{}, errors.New("write date in YYYY-MM-DD format")
+	}
+	toDate, err := time.Parse(dateFormat, period.ToDate)
+	if err != nil {
+		return &pb.CreateBlackoutPeriodResponse{}, errors.New("write date in YYYY-MM-DD format")
+	}
+	if toDate.Before(fromDate) {
+		return &pb.CreateBlackoutPeriodResponse{}, errors.New("to date is before from date")
+	}
+
+	designationId, err := d.getDesignationId(ctx, req.EmployeeId)
+	if err != nil {
+		return &pb.CreateBlackoutPeriodResponse{}, err
+	}
+	if designationId != hrId {
+		return &pb.CreateBlackoutPeriodResponse{}, errors.New("access denied")
+	}
+
+	created := &pb.BlackoutPeriod{
+		Name:               period.Name,
+		FromDate:           period.FromDate,
+		ToDate:             period.ToDate,
+		DesignationId:      period.DesignationId,
+		ManagerId:          period.ManagerId,
+		LeaveTypeId:        period.LeaveTypeId,
+		ExemptLeaveTypeIds: period.ExemptLeaveTypeIds,
+		Enforcement:        enforcement,
+	}
+	err = d.withTx(ctx, func(tx *sql.Tx) error {
+		createBlackoutPeriodQuery := `
+						INSERT INTO lm_blackout_period (
+							name,
+							from_date,
+							to_date,
+							designation_id,
+							manager_id,
+							leave_type_id,
+							exempt_leave_type_ids,
+							enforcement,
+							created_by,
+							created_at)
+						VALUES (?, ?, ?, NULLIF(?,""), NULLIF(?,""), NULLIF(?,""), ?, ?, ?, ?)`
+		execCtx, span, end := d.startQuery(ctx, "createBlackoutPeriod", "INSERT", "lm_blackout_period")
+		defer end()
+		result, err := tx.ExecContext(execCtx, createBlackoutPeriodQuery, created.Name, created.FromDate, created.ToDate,
+			created.DesignationId, created.ManagerId, created.LeaveTypeId, strings.Join(created.ExemptLeaveTypeIds, ","),
+			created.Enforcement, req.EmployeeId, time.Now().Format(dateTimeFormat))
+		tracing.RecordResult(span, result)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return err
+		}
+		blackoutId, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		created.BlackoutId = strconv.FormatInt(blackoutId, 10)
+		return d.appendRecordAuditEvent(ctx, tx, auditSubjectBlackout, created.BlackoutId, "", req.EmployeeId,
+			auditCreate, nil, created)
+	})
+	if err != nil {
+		return &pb.CreateBlackoutPeriodResponse{}, err
+	}
+	return &pb.CreateBlackoutPeriodResponse{BlackoutId: created.BlackoutId}, nil
+}
+
+// ListBlackoutPeriods returns the blackout periods, optionally only those
+// overlapping fromDate to toDate. Every employee has access to it.
+func (d MysqlDB) ListBlackoutPeriods(ctx context.Context, req *pb.ListBlackoutPeriodsRequest) (*pb.ListBlackoutPeriodsResponse, error) {
+	if req.EmployeeId == "" {
+		return &pb.ListBlackoutPeriodsResponse{}, errors.New("invalid input")
+	}
+	if req.FromDate != "" {
+		err := validation.ValidateFromDate(req.FromDate)
+		if err != nil {
+			return &pb.ListBlackoutPeriodsResponse{}, err
+		}
+	}
+	if req.ToDate != "" {
+		err := validation.ValidateToDate(req.ToDate)
+		if err != nil {
+			return &pb.ListBlackoutPeriodsResponse{}, err
+		}
+	}
+
+	listBlackoutPeriodsQuery := `SELECT` + blackoutColumns + ` FROM lm_blackout_period`
+	var conditions []string
+	var args []interface{}
+	if req.FromDate != "" {
+		conditions = append(conditions, "to_date>=?")
+		args = append(args, req.FromDate)
+	}
+	if req.ToDate != "" {
+		conditions = append(conditions, "from_date<=?")
+		args = append(args, req.ToDate)
+	}
+	if len(conditions) > 0 {
+		listBlackoutPeriodsQuery += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	listBlackoutPeriodsQuery += " ORDER BY from_date, blackout_id"
+
+	ctx, span, end := d.startQuery(ctx, "listBlackoutPeriods", "SELECT", "lm_blackout_period")
+	defer end()
+	rows, err := d.DB.QueryContext(ctx, listBlackoutPeriodsQuery, args...)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return &pb.ListBlackoutPeriodsResponse{}, err
+	}
+	defer rows.Close()
+	periods := &pb.ListBlackoutPeriodsResponse{}
+	for rows.Next() {
+		period, err := scanBlackoutPeriod(rows)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return &pb.ListBlackoutPeriodsResponse{}, err
+		}
+		periods.BlackoutPeriods = append(periods.BlackoutPeriods, period)
+	}
+	if err = rows.Err(); err != nil {
+		tracing.RecordError(span, err)
+		return &pb.ListBlackoutPeriodsResponse{}, err
+	}
+	return periods, nil
+}
+
+// DeleteBlackoutPeriod removes a blackout period, only HR has access to it.
+func (d MysqlDB) DeleteBlackoutPeriod(ctx context.Context, req *pb.DeleteBlackoutPeriodRequest) error {
+	validate := validator.New()
+	fields := models.ValidateDeleteBlackoutPeriod{
+		EmployeeId: req.EmployeeId,
+		BlackoutId: req.BlackoutId,
+	}
+	err := validate.Struct(fields)
+	if err != nil {
+		return errors.New("invalid input")
+	}
+
+	designationId, err := d.getDesignationId(ctx, req.EmployeeId)
+	if err != nil {
+		return err
+	}
+	if designationId != hrId {
+		return errors.New("access denied")
+	}
+
+	return d.withTx(ctx, func(tx *sql.Tx) error {
+		period, err := d.getBlackoutPeriod(ctx, tx, req.BlackoutId)
+		if err != nil {
+			return err
+		}
+		deleteBlackoutPeriodQuery := `DELETE FROM lm_blackout_period WHERE blackout_id=?`
+		execCtx, span, end := d.startQuery(ctx, "deleteBlackoutPeriod", "DELETE", "lm_blackout_period")
+		defer end()
+		result, err := tx.ExecContext(execCtx, deleteBlackoutPeriodQuery, req.BlackoutId)
+		tracing.RecordResult(span, result)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return err
+		}
+		return d.appendRecordAuditEvent(ctx, tx, auditSubjectBlackout, req.BlackoutId, "", req.EmployeeId,
+			auditDelete, period, nil)
+	})
+}
+
+// getBlackoutPeriod reads and locks a blackout period inside tx.
+func (d MysqlDB) getBlackoutPeriod(ctx context.Context, tx *sql.Tx, blackoutId string) (*pb.BlackoutPeriod, error) {
+	blackoutPeriodQuery := `SELECT` + blackoutColumns + ` FROM lm_blackout_period WHERE blackout_id=? FOR UPDATE`
+	ctx, span, end := d.startQuery(ctx, "blackoutPeriod", "SELECT", "lm_blackout_period")
+	defer end()
+	rows, err := tx.QueryContext(ctx, blackoutPeriodQuery, blackoutId)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		if err = rows.Err(); err != nil {
+			tracing.RecordError(span, err)
+			return nil, err
+		}
+		return nil, errors.New("blackout period not found")
+	}
+	period, err := scanBlackoutPeriod(rows)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	return period, nil
+}
+
+// checkBlackoutPeriods returns an error when an application of leaveTypeId
+// by the employee from fromDate to toDate overlaps a blocking blackout
+// period, and warnings for the overlapping periods that only flag it.
+func (d MysqlDB) checkBlackoutPeriods(ctx context.Context, employeeId, leaveTypeId, fromDate, toDate string) ([]string, error) {
+	blackoutPeriodsQuery := `SELECT` + blackoutColumns + `
+					FROM lm_blackout_period
+					INNER JOIN lm_employee ON lm_employee.employee_id=?
+					WHERE from_date<=?
+						AND to_date>=?
+						AND (lm_blackout_period.designation_id IS NULL OR lm_blackout_period.designation_id=lm_employee.designation_id)
+						AND (lm_blackout_period.manager_id IS NULL OR lm_blackout_period.manager_id=lm_employee.manager_id)
+						AND (lm_blackout_period.leave_type_id IS NULL OR lm_blackout_period.leave_type_id=?)
+						AND NOT FIND_IN_SET(?, exempt_leave_type_ids)
+					ORDER BY from_date, blackout_id`
+	ctx, span, end := d.startQuery(ctx, "blackoutPeriods", "SELECT", "lm_blackout_period")
+	defer end()
+	rows, err := d.DB.QueryContext(ctx, blackoutPeriodsQuery, employeeId, toDate, fromDate, leaveTypeId, leaveTypeId)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	defer rows.Close()
+	var blocked, warnings []string
+	for rows.Next() {
+		period, err := scanBlackoutPeriod(rows)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return nil, err
+		}
+		if period.Enforcement == blackoutWarn {
+			warnings = append(warnings, blackoutDescription(period))
+		} else {
+			blocked = append(blocked, blackoutDescription(period))
+		}
+	}
+	if err = rows.Err(); err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	if len(blocked) > 0 {
+		return nil, status.Error(codes.FailedPrecondition, strings.Join(blocked, "; "))
+	}
+	return warnings, nil
+}
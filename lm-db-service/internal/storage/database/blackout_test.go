@@ -0,0 +1,273 @@
+package database
+
+import (
+	"context"
+	"database/sql/driver"
+	"leavemanagement/lm-db-service/pkg/pb"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+var blackoutPeriodColumns = []string{
+	"blackout_id",
+	"name",
+	"from_date",
+	"to_date",
+	"designation_id",
+	"manager_id",
+	"leave_type_id",
+	"exempt_leave_type_ids",
+	"enforcement",
+}
+
+const blackoutPeriodsQuery = `FROM lm_blackout_period\s+INNER JOIN lm_employee ON lm_employee.employee_id=\?`
+
+// expectBlackoutPeriods expects the blackout lookup of an application to find
+// no periods.
+func expectBlackoutPeriods(mock sqlmock.Sqlmock, employeeId, leaveTypeId, fromDate, toDate string) {
+	mock.ExpectQuery(blackoutPeriodsQuery).WithArgs(employeeId, toDate, fromDate, leaveTypeId, leaveTypeId).
+		WillReturnRows(sqlmock.NewRows(blackoutPeriodColumns))
+}
+
+func TestMySqlMock_checkBlackoutPeriods(t *testing.T) {
+	tests := []struct {
+		description string
+		rows        *sqlmock.Rows
+		expected    []string
+		isError     codes.Code
+	}{
+		{
+			description: "none",
+			rows:        sqlmock.NewRows(blackoutPeriodColumns),
+			isError:     codes.OK,
+		},
+		{
+			description: "warn",
+			rows: sqlmock.NewRows(blackoutPeriodColumns).
+				AddRow("1", "Holiday sale", "2022-04-18T00:00:00+05:30", "2022-04-20T00:00:00+05:30", "", "", "", "", blackoutWarn),
+			expected: []string{"leave overlaps blackout period Holiday sale (2022-04-18 to 2022-04-20)"},
+			isError:  codes.OK,
+		},
+		{
+			description: "block",
+			rows: sqlmock.NewRows(blackoutPeriodColumns).
+				AddRow("1", "Holiday sale", "2022-04-18T00:00:00+05:30", "2022-04-20T00:00:00+05:30", "", "", "", "", blackoutWarn).
+				AddRow("2", "Quarter close", "2022-04-21T00:00:00+05:30", "2022-04-25T00:00:00+05:30", "4", "", "", "1,3", blackoutBlock),
+			isError: codes.FailedPrecondition,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			testDB, mock := getTestMysqlDB(t)
+			mock.ExpectQuery(blackoutPeriodsQuery).WithArgs("1", "2022-04-21", "2022-04-20", "2", "2").WillReturnRows(test.rows)
+			actual, err := testDB.checkBlackoutPeriods(context.Background(), "1", "2", "2022-04-20", "2022-04-21")
+			if test.isError == codes.OK {
+				if err != nil {
+					t.Errorf("got error %v: want error: %v", err, false)
+				}
+				if len(actual) != len(test.expected) || (len(actual) > 0 && actual[0] != test.expected[0]) {
+					t.Errorf("expected %v: got %v", test.expected, actual)
+				}
+			} else if status.Code(err) != test.isError {
+				t.Errorf("got error %v: want error: %v", err, test.isError)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestMySqlMock_CreateBlackoutPeriod(t *testing.T) {
+	quarterClose := &pb.BlackoutPeriod{
+		Name:               "Quarter close",
+		FromDate:           "2022-03-25",
+		ToDate:             "2022-04-05",
+		DesignationId:      "4",
+		ExemptLeaveTypeIds: []string{"1", "3"},
+	}
+	tests := []struct {
+		description   string
+		request       *pb.CreateBlackoutPeriodRequest
+		designationId string
+		isError       string
+	}{
+		{
+			description:   "success",
+			request:       &pb.CreateBlackoutPeriodRequest{EmployeeId: "7", BlackoutPeriod: quarterClose},
+			designationId: hrId,
+		},
+		{
+			description:   "access denied",
+			request:       &pb.CreateBlackoutPeriodRequest{EmployeeId: "8", BlackoutPeriod: quarterClose},
+			designationId: managerId,
+			isError:       "access denied",
+		},
+		{
+			description: "to date before from date",
+			request: &pb.CreateBlackoutPeriodRequest{EmployeeId: "7", BlackoutPeriod: &pb.BlackoutPeriod{
+				Name: "Quarter close", FromDate: "2022-04-05", ToDate: "2022-03-25",
+			}},
+			isError: "to date is before from date",
+		},
+		{
+			description: "unpadded date",
+			request: &pb.CreateBlackoutPeriodRequest{EmployeeId: "7", BlackoutPeriod: &pb.BlackoutPeriod{
+				Name: "Quarter close", FromDate: "2022-3-25", ToDate: "2022-04-05",
+			}},
+			isError: "write date in YYYY-MM-DD format",
+		},
+		{
+			description: "invalid enforcement",
+			request: &pb.CreateBlackoutPeriodRequest{EmployeeId: "7", BlackoutPeriod: &pb.BlackoutPeriod{
+				Name: "Quarter close", FromDate: "2022-03-25", ToDate: "2022-04-05", Enforcement: "MAYBE",
+			}},
+			isError: "invalid input",
+		},
+		{
+			description: "invalid input",
+			request:     &pb.CreateBlackoutPeriodRequest{EmployeeId: "7"},
+			isError:     "invalid input",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			testDB, mock := getTestMysqlDB(t)
+			if test.designationId != "" {
+				expectDesignation(mock, test.request.EmployeeId, test.designationId)
+			}
+			if test.isError == "" {
+				mock.ExpectBegin()
+				mock.ExpectExec(`INSERT INTO lm_blackout_period`).
+					WithArgs("Quarter close", "2022-03-25", "2022-04-05", "4", "", "", "1,3", blackoutBlock, "7", time.Now().Format(dateTimeFormat)).
+					WillReturnResult(sqlmock.NewResult(3, 1))
+				expectRecordAuditEvent(mock, auditSubjectBlackout, "3", "0", "7", auditCreate)
+				mock.ExpectCommit()
+			}
+			actual, err := testDB.CreateBlackoutPeriod(context.Background(), test.request)
+			if test.isError == "" {
+				if err != nil {
+					t.Errorf("got error %v: want error: %v", err, false)
+				}
+				if actual.BlackoutId != "3" {
+					t.Errorf("expected %v: got %v", "3", actual.BlackoutId)
+				}
+			} else if err == nil || err.Error() != test.isError {
+				t.Errorf("got error %v: want error: %v", err, test.isError)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestMySqlMock_ListBlackoutPeriods(t *testing.T) {
+	tests := []struct {
+		description string
+		request     *pb.ListBlackoutPeriodsRequest
+		expectedSql string
+		args        []driver.Value
+	}{
+		{
+			description: "all",
+			request:     &pb.ListBlackoutPeriodsRequest{EmployeeId: "1"},
+			expectedSql: `FROM lm_blackout_period ORDER BY from_date, blackout_id`,
+		},
+		{
+			description: "overlapping",
+			request:     &pb.ListBlackoutPeriodsRequest{EmployeeId: "1", FromDate: "2022-04-01", ToDate: "2022-04-30"},
+			expectedSql: `FROM lm_blackout_period WHERE to_date>=\? AND from_date<=\? ORDER BY from_date, blackout_id`,
+			args:        []driver.Value{"2022-04-01", "2022-04-30"},
+		},
+	}
+	expected := &pb.ListBlackoutPeriodsResponse{
+		BlackoutPeriods: []*pb.BlackoutPeriod{{
+			BlackoutId:         "2",
+			Name:               "Quarter close",
+			FromDate:           "2022-03-25",
+			ToDate:             "2022-04-05",
+			DesignationId:      "4",
+			ExemptLeaveTypeIds: []string{"1", "3"},
+			Enforcement:        blackoutBlock,
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			testDB, mock := getTestMysqlDB(t)
+			mock.ExpectQuery(test.expectedSql).WithArgs(test.args...).WillReturnRows(sqlmock.NewRows(blackoutPeriodColumns).
+				AddRow("2", "Quarter close", "2022-03-25T00:00:00+05:30", "2022-04-05T00:00:00+05:30", "4", "", "", "1,3", blackoutBlock))
+			actual, err := testDB.ListBlackoutPeriods(context.Background(), test.request)
+			if err != nil {
+				t.Errorf("got error %v: want error: %v", err, false)
+			}
+			if !proto.Equal(actual, expected) {
+				t.Errorf("expected %v: got %v", expected, actual)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestMySqlMock_DeleteBlackoutPeriod(t *testing.T) {
+	tests := []struct {
+		description   string
+		designationId string
+		rows          *sqlmock.Rows
+		isError       string
+	}{
+		{
+			description:   "success",
+			designationId: hrId,
+			rows: sqlmock.NewRows(blackoutPeriodColumns).
+				AddRow("2", "Quarter close", "2022-03-25", "2022-04-05", "4", "", "", "1,3", blackoutBlock),
+		},
+		{
+			description:   "not found",
+			designationId: hrId,
+			rows:          sqlmock.NewRows(blackoutPeriodColumns),
+			isError:       "blackout period not found",
+		},
+		{
+			description:   "access denied",
+			designationId: employeeId,
+			isError:       "access denied",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			testDB, mock := getTestMysqlDB(t)
+			expectDesignation(mock, "7", test.designationId)
+			if test.designationId == hrId {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`FROM lm_blackout_period WHERE blackout_id=\? FOR UPDATE`).WithArgs("2").WillReturnRows(test.rows)
+				if test.isError == "" {
+					mock.ExpectExec(`DELETE FROM lm_blackout_period WHERE blackout_id=\?`).WithArgs("2").
+						WillReturnResult(sqlmock.NewResult(0, 1))
+					expectRecordAuditEvent(mock, auditSubjectBlackout, "2", "0", "7", auditDelete)
+					mock.ExpectCommit()
+				} else {
+					mock.ExpectRollback()
+				}
+			}
+			err := testDB.DeleteBlackoutPeriod(context.Background(), &pb.DeleteBlackoutPeriodRequest{EmployeeId: "7", BlackoutId: "2"})
+			if test.isError == "" {
+				if err != nil {
+					t.Errorf("got error %v: want error: %v", err, false)
+				}
+			} else if err == nil || err.Error() != test.isError {
+				t.Errorf("got error %v: want error: %v", err, test.isError)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
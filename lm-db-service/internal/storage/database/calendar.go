@@ -0,0 +1,216 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"leavemanagement/lm-db-service/internal/storage/validation"
+	"leavemanagement/lm-db-service/internal/tracing"
+	"leavemanagement/lm-db-service/models"
+	"leavemanagement/lm-db-service/pkg/pb"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator"
+)
+
+// maxCalendarDays bounds the date range of one TeamCalendar call.
+const maxCalendarDays = 92
+
+// teamLeave is an approved or pending application of a team member.
+type teamLeave struct {
+	member   *pb.TeamMemberLeave
+	fromDate string
+	toDate   string
+}
+
+// TeamCalendar returns, for every day from fromDate to toDate, the holiday
+// and the members of a manager's team on approved or pending leave, with the
+// headcount left available. Managers see their own team and HR any team.
+func (d MysqlDB) TeamCalendar(ctx context.Context, req *pb.TeamCalendarRequest) (*pb.TeamCalendarResponse, error) {
+	teamManagerId := req.ManagerId
+	if teamManagerId == "" {
+		teamManagerId = req.EmployeeId
+	}
+	validate := validator.New()
+	fields := models.ValidateTeamCalendar{
+		EmployeeId: req.EmployeeId,
+		ManagerId:  teamManagerId,
+		FromDate:   req.FromDate,
+		ToDate:     req.ToDate,
+	}
+	err := validate.Struct(fields)
+	if err != nil {
+		return &pb.TeamCalendarResponse{}, errors.New("invalid input")
+	}
+	err = validation.ValidateFromDate(req.FromDate)
+	if err != nil {
+		return &pb.TeamCalendarResponse{}, err
+	}
+	err = validation.ValidateToDate(req.ToDate)
+	if err != nil {
+		return &pb.TeamCalendarResponse{}, err
+	}
+	fromDate, err := time.Parse(dateFormat, req.FromDate)
+	if err != nil {
+		return &pb.TeamCalendarResponse{}, errors.New("write date in YYYY-MM-DD format")
+	}
+	toDate, err := time.Parse(dateFormat, req.ToDate)
+	if err != nil {
+		return &pb.TeamCalendarResponse{}, errors.New("write date in YYYY-MM-DD format")
+	}
+	if toDate.Before(fromDate) {
+		return &pb.TeamCalendarResponse{}, errors.New("to date is before from date")
+	}
+	if leaveDays(req.FromDate, req.ToDate) > maxCalendarDays {
+		return &pb.TeamCalendarResponse{}, fmt.Errorf("date range is longer than %d days", maxCalendarDays)
+	}
+
+	designationId, err := d.getDesignationId(ctx, req.EmployeeId)
+	if err != nil {
+		return &pb.TeamCalendarResponse{}, err
+	}
+	if designationId != hrId && (designationId != managerId || teamManagerId != req.EmployeeId) {
+		return &pb.TeamCalendarResponse{}, errors.New("access denied")
+	}
+
+	teamSize, err := d.getTeamSize(ctx, teamManagerId)
+	if err != nil {
+		return &pb.TeamCalendarResponse{}, err
+	}
+	holidays, err := d.getHolidays(ctx, req.FromDate, req.ToDate)
+	if err != nil {
+		return &pb.TeamCalendarResponse{}, err
+	}
+	leaves, err := d.getTeamLeaves(ctx, teamManagerId, req.FromDate, req.ToDate)
+	if err != nil {
+		return &pb.TeamCalendarResponse{}, err
+	}
+
+	calendar := &pb.TeamCalendarResponse{
+		ManagerId: teamManagerId,
+		TeamSize:  strconv.Itoa(teamSize),
+	}
+	for date := fromDate; !date.After(toDate); date = date.AddDate(0, 0, 1) {
+		day := &pb.TeamCalendarDay{
+			Date:    date.Format(dateFormat),
+			Holiday: holidays[date.Format(dateFormat)],
+		}
+		onApprovedLeave := map[string]bool{}
+		onLeave := map[string]bool{}
+		for _, leave := range leaves {
+			if leave.fromDate > day.Date || leave.toDate < day.Date {
+				continue
+			}
+			day.OnLeave = append(day.OnLeave, leave.member)
+			onLeave[leave.member.EmployeeId] = true
+			if leave.member.LeaveStatus == approved {
+				onApprovedLeave[leave.member.EmployeeId] = true
+			}
+		}
+		available, availableIfApproved := teamSize-len(onApprovedLeave), teamSize-len(onLeave)
+		if day.Holiday != "" {
+			available, availableIfApproved = 0, 0
+		}
+		day.Available = strconv.Itoa(available)
+		day.AvailableIfApproved = strconv.Itoa(availableIfApproved)
+		calendar.Days = append(calendar.Days, day)
+	}
+	return calendar, nil
+}
+func (d MysqlDB) getTeamSize(ctx context.Context, teamManagerId string) (int, error) {
+	var teamSize int
+	teamSizeQuery := `SELECT COUNT(*) FROM lm_employee WHERE manager_id=?`
+	ctx, span, end := d.startQuery(ctx, "teamSize", "SELECT", "lm_employee")
+	defer end()
+	err := d.DB.QueryRowContext(ctx, teamSizeQuery, teamManagerId).Scan(&teamSize)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return 0, err
+	}
+	return teamSize, nil
+}
+
+// getHolidays returns the names of the holidays from fromDate to toDate by
+// date.
+func (d MysqlDB) getHolidays(ctx context.Context, fromDate, toDate string) (map[string]string, error) {
+	holidaysQuery := `SELECT holiday_date, name FROM lm_holiday WHERE holiday_date BETWEEN ? AND ?`
+	ctx, span, end := d.startQuery(ctx, "holidays", "SELECT", "lm_holiday")
+	defer end()
+	rows, err := d.DB.QueryContext(ctx, holidaysQuery, fromDate, toDate)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	defer rows.Close()
+	holidays := map[string]string{}
+	for rows.Next() {
+		var date, name string
+		err = rows.Scan(&date, &name)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return nil, err
+		}
+		holidays[dateOnly(date)] = name
+	}
+	if err = rows.Err(); err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	return holidays, nil
+}
+
+// getTeamLeaves returns the approved and pending applications of the team of
+// teamManagerId that overlap fromDate to toDate.
+func (d MysqlDB) getTeamLeaves(ctx context.Context, teamManagerId, fromDate, toDate string) ([]teamLeave, error) {
+	teamLeavesQuery := `
+					SELECT
+						employee_id,
+						first_name,
+						last_name,
+						application_id,
+						leave_type_id,
+						leave_status,
+						from_date,
+						to_date
+					FROM lm_leave_application
+					INNER JOIN lm_employee USING (employee_id)
+					WHERE manager_id=?
+						AND deleted_at IS NULL
+						AND leave_status IN (?, ?)
+						AND to_date>=?
+						AND from_date<=?
+					ORDER BY from_date, application_id`
+	ctx, span, end := d.startQuery(ctx, "teamLeaves", "SELECT", "lm_leave_application")
+	defer end()
+	rows, err := d.DB.QueryContext(ctx, teamLeavesQuery, teamManagerId, approved, pending, fromDate, toDate)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	defer rows.Close()
+	var leaves []teamLeave
+	for rows.Next() {
+		leave := teamLeave{member: &pb.TeamMemberLeave{}}
+		err = rows.Scan(
+			&leave.member.EmployeeId,
+			&leave.member.FirstName,
+			&leave.member.LastName,
+			&leave.member.ApplicationId,
+			&leave.member.LeaveTypeId,
+			&leave.member.LeaveStatus,
+			&leave.fromDate,
+			&leave.toDate)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return nil, err
+		}
+		leave.fromDate, leave.toDate = dateOnly(leave.fromDate), dateOnly(leave.toDate)
+		leaves = append(leaves, leave)
+	}
+	if err = rows.Err(); err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	return leaves, nil
+}
@@ -0,0 +1,101 @@
+package database
+
+import (
+	"context"
+	"leavemanagement/lm-db-service/pkg/pb"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestMySqlMock_TeamCalendar(t *testing.T) {
+	tests := []struct {
+		description   string
+		request       *pb.TeamCalendarRequest
+		designationId string
+		isError       string
+	}{
+		{
+			description:   "manager",
+			request:       &pb.TeamCalendarRequest{EmployeeId: "8", FromDate: "2022-04-20", ToDate: "2022-04-22"},
+			designationId: managerId,
+		},
+		{
+			description:   "hr",
+			request:       &pb.TeamCalendarRequest{EmployeeId: "7", ManagerId: "8", FromDate: "2022-04-20", ToDate: "2022-04-22"},
+			designationId: hrId,
+		},
+		{
+			description:   "manager of another team",
+			request:       &pb.TeamCalendarRequest{EmployeeId: "9", ManagerId: "8", FromDate: "2022-04-20", ToDate: "2022-04-22"},
+			designationId: managerId,
+			isError:       "access denied",
+		},
+		{
+			description:   "employee",
+			request:       &pb.TeamCalendarRequest{EmployeeId: "1", FromDate: "2022-04-20", ToDate: "2022-04-22"},
+			designationId: employeeId,
+			isError:       "access denied",
+		},
+		{
+			description: "to date before from date",
+			request:     &pb.TeamCalendarRequest{EmployeeId: "8", FromDate: "2022-04-22", ToDate: "2022-04-20"},
+			isError:     "to date is before from date",
+		},
+		{
+			description: "range too long",
+			request:     &pb.TeamCalendarRequest{EmployeeId: "8", FromDate: "2022-01-01", ToDate: "2022-12-31"},
+			isError:     "date range is longer than 92 days",
+		},
+		{
+			description: "invalid input",
+			request:     &pb.TeamCalendarRequest{EmployeeId: "8", FromDate: "2022-04-20"},
+			isError:     "invalid input",
+		},
+	}
+	fever := &pb.TeamMemberLeave{EmployeeId: "1", FirstName: "Saurabh", LastName: "Jain", ApplicationId: "2", LeaveTypeId: "1", LeaveStatus: approved}
+	exams := &pb.TeamMemberLeave{EmployeeId: "3", FirstName: "Rahul", LastName: "Mehta", ApplicationId: "4", LeaveTypeId: "2", LeaveStatus: pending}
+	expected := &pb.TeamCalendarResponse{
+		ManagerId: "8",
+		TeamSize:  "4",
+		Days: []*pb.TeamCalendarDay{
+			{Date: "2022-04-20", OnLeave: []*pb.TeamMemberLeave{fever}, Available: "3", AvailableIfApproved: "3"},
+			{Date: "2022-04-21", OnLeave: []*pb.TeamMemberLeave{fever, exams}, Available: "3", AvailableIfApproved: "2"},
+			{Date: "2022-04-22", Holiday: "Founders Day", OnLeave: []*pb.TeamMemberLeave{exams}, Available: "0", AvailableIfApproved: "0"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			testDB, mock := getTestMysqlDB(t)
+			if test.designationId != "" {
+				expectDesignation(mock, test.request.EmployeeId, test.designationId)
+			}
+			if test.isError == "" {
+				mock.ExpectQuery(`SELECT COUNT\(\*\) FROM lm_employee WHERE manager_id=\?`).WithArgs("8").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(4))
+				mock.ExpectQuery(`FROM lm_holiday WHERE holiday_date BETWEEN \? AND \?`).WithArgs("2022-04-20", "2022-04-22").
+					WillReturnRows(sqlmock.NewRows([]string{"holiday_date", "name"}).AddRow("2022-04-22T00:00:00+05:30", "Founders Day"))
+				mock.ExpectQuery(`WHERE manager_id=\? AND deleted_at IS NULL AND leave_status IN \(\?, \?\) AND to_date>=\? AND from_date<=\?`).
+					WithArgs("8", approved, pending, "2022-04-20", "2022-04-22").
+					WillReturnRows(sqlmock.NewRows([]string{"employee_id", "first_name", "last_name", "application_id", "leave_type_id", "leave_status", "from_date", "to_date"}).
+						AddRow("1", "Saurabh", "Jain", "2", "1", approved, "2022-04-19T00:00:00+05:30", "2022-04-21T00:00:00+05:30").
+						AddRow("3", "Rahul", "Mehta", "4", "2", pending, "2022-04-21T00:00:00+05:30", "2022-04-25T00:00:00+05:30"))
+			}
+			actual, err := testDB.TeamCalendar(context.Background(), test.request)
+			if test.isError == "" {
+				if err != nil {
+					t.Errorf("got error %v: want error: %v", err, false)
+				}
+				if !proto.Equal(actual, expected) {
+					t.Errorf("expected %v: got %v", expected, actual)
+				}
+			} else if err == nil || err.Error() != test.isError {
+				t.Errorf("got error %v: want error: %v", err, test.isError)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
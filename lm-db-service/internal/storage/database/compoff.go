@@ -0,0 +1,563 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"leavemanagement/lm-db-service/internal/policy"
+	"leavemanagement/lm-db-service/internal/storage/validation"
+	"leavemanagement/lm-db-service/internal/tracing"
+	"leavemanagement/lm-db-service/models"
+	"leavemanagement/lm-db-service/pkg/pb"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator"
+	"github.com/go-sql-driver/mysql"
+)
+
+// Statuses of a comp-off credit after approval, settled once it expires.
+const (
+	compOffLapsed = "3"
+	compOffUsed   = "4"
+)
+
+// mysqlDuplicateEntry is the MySQL error number of a unique key violation.
+const mysqlDuplicateEntry = 1062
+
+var errCompOffRequested = errors.New("comp-off already requested for this date")
+
+// RequestCompOff claims a comp-off for a weekend or holiday the employee
+// worked on. It is credited once the employee's manager approves it. A day
+// is claimed once; claiming a declined day again reopens the claim.
+func (d MysqlDB) RequestCompOff(ctx context.Context, req *pb.RequestCompOffRequest) (*pb.RequestCompOffResponse, error) {
+	validate := validator.New()
+	fields := models.ValidateRequestCompOff{
+		EmployeeId: req.EmployeeId,
+		WorkedDate: req.WorkedDate,
+		Reason:     req.Reason,
+	}
+	err := validate.Struct(fields)
+	if err != nil {
+		return &pb.RequestCompOffResponse{}, errors.New("invalid input")
+	}
+	err = validation.ValidateFromDate(req.WorkedDate)
+	if err != nil {
+		return &pb.RequestCompOffResponse{}, err
+	}
+	workedDate, err := time.Parse(dateFormat, req.WorkedDate)
+	if err != nil {
+		return &pb.RequestCompOffResponse{}, errors.New("write date in YYYY-MM-DD format")
+	}
+	if workedDate.After(time.Now()) {
+		return &pb.RequestCompOffResponse{}, errors.New("worked date is in the future")
+	}
+	holidays, err := d.getHolidays(ctx, req.WorkedDate, req.WorkedDate)
+	if err != nil {
+		return &pb.RequestCompOffResponse{}, err
+	}
+	weekend := workedDate.Weekday() == time.Saturday || workedDate.Weekday() == time.Sunday
+	if _, holiday := holidays[req.WorkedDate]; !weekend && !holiday {
+		return &pb.RequestCompOffResponse{}, errors.New("worked date is not a weekend or holiday")
+	}
+
+	compOff := &pb.CompOff{
+		EmployeeId: req.EmployeeId,
+		WorkedDate: req.WorkedDate,
+		Reason:     req.Reason,
+		Status:     pending,
+	}
+	err = d.withTx(ctx, func(tx *sql.Tx) error {
+		var before *pb.CompOff
+		var requestedStatus string
+		requestedQuery := `SELECT comp_off_id, status FROM lm_comp_off WHERE employee_id=? AND worked_date=? FOR UPDATE`
+		queryCtx, span, end := d.startQuery(ctx, "compOffRequested", "SELECT", "lm_comp_off")
+		err := tx.QueryRowContext(queryCtx, requestedQuery, req.EmployeeId, req.WorkedDate).Scan(&compOff.CompOffId, &requestedStatus)
+		end()
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+		case err != nil:
+			tracing.RecordError(span, err)
+			return err
+		case requestedStatus != declined:
+			return errCompOffRequested
+		default:
+			before = &pb.CompOff{CompOffId: compOff.CompOffId, EmployeeId: req.EmployeeId, WorkedDate: req.WorkedDate, Status: declined}
+		}
+
+		if before != nil {
+			reopenCompOffQuery := `
+							UPDATE lm_comp_off
+							SET
+								reason=?,
+								status=?,
+								requested_at=?,
+								decided_by=NULL,
+								decided_at=NULL
+							WHERE comp_off_id=?`
+			execCtx, span, end := d.startQuery(ctx, "reopenCompOff", "UPDATE", "lm_comp_off")
+			defer end()
+			result, err := tx.ExecContext(execCtx, reopenCompOffQuery, compOff.Reason, compOff.Status,
+				time.Now().Format(dateTimeFormat), compOff.CompOffId)
+			tracing.RecordResult(span, result)
+			if err != nil {
+				tracing.RecordError(span, err)
+				return err
+			}
+		} else {
+			requestCompOffQuery := `
+							INSERT INTO lm_comp_off (
+								employee_id,
+								worked_date,
+								reason,
+								status,
+								requested_at)
+							VALUES (?, ?, ?, ?, ?)`
+			execCtx, span, end := d.startQuery(ctx, "requestCompOff", "INSERT", "lm_comp_off")
+			defer end()
+			result, err := tx.ExecContext(execCtx, requestCompOffQuery, compOff.EmployeeId, compOff.WorkedDate, compOff.Reason,
+				compOff.Status, time.Now().Format(dateTimeFormat))
+			tracing.RecordResult(span, result)
+			if err != nil {
+				tracing.RecordError(span, err)
+				// A request for the same day inserted in the meantime.
+				var mysqlErr *mysql.MySQLError
+				if errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlDuplicateEntry {
+					return errCompOffRequested
+				}
+				return err
+			}
+			compOffId, err := result.LastInsertId()
+			if err != nil {
+				return err
+			}
+			compOff.CompOffId = strconv.FormatInt(compOffId, 10)
+		}
+		return d.appendRecordAuditEvent(ctx, tx, auditSubjectCompOff, compOff.CompOffId, compOff.EmployeeId, req.EmployeeId,
+			auditRequest, before, compOff)
+	})
+	if err != nil {
+		return &pb.RequestCompOffResponse{}, err
+	}
+	return &pb.RequestCompOffResponse{CompOffId: compOff.CompOffId}, nil
+}
+
+// ChangeCompOffStatus approves or declines a pending comp-off request, only
+// the manager of the employee who worked has access to it. An approved
+// credit expires the validity days of the comp-off leave type after the day
+// worked.
+func (d MysqlDB) ChangeCompOffStatus(ctx context.Context, req *pb.ChangeCompOffStatusRequest) error {
+	validate := validator.New()
+	fields := models.ValidateChangeCompOffStatus{
+		EmployeeId: req.EmployeeId,
+		CompOffId:  req.CompOffId,
+		Status:     req.Status,
+	}
+	err := validate.Struct(fields)
+	if err != nil {
+		return errors.New("invalid input")
+	}
+
+	designationId, err := d.getDesignationId(ctx, req.EmployeeId)
+	if err != nil {
+		return err
+	}
+	if designationId != managerId {
+		return errors.New("access denied")
+	}
+
+	return d.withTx(ctx, func(tx *sql.Tx) error {
+		var applicantId, workedDate, compOffStatus string
+		compOffQuery := `SELECT employee_id, worked_date, status FROM lm_comp_off WHERE comp_off_id=? FOR UPDATE`
+		queryCtx, span, end := d.startQuery(ctx, "compOff", "SELECT", "lm_comp_off")
+		err := tx.QueryRowContext(queryCtx, compOffQuery, req.CompOffId).Scan(&applicantId, &workedDate, &compOffStatus)
+		end()
+		if err != nil {
+			tracing.RecordError(span, err)
+			if errors.Is(err, sql.ErrNoRows) {
+				return errors.New("comp-off request not found")
+			}
+			return err
+		}
+		reportsTo, err := d.getManagerId(ctx, applicantId)
+		if err != nil {
+			return err
+		}
+		if reportsTo != req.EmployeeId {
+			return errors.New("access denied")
+		}
+		if compOffStatus != pending {
+			return errors.New("comp-off request already decided")
+		}
+
+		var expiresOn interface{}
+		if req.Status == approved {
+			_, validityDays, err := d.getCompOffLeaveType(ctx)
+			if err != nil {
+				return err
+			}
+			worked, err := time.Parse(dateFormat, dateOnly(workedDate))
+			if err != nil {
+				return err
+			}
+			expiresOn = worked.AddDate(0, 0, validityDays).Format(dateFormat)
+		}
+		changeCompOffStatusQuery := `
+						UPDATE lm_comp_off
+						SET
+							status=?,
+							decided_by=?,
+							decided_at=?,
+							expires_on=?
+						WHERE comp_off_id=?`
+		execCtx, span, end := d.startQuery(ctx, "changeCompOffStatus", "UPDATE", "lm_comp_off")
+		defer end()
+		result, err := tx.ExecContext(execCtx, changeCompOffStatusQuery, req.Status, req.EmployeeId,
+			time.Now().Format(dateTimeFormat), expiresOn, req.CompOffId)
+		tracing.RecordResult(span, result)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return err
+		}
+		before := &pb.CompOff{CompOffId: req.CompOffId, EmployeeId: applicantId, WorkedDate: dateOnly(workedDate), Status: compOffStatus}
+		after := &pb.CompOff{CompOffId: req.CompOffId, EmployeeId: applicantId, WorkedDate: dateOnly(workedDate), Status: req.Status}
+		if expiresOn != nil {
+			after.ExpiresOn = expiresOn.(string)
+		}
+		return d.appendRecordAuditEvent(ctx, tx, auditSubjectCompOff, req.CompOffId, applicantId, req.EmployeeId,
+			auditChangeStatus, before, after)
+	})
+}
+
+// ListCompOffs returns the comp-offs of an employee, newest worked date
+// first, optionally only those with a status. Employees see their own, managers
+// those of their team and HR everyone's.
+func (d MysqlDB) ListCompOffs(ctx context.Context, req *pb.ListCompOffsRequest) (*pb.ListCompOffsResponse, error) {
+	targetEmployeeId := req.TargetEmployeeId
+	if targetEmployeeId == "" {
+		targetEmployeeId = req.EmployeeId
+	}
+	validate := validator.New()
+	fields := models.ValidateListCompOffs{
+		EmployeeId:       req.EmployeeId,
+		TargetEmployeeId: targetEmployeeId,
+		Status:           req.Status,
+	}
+	err := validate.Struct(fields)
+	if err != nil {
+		return &pb.ListCompOffsResponse{}, errors.New("invalid input")
+	}
+
+	err = d.canViewEmployee(ctx, req.EmployeeId, targetEmployeeId)
+	if err != nil {
+		return &pb.ListCompOffsResponse{}, err
+	}
+
+	listCompOffsQuery := `
+					SELECT
+						comp_off_id,
+						employee_id,
+						worked_date,
+						reason,
+						status,
+						IFNULL(expires_on,"")
+					FROM lm_comp_off
+					WHERE employee_id=? AND (?="" OR status=?)
+					ORDER BY worked_date DESC, comp_off_id DESC`
+	ctx, span, end := d.startQuery(ctx, "listCompOffs", "SELECT", "lm_comp_off")
+	defer end()
+	rows, err := d.DB.QueryContext(ctx, listCompOffsQuery, targetEmployeeId, req.Status, req.Status)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return &pb.ListCompOffsResponse{}, err
+	}
+	defer rows.Close()
+	compOffs := &pb.ListCompOffsResponse{}
+	for rows.Next() {
+		compOff := &pb.CompOff{}
+		err = rows.Scan(&compOff.CompOffId, &compOff.EmployeeId, &compOff.WorkedDate, &compOff.Reason, &compOff.Status, &compOff.ExpiresOn)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return &pb.ListCompOffsResponse{}, err
+		}
+		compOff.WorkedDate, compOff.ExpiresOn = dateOnly(compOff.WorkedDate), dateOnly(compOff.ExpiresOn)
+		compOffs.CompOffs = append(compOffs.CompOffs, compOff)
+	}
+	if err = rows.Err(); err != nil {
+		tracing.RecordError(span, err)
+		return &pb.ListCompOffsResponse{}, err
+	}
+	return compOffs, nil
+}
+
+// LapseCompOffs settles the approved credits that expired more than
+// olderThan before today: those spent on approved comp-off leave become used
+// and those not spent lapse, while those spent on pending leave wait for its
+// decision. It settles the credits of up to purgeBatchSize employees per
+// call and returns how many credits it settled.
+func (d MysqlDB) LapseCompOffs(ctx context.Context, olderThan time.Duration) (int, error) {
+	today, _ := time.Parse(dateFormat, time.Now().Format(dateFormat))
+	cutoff := today.Add(-olderThan)
+	var settled int
+	err := d.withTx(ctx, func(tx *sql.Tx) error {
+		expiredQuery := `
+						SELECT DISTINCT employee_id
+						FROM lm_comp_off
+						WHERE status=? AND expires_on<?
+						ORDER BY employee_id
+						LIMIT ?`
+		queryCtx, span, end := d.startQuery(ctx, "expiredCompOffs", "SELECT", "lm_comp_off")
+		defer end()
+		rows, err := tx.QueryContext(queryCtx, expiredQuery, approved, cutoff.Format(dateFormat), purgeBatchSize)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return err
+		}
+		var employeeIds []string
+		for rows.Next() {
+			var employeeId string
+			if err := rows.Scan(&employeeId); err != nil {
+				rows.Close()
+				tracing.RecordError(span, err)
+				return err
+			}
+			employeeIds = append(employeeIds, employeeId)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			tracing.RecordError(span, err)
+			return err
+		}
+		if len(employeeIds) == 0 {
+			return nil
+		}
+
+		leaveTypeId, _, err := d.getCompOffLeaveType(ctx)
+		if err != nil {
+			return err
+		}
+		leavePolicy, err := d.getLeavePolicy(ctx, leaveTypeId)
+		if err != nil {
+			return err
+		}
+		settleQuery := `UPDATE lm_comp_off SET status=?, settled_at=? WHERE comp_off_id=? AND status=?`
+		for _, employeeId := range employeeIds {
+			credits, err := d.getCompOffCredits(ctx, tx, employeeId)
+			if err != nil {
+				return err
+			}
+			spends, err := d.getCompOffSpends(ctx, tx, leavePolicy, employeeId, leaveTypeId, "0")
+			if err != nil {
+				return err
+			}
+			allocation := policy.AllocateCredits(credits, spends)
+			for _, credit := range credits {
+				// A credit reserved by a pending application is settled
+				// once the application is decided or deleted.
+				if !credit.ExpiresOn.Before(cutoff) || allocation.Reserved[credit.Id] {
+					continue
+				}
+				compOffStatus := compOffLapsed
+				if allocation.Used[credit.Id] {
+					compOffStatus = compOffUsed
+				}
+				execCtx, span, end := d.startQuery(ctx, "settleCompOff", "UPDATE", "lm_comp_off")
+				result, err := tx.ExecContext(execCtx, settleQuery, compOffStatus, time.Now().Format(dateTimeFormat), credit.Id, approved)
+				tracing.RecordResult(span, result)
+				if err != nil {
+					tracing.RecordError(span, err)
+					end()
+					return err
+				}
+				end()
+				affected, err := result.RowsAffected()
+				if err != nil {
+					return err
+				}
+				settled += int(affected)
+				if affected == 0 {
+					continue
+				}
+				workedDate, expiresOn := credit.WorkedDate.Format(dateFormat), credit.ExpiresOn.Format(dateFormat)
+				before := &pb.CompOff{CompOffId: credit.Id, EmployeeId: employeeId, WorkedDate: workedDate, Status: approved, ExpiresOn: expiresOn}
+				after := &pb.CompOff{CompOffId: credit.Id, EmployeeId: employeeId, WorkedDate: workedDate, Status: compOffStatus, ExpiresOn: expiresOn}
+				err = d.appendRecordAuditEvent(ctx, tx, auditSubjectCompOff, credit.Id, employeeId, systemActorId,
+					auditSettle, before, after)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return settled, nil
+}
+
+// compOffBalanceAfter returns the comp-off credits the employee has left
+// after applying for the comp-off leave type leaveTypeId on dates, the days
+// counted as leave, with the credits spent on the other applications first.
+// applicationId is the application being changed, empty for a new one. The
+// credits and applications are read on q.
+func (d MysqlDB) compOffBalanceAfter(ctx context.Context, q queryer, leavePolicy policy.Policy, employeeId, leaveTypeId, applicationId string,
+	dates []time.Time) (int, error) {
+	credits, err := d.getCompOffCredits(ctx, q, employeeId)
+	if err != nil {
+		return 0, err
+	}
+	if applicationId == "" {
+		applicationId = "0"
+	}
+	spends, err := d.getCompOffSpends(ctx, q, leavePolicy, employeeId, leaveTypeId, applicationId)
+	if err != nil {
+		return 0, err
+	}
+	allocation := policy.AllocateCredits(credits, append(spends, policy.Spend{Dates: dates}))
+	if allocation.Uncovered > 0 {
+		return 0, errors.New("comp-off credits not remaining")
+	}
+	return allocation.Available(credits, dates[0]), nil
+}
+
+// getCompOffLeaveType returns the comp-off leave type and how many days
+// after the day worked its credits expire.
+func (d MysqlDB) getCompOffLeaveType(ctx context.Context) (string, int, error) {
+	var leaveTypeId string
+	var validityDays int
+	compOffLeaveTypeQuery := `
+					SELECT leave_type_id, comp_off_validity_days
+					FROM lm_leave_type
+					WHERE comp_off_validity_days IS NOT NULL
+					ORDER BY leave_type_id
+					LIMIT 1`
+	ctx, span, end := d.startQuery(ctx, "compOffLeaveType", "SELECT", "lm_leave_type")
+	defer end()
+	err := d.DB.QueryRowContext(ctx, compOffLeaveTypeQuery).Scan(&leaveTypeId, &validityDays)
+	if err != nil {
+		tracing.RecordError(span, err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", 0, errors.New("comp-off leave type not found")
+		}
+		return "", 0, err
+	}
+	return leaveTypeId, validityDays, nil
+}
+
+// getCompOffCredits returns the approved credits of the employee, including
+// those already settled as used but not the lapsed ones, locking them.
+func (d MysqlDB) getCompOffCredits(ctx context.Context, q queryer, employeeId string) ([]policy.Credit, error) {
+	compOffCreditsQuery := `
+					SELECT comp_off_id, worked_date, expires_on
+					FROM lm_comp_off
+					WHERE employee_id=? AND status IN (?, ?)
+					FOR UPDATE`
+	ctx, span, end := d.startQuery(ctx, "compOffCredits", "SELECT", "lm_comp_off")
+	defer end()
+	rows, err := q.QueryContext(ctx, compOffCreditsQuery, employeeId, approved, compOffUsed)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	defer rows.Close()
+	var credits []policy.Credit
+	for rows.Next() {
+		var credit policy.Credit
+		var workedDate, expiresOn string
+		err = rows.Scan(&credit.Id, &workedDate, &expiresOn)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return nil, err
+		}
+		credit.WorkedDate, _ = time.Parse(dateFormat, dateOnly(workedDate))
+		credit.ExpiresOn, _ = time.Parse(dateFormat, dateOnly(expiresOn))
+		credits = append(credits, credit)
+	}
+	if err = rows.Err(); err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	return credits, nil
+}
+
+// getCompOffSpends returns the applications of the employee for the
+// comp-off leave type leaveTypeId, leaving out declined and deleted ones and
+// applicationId, locking them.
+func (d MysqlDB) getCompOffSpends(ctx context.Context, q queryer, leavePolicy policy.Policy, employeeId, leaveTypeId,
+	applicationId string) ([]policy.Spend, error) {
+	compOffSpendsQuery := `
+					SELECT from_date, to_date, sandwich_before, sandwich_after, leave_status
+					FROM lm_leave_application
+					WHERE employee_id=?
+						AND leave_type_id=?
+						AND leave_status<>?
+						AND deleted_at IS NULL
+						AND application_id<>?
+					FOR UPDATE`
+	queryCtx, span, end := d.startQuery(ctx, "compOffSpends", "SELECT", "lm_leave_application")
+	defer end()
+	rows, err := q.QueryContext(queryCtx, compOffSpendsQuery, employeeId, leaveTypeId, declined, applicationId)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	defer rows.Close()
+	var leaves []policy.Leave
+	var spends []policy.Spend
+	for rows.Next() {
+		var leave policy.Leave
+		var fromDate, toDate, leaveStatus string
+		err = rows.Scan(&fromDate, &toDate, &leave.SandwichBefore, &leave.SandwichAfter, &leaveStatus)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return nil, err
+		}
+		leave.FromDate, _ = time.Parse(dateFormat, dateOnly(fromDate))
+		leave.ToDate, _ = time.Parse(dateFormat, dateOnly(toDate))
+		leaves = append(leaves, leave)
+		spends = append(spends, policy.Spend{Pending: leaveStatus == pending})
+	}
+	if err = rows.Err(); err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	dates, err := d.leaveDates(ctx, leavePolicy, leaves)
+	if err != nil {
+		return nil, err
+	}
+	for i := range spends {
+		spends[i].Dates = dates[i]
+	}
+	return spends, nil
+}
+
+// leaveDates returns the days each of the saved applications counted as
+// leave under leavePolicy.
+func (d MysqlDB) leaveDates(ctx context.Context, leavePolicy policy.Policy, leaves []policy.Leave) ([][]time.Time, error) {
+	if len(leaves) == 0 {
+		return nil, nil
+	}
+	holidays := map[string]string{}
+	if leavePolicy.WorkingDays {
+		fromDate, toDate := leaves[0].FromDate, leaves[0].ToDate
+		for _, leave := range leaves {
+			if leave.FromDate.Before(fromDate) {
+				fromDate = leave.FromDate
+			}
+			if leave.ToDate.After(toDate) {
+				toDate = leave.ToDate
+			}
+		}
+		var err error
+		holidays, err = d.getHolidays(ctx, fromDate.Format(dateFormat), toDate.Format(dateFormat))
+		if err != nil {
+			return nil, err
+		}
+	}
+	dates := make([][]time.Time, len(leaves))
+	for i, leave := range leaves {
+		dates[i] = policy.LeaveDates(leavePolicy, leave, holidays)
+	}
+	return dates, nil
+}
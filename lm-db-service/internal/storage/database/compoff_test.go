@@ -0,0 +1,431 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"leavemanagement/lm-db-service/pkg/pb"
+	"strconv"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	compOffCreditColumns = []string{"comp_off_id", "worked_date", "expires_on"}
+	compOffSpendColumns  = []string{"from_date", "to_date", "sandwich_before", "sandwich_after", "leave_status"}
+)
+
+const (
+	compOffCreditsQuery = `SELECT comp_off_id, worked_date, expires_on\s+FROM lm_comp_off\s+WHERE employee_id=\? AND status IN \(\?, \?\)\s+FOR UPDATE`
+	compOffSpendsQuery  = `SELECT from_date, to_date, sandwich_before, sandwich_after, leave_status\s+FROM lm_leave_application`
+)
+
+// expectCompOffCredits expects the credits of employee 1 to be two days worked
+// on the first two Saturdays of April 2022, valid for 30 days.
+func expectCompOffCredits(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(compOffCreditsQuery).WithArgs("1", approved, compOffUsed).
+		WillReturnRows(sqlmock.NewRows(compOffCreditColumns).
+			AddRow("1", "2022-04-02T00:00:00+05:30", "2022-05-02T00:00:00+05:30").
+			AddRow("2", "2022-04-09T00:00:00+05:30", "2022-05-09T00:00:00+05:30"))
+}
+
+func TestMySqlMock_RequestCompOff(t *testing.T) {
+	requestedQuery := `SELECT comp_off_id, status FROM lm_comp_off WHERE employee_id=\? AND worked_date=\? FOR UPDATE`
+	tests := []struct {
+		description string
+		request     *pb.RequestCompOffRequest
+		expect      func(mock sqlmock.Sqlmock)
+		isError     string
+	}{
+		{
+			description: "weekend",
+			request:     &pb.RequestCompOffRequest{EmployeeId: "1", WorkedDate: "2022-04-23", Reason: "Release"},
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(holidaysQuery).WithArgs("2022-04-23", "2022-04-23").
+					WillReturnRows(sqlmock.NewRows([]string{"holiday_date", "name"}))
+				mock.ExpectBegin()
+				mock.ExpectQuery(requestedQuery).WithArgs("1", "2022-04-23").
+					WillReturnRows(sqlmock.NewRows([]string{"comp_off_id", "status"}))
+				mock.ExpectExec(`INSERT INTO lm_comp_off`).
+					WithArgs("1", "2022-04-23", "Release", pending, time.Now().Format(dateTimeFormat)).
+					WillReturnResult(sqlmock.NewResult(3, 1))
+				expectRecordAuditEvent(mock, auditSubjectCompOff, "3", "1", "1", auditRequest)
+				mock.ExpectCommit()
+			},
+		},
+		{
+			description: "holiday",
+			request:     &pb.RequestCompOffRequest{EmployeeId: "1", WorkedDate: "2022-04-14", Reason: "Release"},
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(holidaysQuery).WithArgs("2022-04-14", "2022-04-14").
+					WillReturnRows(sqlmock.NewRows([]string{"holiday_date", "name"}).AddRow("2022-04-14T00:00:00+05:30", "Ambedkar Jayanti"))
+				mock.ExpectBegin()
+				mock.ExpectQuery(requestedQuery).WillReturnRows(sqlmock.NewRows([]string{"comp_off_id", "status"}))
+				mock.ExpectExec(`INSERT INTO lm_comp_off`).WillReturnResult(sqlmock.NewResult(3, 1))
+				expectRecordAuditEvent(mock, auditSubjectCompOff, "3", "1", "1", auditRequest)
+				mock.ExpectCommit()
+			},
+		},
+		{
+			description: "declined before",
+			request:     &pb.RequestCompOffRequest{EmployeeId: "1", WorkedDate: "2022-04-23", Reason: "Release night"},
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(holidaysQuery).WillReturnRows(sqlmock.NewRows([]string{"holiday_date", "name"}))
+				mock.ExpectBegin()
+				mock.ExpectQuery(requestedQuery).WithArgs("1", "2022-04-23").
+					WillReturnRows(sqlmock.NewRows([]string{"comp_off_id", "status"}).AddRow("3", declined))
+				mock.ExpectExec(`UPDATE lm_comp_off\s+SET\s+reason=\?,\s+status=\?,\s+requested_at=\?,\s+decided_by=NULL,\s+decided_at=NULL`).
+					WithArgs("Release night", pending, time.Now().Format(dateTimeFormat), "3").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectRecordAuditEvent(mock, auditSubjectCompOff, "3", "1", "1", auditRequest)
+				mock.ExpectCommit()
+			},
+		},
+		{
+			description: "working day",
+			request:     &pb.RequestCompOffRequest{EmployeeId: "1", WorkedDate: "2022-04-20", Reason: "Release"},
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(holidaysQuery).WithArgs("2022-04-20", "2022-04-20").
+					WillReturnRows(sqlmock.NewRows([]string{"holiday_date", "name"}))
+			},
+			isError: "worked date is not a weekend or holiday",
+		},
+		{
+			description: "already requested",
+			request:     &pb.RequestCompOffRequest{EmployeeId: "1", WorkedDate: "2022-04-23", Reason: "Release"},
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(holidaysQuery).WillReturnRows(sqlmock.NewRows([]string{"holiday_date", "name"}))
+				mock.ExpectBegin()
+				mock.ExpectQuery(requestedQuery).WillReturnRows(sqlmock.NewRows([]string{"comp_off_id", "status"}).AddRow("3", pending))
+				mock.ExpectRollback()
+			},
+			isError: "comp-off already requested for this date",
+		},
+		{
+			description: "requested at the same time",
+			request:     &pb.RequestCompOffRequest{EmployeeId: "1", WorkedDate: "2022-04-23", Reason: "Release"},
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(holidaysQuery).WillReturnRows(sqlmock.NewRows([]string{"holiday_date", "name"}))
+				mock.ExpectBegin()
+				mock.ExpectQuery(requestedQuery).WillReturnRows(sqlmock.NewRows([]string{"comp_off_id", "status"}))
+				mock.ExpectExec(`INSERT INTO lm_comp_off`).
+					WillReturnError(&mysql.MySQLError{Number: mysqlDuplicateEntry, Message: "Duplicate entry"})
+				mock.ExpectRollback()
+			},
+			isError: "comp-off already requested for this date",
+		},
+		{
+			description: "future date",
+			request:     &pb.RequestCompOffRequest{EmployeeId: "1", WorkedDate: time.Now().AddDate(0, 0, 7).Format(dateFormat), Reason: "Release"},
+			expect:      func(mock sqlmock.Sqlmock) {},
+			isError:     "worked date is in the future",
+		},
+		{
+			description: "validation error",
+			request:     &pb.RequestCompOffRequest{EmployeeId: "1", WorkedDate: "2022-04-23"},
+			expect:      func(mock sqlmock.Sqlmock) {},
+			isError:     "invalid input",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			testDB, mock := getTestMysqlDB(t)
+			test.expect(mock)
+			got, err := testDB.RequestCompOff(context.Background(), test.request)
+			if test.isError != "" {
+				if err == nil || err.Error() != test.isError {
+					t.Errorf("got error %v: want error: %v", err, test.isError)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("got error %v: want error: %v", err, false)
+				}
+				if got.CompOffId != "3" {
+					t.Errorf("expected %v: got %v", "3", got.CompOffId)
+				}
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestMySqlMock_ChangeCompOffStatus(t *testing.T) {
+	compOffQuery := `SELECT employee_id, worked_date, status FROM lm_comp_off WHERE comp_off_id=\? FOR UPDATE`
+	changeCompOffStatusQuery := `UPDATE lm_comp_off\s+SET\s+status=\?,\s+decided_by=\?,\s+decided_at=\?,\s+expires_on=\?\s+WHERE comp_off_id=\?`
+	compOff := func(mock sqlmock.Sqlmock, compOffStatus string) {
+		mock.ExpectQuery(compOffQuery).WithArgs("3").
+			WillReturnRows(sqlmock.NewRows([]string{"employee_id", "worked_date", "status"}).
+				AddRow("1", "2022-04-23T00:00:00+05:30", compOffStatus))
+	}
+	tests := []struct {
+		description   string
+		request       *pb.ChangeCompOffStatusRequest
+		designationId string
+		expect        func(mock sqlmock.Sqlmock)
+		isError       string
+	}{
+		{
+			description:   "approve",
+			request:       &pb.ChangeCompOffStatusRequest{EmployeeId: "8", CompOffId: "3", Status: approved},
+			designationId: managerId,
+			expect: func(mock sqlmock.Sqlmock) {
+				compOff(mock, pending)
+				expectManager(mock, "1", "8")
+				mock.ExpectQuery(`WHERE comp_off_validity_days IS NOT NULL`).
+					WillReturnRows(sqlmock.NewRows([]string{"leave_type_id", "comp_off_validity_days"}).AddRow("6", 30))
+				mock.ExpectExec(changeCompOffStatusQuery).
+					WithArgs(approved, "8", time.Now().Format(dateTimeFormat), "2022-05-23", "3").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectRecordAuditEvent(mock, auditSubjectCompOff, "3", "1", "8", auditChangeStatus)
+				mock.ExpectCommit()
+			},
+		},
+		{
+			description:   "decline",
+			request:       &pb.ChangeCompOffStatusRequest{EmployeeId: "8", CompOffId: "3", Status: declined},
+			designationId: managerId,
+			expect: func(mock sqlmock.Sqlmock) {
+				compOff(mock, pending)
+				expectManager(mock, "1", "8")
+				mock.ExpectExec(changeCompOffStatusQuery).
+					WithArgs(declined, "8", time.Now().Format(dateTimeFormat), nil, "3").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectRecordAuditEvent(mock, auditSubjectCompOff, "3", "1", "8", auditChangeStatus)
+				mock.ExpectCommit()
+			},
+		},
+		{
+			description:   "no comp-off leave type",
+			request:       &pb.ChangeCompOffStatusRequest{EmployeeId: "8", CompOffId: "3", Status: approved},
+			designationId: managerId,
+			expect: func(mock sqlmock.Sqlmock) {
+				compOff(mock, pending)
+				expectManager(mock, "1", "8")
+				mock.ExpectQuery(`WHERE comp_off_validity_days IS NOT NULL`).
+					WillReturnRows(sqlmock.NewRows([]string{"leave_type_id", "comp_off_validity_days"}))
+				mock.ExpectRollback()
+			},
+			isError: "comp-off leave type not found",
+		},
+		{
+			description:   "manager of another team",
+			request:       &pb.ChangeCompOffStatusRequest{EmployeeId: "9", CompOffId: "3", Status: approved},
+			designationId: managerId,
+			expect: func(mock sqlmock.Sqlmock) {
+				compOff(mock, pending)
+				expectManager(mock, "1", "8")
+				mock.ExpectRollback()
+			},
+			isError: "access denied",
+		},
+		{
+			description:   "already decided",
+			request:       &pb.ChangeCompOffStatusRequest{EmployeeId: "8", CompOffId: "3", Status: approved},
+			designationId: managerId,
+			expect: func(mock sqlmock.Sqlmock) {
+				compOff(mock, declined)
+				expectManager(mock, "1", "8")
+				mock.ExpectRollback()
+			},
+			isError: "comp-off request already decided",
+		},
+		{
+			description:   "not found",
+			request:       &pb.ChangeCompOffStatusRequest{EmployeeId: "8", CompOffId: "3", Status: approved},
+			designationId: managerId,
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(compOffQuery).WithArgs("3").
+					WillReturnRows(sqlmock.NewRows([]string{"employee_id", "worked_date", "status"}))
+				mock.ExpectRollback()
+			},
+			isError: "comp-off request not found",
+		},
+		{
+			description:   "not a manager",
+			request:       &pb.ChangeCompOffStatusRequest{EmployeeId: "1", CompOffId: "3", Status: approved},
+			designationId: employeeId,
+			isError:       "access denied",
+		},
+		{
+			description: "validation error",
+			request:     &pb.ChangeCompOffStatusRequest{EmployeeId: "8", CompOffId: "3", Status: pending},
+			isError:     "invalid input",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			testDB, mock := getTestMysqlDB(t)
+			if test.designationId != "" {
+				expectDesignation(mock, test.request.EmployeeId, test.designationId)
+			}
+			if test.expect != nil {
+				mock.ExpectBegin()
+				test.expect(mock)
+			}
+			err := testDB.ChangeCompOffStatus(context.Background(), test.request)
+			if test.isError != "" {
+				if err == nil || err.Error() != test.isError {
+					t.Errorf("got error %v: want error: %v", err, test.isError)
+				}
+			} else if err != nil {
+				t.Errorf("got error %v: want error: %v", err, false)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestMySqlMock_ListCompOffs(t *testing.T) {
+	testDB, mock := getTestMysqlDB(t)
+	expectDesignation(mock, "8", managerId)
+	expectManager(mock, "1", "8")
+	mock.ExpectQuery(`FROM lm_comp_off\s+WHERE employee_id=\? AND \(\?="" OR status=\?\)`).WithArgs("1", approved, approved).
+		WillReturnRows(sqlmock.NewRows([]string{"comp_off_id", "employee_id", "worked_date", "reason", "status", "expires_on"}).
+			AddRow("2", "1", "2022-04-09T00:00:00+05:30", "Release", approved, "2022-05-09T00:00:00+05:30"))
+	got, err := testDB.ListCompOffs(context.Background(), &pb.ListCompOffsRequest{EmployeeId: "8", TargetEmployeeId: "1", Status: approved})
+	if err != nil {
+		t.Errorf("got error %v: want error: %v", err, false)
+	}
+	expected := &pb.ListCompOffsResponse{CompOffs: []*pb.CompOff{
+		{CompOffId: "2", EmployeeId: "1", WorkedDate: "2022-04-09", Reason: "Release", Status: approved, ExpiresOn: "2022-05-09"},
+	}}
+	if !proto.Equal(got, expected) {
+		t.Errorf("expected %v: got %v", expected, got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMySqlMock_LapseCompOffs(t *testing.T) {
+	settleQuery := `UPDATE lm_comp_off SET status=\?, settled_at=\? WHERE comp_off_id=\? AND status=\?`
+	tests := []struct {
+		description string
+		leaveStatus string
+		// settled are the statuses credits 1 and 2 are settled as, empty
+		// when left alone.
+		settled []string
+	}{
+		{
+			description: "spent on approved leave",
+			leaveStatus: approved,
+			settled:     []string{compOffUsed, compOffLapsed},
+		},
+		{
+			description: "reserved by pending leave",
+			leaveStatus: pending,
+			settled:     []string{"", compOffLapsed},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			testDB, mock := getTestMysqlDB(t)
+			today := time.Now().Format(dateFormat)
+			mock.ExpectBegin()
+			mock.ExpectQuery(`SELECT DISTINCT employee_id\s+FROM lm_comp_off\s+WHERE status=\? AND expires_on<\?`).
+				WithArgs(approved, today, purgeBatchSize).
+				WillReturnRows(sqlmock.NewRows([]string{"employee_id"}).AddRow("1"))
+			mock.ExpectQuery(`WHERE comp_off_validity_days IS NOT NULL`).
+				WillReturnRows(sqlmock.NewRows([]string{"leave_type_id", "comp_off_validity_days"}).AddRow("6", 30))
+			mock.ExpectQuery(leavePolicyQuery).WithArgs("6").
+				WillReturnRows(sqlmock.NewRows(policyColumns).AddRow(nil, nil, nil, nil, true, false, true, nil, "", nil, "", false, 30))
+			expectCompOffCredits(mock)
+			mock.ExpectQuery(compOffSpendsQuery).WithArgs("1", "6", declined, "0").
+				WillReturnRows(sqlmock.NewRows(compOffSpendColumns).
+					AddRow("2022-04-11T00:00:00+05:30", "2022-04-11T00:00:00+05:30", 0, 0, test.leaveStatus))
+			mock.ExpectQuery(holidaysQuery).WithArgs("2022-04-11", "2022-04-11").
+				WillReturnRows(sqlmock.NewRows([]string{"holiday_date", "name"}))
+			expected := 0
+			for i, compOffStatus := range test.settled {
+				if compOffStatus == "" {
+					continue
+				}
+				compOffId := strconv.Itoa(i + 1)
+				mock.ExpectExec(settleQuery).WithArgs(compOffStatus, time.Now().Format(dateTimeFormat), compOffId, approved).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectRecordAuditEvent(mock, auditSubjectCompOff, compOffId, "1", systemActorId, auditSettle)
+				expected++
+			}
+			mock.ExpectCommit()
+			settled, err := testDB.LapseCompOffs(context.Background(), 0)
+			if err != nil {
+				t.Errorf("got error %v: want error: %v", err, false)
+			}
+			if settled != expected {
+				t.Errorf("expected %v: got %v", expected, settled)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestMySqlMock_ApplyLeaveCompOff(t *testing.T) {
+	tests := []struct {
+		description string
+		spends      *sqlmock.Rows
+		isError     error
+	}{
+		{
+			description: "credits spent first expiring first",
+			spends:      sqlmock.NewRows(compOffSpendColumns),
+		},
+		{
+			description: "credits not remaining",
+			spends: sqlmock.NewRows(compOffSpendColumns).
+				AddRow("2022-04-11T00:00:00+05:30", "2022-04-11T00:00:00+05:30", 0, 0, approved),
+			isError: errors.New("comp-off credits not remaining"),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			testDB, mock := getTestMysqlDB(t)
+			mock.ExpectQuery(leavePolicyQuery).WithArgs("6").
+				WillReturnRows(sqlmock.NewRows(policyColumns).AddRow(nil, nil, nil, nil, true, false, true, nil, "", nil, "", false, 30))
+			mock.ExpectQuery(holidaysQuery).WithArgs("2022-04-20", "2022-04-21").
+				WillReturnRows(sqlmock.NewRows([]string{"holiday_date", "name"}))
+			expectBlackoutPeriods(mock, "1", "6", "2022-04-20", "2022-04-21")
+			mock.ExpectBegin()
+			expectLockEmployee(mock, "1")
+			expectCompOffCredits(mock)
+			mock.ExpectQuery(compOffSpendsQuery).WithArgs("1", "6", declined, "0").WillReturnRows(test.spends)
+			if test.isError != nil {
+				mock.ExpectQuery(holidaysQuery).WithArgs("2022-04-11", "2022-04-11").
+					WillReturnRows(sqlmock.NewRows([]string{"holiday_date", "name"}))
+				mock.ExpectRollback()
+			} else {
+				expectCoverageRules(mock, "1")
+				mock.ExpectExec(`INSERT INTO lm_leave_application`).
+					WithArgs("1", 6, time.Now().Format(dateTimeFormat), "2022-04-20", "2022-04-21", 2, 0, "Comp-off", 0, 0, 0, 0).
+					WillReturnResult(sqlmock.NewResult(4, 1))
+				expectLeaveSnapshot(mock, "4", "1", pending)
+				expectAuditEvent(mock, "4", "1", "1", auditApply)
+				mock.ExpectCommit()
+			}
+			_, err := testDB.ApplyLeave(context.Background(), &pb.ApplyLeaveRequest{
+				EmployeeId:  "1",
+				LeaveTypeId: "6",
+				FromDate:    "2022-04-20",
+				ToDate:      "2022-04-21",
+				Comment:     "Comp-off",
+			})
+			if test.isError == nil && err != nil {
+				t.Errorf("got error %v: want error: %v", err, false)
+			}
+			if test.isError != nil && (err == nil || err.Error() != test.isError.Error()) {
+				t.Errorf("got error %v: want error: %v", err, test.isError)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
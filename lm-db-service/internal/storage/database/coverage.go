@@ -0,0 +1,239 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"leavemanagement/lm-db-service/internal/tracing"
+	"leavemanagement/lm-db-service/pkg/pb"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// coverageRule is a row of lm_coverage_rule. Exactly one of managerId and
+// designationId is set and selects the group of employees it covers.
+type coverageRule struct {
+	ruleId           string
+	managerId        string
+	designationId    string
+	maxAbsent        sql.NullInt64
+	maxAbsentPercent sql.NullInt64
+}
+
+// groupColumn returns the lm_employee column and value selecting the group
+// the rule covers.
+func (r coverageRule) groupColumn() (string, string) {
+	if r.managerId != "" {
+		return "manager_id", r.managerId
+	}
+	return "designation_id", r.designationId
+}
+
+func (r coverageRule) groupName() string {
+	if r.managerId != "" {
+		return "team of manager " + r.managerId
+	}
+	return "designation " + r.designationId
+}
+
+// limit returns how many of groupSize employees may be absent on a day.
+func (r coverageRule) limit(groupSize int) int {
+	limit := groupSize
+	if r.maxAbsent.Valid && int(r.maxAbsent.Int64) < limit {
+		limit = int(r.maxAbsent.Int64)
+	}
+	if r.maxAbsentPercent.Valid && groupSize*int(r.maxAbsentPercent.Int64)/100 < limit {
+		limit = groupSize * int(r.maxAbsentPercent.Int64) / 100
+	}
+	return limit
+}
+
+// coverageBreach is a day on which approving a leave would leave more of a
+// group absent than its coverage rule allows.
+type coverageBreach struct {
+	date      string
+	group     string
+	absent    int
+	groupSize int
+	limit     int
+}
+
+func (b coverageBreach) String() string {
+	return fmt.Sprintf("%s: %d of %d in %s absent, at most %d allowed", b.date, b.absent, b.groupSize, b.group, b.limit)
+}
+
+// coverageError is returned by ChangeLeaveStatus when an approval is blocked
+// by coverage rules.
+func coverageError(breaches []coverageBreach) error {
+	days := make([]string, len(breaches))
+	for i, breach := range breaches {
+		days[i] = breach.String()
+	}
+	return status.Error(codes.FailedPrecondition, "coverage limit exceeded on "+strings.Join(days, "; "))
+}
+
+// coverageWarnings describes breaches for the ApplyLeave and UpdateLeave
+// responses.
+func coverageWarnings(breaches []coverageBreach) []string {
+	var warnings []string
+	for _, breach := range breaches {
+		warnings = append(warnings, "coverage limit exceeded if approved on "+breach.String())
+	}
+	return warnings
+}
+
+// coverageBreaches returns the working days from fromDate to toDate on which
+// the employee being on leave, on top of the approved leave of the rest of
+// their groups, would exceed a coverage rule. Weekends and holidays are
+// skipped, as TeamCalendar shows nobody available on them anyway.
+//
+// On a transaction the rules are read FOR UPDATE, so concurrent approvals in
+// a group wait for each other and the group's leaves are read only once the
+// earlier approval is committed.
+func (d MysqlDB) coverageBreaches(ctx context.Context, q queryer, employeeId, fromDate, toDate string) ([]coverageBreach, error) {
+	rules, err := d.getCoverageRules(ctx, q, employeeId)
+	if err != nil || len(rules) == 0 {
+		return nil, err
+	}
+	holidays, err := d.getHolidays(ctx, fromDate, toDate)
+	if err != nil {
+		return nil, err
+	}
+	var breaches []coverageBreach
+	for _, rule := range rules {
+		groupSize, err := d.getGroupSize(ctx, q, rule)
+		if err != nil {
+			return nil, err
+		}
+		limit := rule.limit(groupSize)
+		leaves, err := d.getGroupLeaves(ctx, q, rule, employeeId, fromDate, toDate)
+		if err != nil {
+			return nil, err
+		}
+		startDate, _ := time.Parse(dateFormat, fromDate)
+		endDate, _ := time.Parse(dateFormat, toDate)
+		for date := startDate; !date.After(endDate); date = date.AddDate(0, 0, 1) {
+			day := date.Format(dateFormat)
+			if date.Weekday() == time.Saturday || date.Weekday() == time.Sunday || holidays[day] != "" {
+				continue
+			}
+			absent := map[string]bool{employeeId: true}
+			for _, leave := range leaves {
+				if leave.fromDate <= day && leave.toDate >= day {
+					absent[leave.member.EmployeeId] = true
+				}
+			}
+			if len(absent) > limit {
+				breaches = append(breaches, coverageBreach{
+					date:      day,
+					group:     rule.groupName(),
+					absent:    len(absent),
+					groupSize: groupSize,
+					limit:     limit,
+				})
+			}
+		}
+	}
+	return breaches, nil
+}
+
+// getCoverageRules returns the rules covering the team or the designation of
+// the employee, locking them.
+func (d MysqlDB) getCoverageRules(ctx context.Context, q queryer, employeeId string) ([]coverageRule, error) {
+	coverageRulesQuery := `
+					SELECT
+						rule_id,
+						IFNULL(lm_coverage_rule.manager_id,""),
+						IFNULL(lm_coverage_rule.designation_id,""),
+						max_absent,
+						max_absent_percent
+					FROM lm_coverage_rule
+					INNER JOIN lm_employee
+						ON lm_coverage_rule.manager_id=lm_employee.manager_id
+						OR lm_coverage_rule.designation_id=lm_employee.designation_id
+					WHERE employee_id=?
+					ORDER BY rule_id
+					FOR UPDATE`
+	ctx, span, end := d.startQuery(ctx, "coverageRules", "SELECT", "lm_coverage_rule")
+	defer end()
+	rows, err := q.QueryContext(ctx, coverageRulesQuery, employeeId)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	defer rows.Close()
+	var rules []coverageRule
+	for rows.Next() {
+		var rule coverageRule
+		err = rows.Scan(&rule.ruleId, &rule.managerId, &rule.designationId, &rule.maxAbsent, &rule.maxAbsentPercent)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	if err = rows.Err(); err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	return rules, nil
+}
+func (d MysqlDB) getGroupSize(ctx context.Context, q queryer, rule coverageRule) (int, error) {
+	var groupSize int
+	column, value := rule.groupColumn()
+	groupSizeQuery := fmt.Sprintf(`SELECT COUNT(*) FROM lm_employee WHERE %s=?`, column)
+	ctx, span, end := d.startQuery(ctx, "groupSize", "SELECT", "lm_employee")
+	defer end()
+	err := q.QueryRowContext(ctx, groupSizeQuery, value).Scan(&groupSize)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return 0, err
+	}
+	return groupSize, nil
+}
+
+// getGroupLeaves returns the approved applications of the other employees of
+// the group of rule that overlap fromDate to toDate.
+func (d MysqlDB) getGroupLeaves(ctx context.Context, q queryer, rule coverageRule, employeeId, fromDate, toDate string) ([]teamLeave, error) {
+	column, value := rule.groupColumn()
+	groupLeavesQuery := fmt.Sprintf(`
+					SELECT
+						employee_id,
+						from_date,
+						to_date
+					FROM lm_leave_application
+					INNER JOIN lm_employee USING (employee_id)
+					WHERE %s=?
+						AND employee_id<>?
+						AND deleted_at IS NULL
+						AND leave_status=?
+						AND to_date>=?
+						AND from_date<=?`, column)
+	ctx, span, end := d.startQuery(ctx, "groupLeaves", "SELECT", "lm_leave_application")
+	defer end()
+	rows, err := q.QueryContext(ctx, groupLeavesQuery, value, employeeId, approved, fromDate, toDate)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	defer rows.Close()
+	var leaves []teamLeave
+	for rows.Next() {
+		leave := teamLeave{member: &pb.TeamMemberLeave{}}
+		err = rows.Scan(&leave.member.EmployeeId, &leave.fromDate, &leave.toDate)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return nil, err
+		}
+		leave.fromDate, leave.toDate = dateOnly(leave.fromDate), dateOnly(leave.toDate)
+		leaves = append(leaves, leave)
+	}
+	if err = rows.Err(); err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	return leaves, nil
+}
@@ -0,0 +1,246 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"leavemanagement/lm-db-service/pkg/pb"
+	"reflect"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+var coverageRuleColumns = []string{"rule_id", "manager_id", "designation_id", "max_absent", "max_absent_percent"}
+
+const coverageRulesQuery = `FROM lm_coverage_rule\s+INNER JOIN lm_employee`
+
+// expectCoverageRules expects the coverage rule lookup of employeeId to find
+// no rules.
+func expectCoverageRules(mock sqlmock.Sqlmock, employeeId string) {
+	mock.ExpectQuery(coverageRulesQuery).WithArgs(employeeId).WillReturnRows(sqlmock.NewRows(coverageRuleColumns))
+}
+
+// expectTeamCoverage expects a rule allowing one member of the team of
+// manager 8 out of four to be absent, while employee 3 is on approved leave
+// from 2022-04-21 to 2022-04-25.
+func expectTeamCoverage(mock sqlmock.Sqlmock, employeeId, fromDate, toDate string) {
+	mock.ExpectQuery(coverageRulesQuery).WithArgs(employeeId).
+		WillReturnRows(sqlmock.NewRows(coverageRuleColumns).AddRow("1", "8", "", 1, nil))
+	mock.ExpectQuery(holidaysQuery).WithArgs(fromDate, toDate).WillReturnRows(sqlmock.NewRows([]string{"holiday_date", "name"}))
+	mock.ExpectQuery(`SELECT COUNT\(\*\) FROM lm_employee WHERE manager_id=\?`).WithArgs("8").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(4))
+	mock.ExpectQuery(`WHERE manager_id=\? AND employee_id<>\? AND deleted_at IS NULL AND leave_status=\?`).
+		WithArgs("8", employeeId, approved, fromDate, toDate).
+		WillReturnRows(sqlmock.NewRows([]string{"employee_id", "from_date", "to_date"}).
+			AddRow("3", "2022-04-21T00:00:00+05:30", "2022-04-25T00:00:00+05:30"))
+}
+
+func TestCoverageRule_limit(t *testing.T) {
+	tests := []struct {
+		description string
+		rule        coverageRule
+		expected    int
+	}{
+		{
+			description: "max absent",
+			rule:        coverageRule{maxAbsent: sql.NullInt64{Int64: 2, Valid: true}},
+			expected:    2,
+		},
+		{
+			description: "percent",
+			rule:        coverageRule{maxAbsentPercent: sql.NullInt64{Int64: 30, Valid: true}},
+			expected:    3,
+		},
+		{
+			description: "lower of both",
+			rule:        coverageRule{maxAbsent: sql.NullInt64{Int64: 4, Valid: true}, maxAbsentPercent: sql.NullInt64{Int64: 30, Valid: true}},
+			expected:    3,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			if actual := test.rule.limit(10); actual != test.expected {
+				t.Errorf("expected %v: got %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestMySqlMock_coverageBreachesWorkingDays(t *testing.T) {
+	testDB, mock := getTestMysqlDB(t)
+	mock.ExpectQuery(coverageRulesQuery).WithArgs("1").
+		WillReturnRows(sqlmock.NewRows(coverageRuleColumns).AddRow("1", "8", "", 1, nil))
+	mock.ExpectQuery(holidaysQuery).WithArgs("2022-04-21", "2022-04-25").
+		WillReturnRows(sqlmock.NewRows([]string{"holiday_date", "name"}).AddRow("2022-04-22", "Founders Day"))
+	mock.ExpectQuery(`SELECT COUNT\(\*\) FROM lm_employee WHERE manager_id=\?`).WithArgs("8").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(4))
+	mock.ExpectQuery(`WHERE manager_id=\? AND employee_id<>\? AND deleted_at IS NULL AND leave_status=\?`).
+		WithArgs("8", "1", approved, "2022-04-21", "2022-04-25").
+		WillReturnRows(sqlmock.NewRows([]string{"employee_id", "from_date", "to_date"}).
+			AddRow("3", "2022-04-21T00:00:00+05:30", "2022-04-25T00:00:00+05:30"))
+	breaches, err := testDB.coverageBreaches(context.Background(), testDB.DB, "1", "2022-04-21", "2022-04-25")
+	if err != nil {
+		t.Errorf("got error %v: want error: %v", err, false)
+	}
+	// 2022-04-22 is a holiday and 2022-04-23 and 24 a weekend.
+	var days []string
+	for _, breach := range breaches {
+		days = append(days, breach.date)
+	}
+	expected := []string{"2022-04-21", "2022-04-25"}
+	if !reflect.DeepEqual(days, expected) {
+		t.Errorf("expected %v: got %v", expected, days)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMySqlMock_ApplyLeaveCoverageWarning(t *testing.T) {
+	testDB, mock := getTestMysqlDB(t)
+	expectLeavePolicy(mock, "1", "2022-04-20", "2022-04-21")
+	expectBlackoutPeriods(mock, "1", "1", "2022-04-20", "2022-04-21")
+	mock.ExpectBegin()
+	expectLockEmployee(mock, "1")
+	expectAllowedDays(mock, "1", "1", 3)
+	mock.ExpectQuery(`SELECT IFNULL\(SUM\(IF\(from_date>=\?, no_of_days-loss_of_pay_days, advance_days\)\),0\)`).WithArgs("2022-01-01", "1", "1", declined, "2021-01-01", "2022-12-31").
+		WillReturnRows(sqlmock.NewRows([]string{"no_of_days"}).AddRow("1"))
+	expectAdvancesBefore(mock, "1", "1", "2021-01-01")
+	expectEncashedDays(mock, "1", "1", "2022", 0)
+	expectAdjustedDays(mock, "1", "1", "2022", 0)
+	expectTeamCoverage(mock, "1", "2022-04-20", "2022-04-21")
+	mock.ExpectExec(`INSERT INTO lm_leave_application`).
+		WithArgs("1", 1, time.Now().Format(dateTimeFormat), "2022-04-20", "2022-04-21", 2, 0, "Fever", 0, 0, 0, 0).
+		WillReturnResult(sqlmock.NewResult(4, 1))
+	expectLeaveSnapshot(mock, "4", "1", pending)
+	expectAuditEvent(mock, "4", "1", "1", auditApply)
+	mock.ExpectCommit()
+	got, err := testDB.ApplyLeave(context.Background(), &pb.ApplyLeaveRequest{
+		EmployeeId:  "1",
+		LeaveTypeId: "1",
+		FromDate:    "2022-04-20",
+		ToDate:      "2022-04-21",
+		Comment:     "Fever",
+	})
+	if err != nil {
+		t.Errorf("got error %v: want error: %v", err, false)
+	}
+	expected := "coverage limit exceeded if approved on 2022-04-21: 2 of 4 in team of manager 8 absent, at most 1 allowed"
+	if len(got.Warnings) != 1 || got.Warnings[0] != expected {
+		t.Errorf("expected %v: got %v", expected, got.Warnings)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMySqlMock_UpdateLeaveCoverageWarning(t *testing.T) {
+	testDB, mock := getTestMysqlDB(t)
+	mock.ExpectBegin()
+	expectLockEmployee(mock, "1")
+	expectLeaveSnapshot(mock, "2", "1", approved)
+	expectLeavePolicy(mock, "1", "2022-04-21", "2022-04-21")
+	expectAllowedDays(mock, "1", "1", 3)
+	mock.ExpectQuery(`SELECT IFNULL\(SUM\(IF\(from_date>=\?, no_of_days-loss_of_pay_days, advance_days\)\),0\)`).WithArgs("2022-01-01", "1", "1", declined, "2021-01-01", "2022-12-31").
+		WillReturnRows(sqlmock.NewRows([]string{"no_of_days"}).AddRow("2"))
+	expectAdvancesBefore(mock, "1", "1", "2021-01-01")
+	expectEncashedDays(mock, "1", "1", "2022", 0)
+	expectAdjustedDays(mock, "1", "1", "2022", 0)
+	expectBlackoutPeriods(mock, "1", "1", "2022-04-21", "2022-04-21")
+	expectTeamCoverage(mock, "1", "2022-04-21", "2022-04-21")
+	mock.ExpectExec(`UPDATE lm_leave_application SET`).WillReturnResult(sqlmock.NewResult(0, 1))
+	expectLeaveSnapshot(mock, "2", "1", pending)
+	expectAuditEvent(mock, "2", "1", "1", auditUpdate)
+	mock.ExpectCommit()
+	got, err := testDB.UpdateLeave(context.Background(), &pb.UpdateLeaveRequest{
+		ApplicationId: "2",
+		EmployeeId:    "1",
+		FromDate:      "2022-04-21",
+		Version:       "1",
+		UpdateMask:    &fieldmaskpb.FieldMask{Paths: []string{"fromDate"}},
+	})
+	if err != nil {
+		t.Errorf("got error %v: want error: %v", err, false)
+	}
+	expected := "coverage limit exceeded if approved on 2022-04-21: 2 of 4 in team of manager 8 absent, at most 1 allowed"
+	if len(got.Warnings) != 1 || got.Warnings[0] != expected {
+		t.Errorf("expected %v: got %v", expected, got.Warnings)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMySqlMock_ChangeLeaveStatusCoverage(t *testing.T) {
+	tests := []struct {
+		description   string
+		request       *pb.ChangeLeaveStatusRequest
+		designationId string
+		isError       codes.Code
+	}{
+		{
+			description:   "blocked",
+			request:       &pb.ChangeLeaveStatusRequest{EmployeeId: "8", ApplicationId: "2", LeaveStatus: approved, Version: "1"},
+			designationId: managerId,
+			isError:       codes.FailedPrecondition,
+		},
+		{
+			description:   "hr override",
+			request:       &pb.ChangeLeaveStatusRequest{EmployeeId: "7", ApplicationId: "2", LeaveStatus: approved, Version: "1", OverrideReason: "product launch"},
+			designationId: hrId,
+			isError:       codes.OK,
+		},
+		{
+			description:   "manager override",
+			request:       &pb.ChangeLeaveStatusRequest{EmployeeId: "8", ApplicationId: "2", LeaveStatus: approved, Version: "1", OverrideReason: "product launch"},
+			designationId: managerId,
+			isError:       codes.Unknown,
+		},
+		{
+			description: "override of a decline",
+			request:     &pb.ChangeLeaveStatusRequest{EmployeeId: "7", ApplicationId: "2", LeaveStatus: declined, Version: "1", OverrideReason: "product launch"},
+			isError:     codes.Unknown,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			testDB, mock := getTestMysqlDB(t)
+			if test.designationId != "" {
+				expectDesignation(mock, test.request.EmployeeId, test.designationId)
+			}
+			switch test.description {
+			case "blocked":
+				mock.ExpectBegin()
+				expectLockApplicant(mock, "2", "1")
+				expectLeaveSnapshot(mock, "2", "1", pending)
+				expectTeamCoverage(mock, "1", "2022-04-20", "2022-04-21")
+				mock.ExpectRollback()
+			case "hr override":
+				mock.ExpectBegin()
+				expectLockApplicant(mock, "2", "1")
+				expectLeaveSnapshot(mock, "2", "1", pending)
+				mock.ExpectExec(`UPDATE lm_leave_application SET leave_status=\?`).
+					WithArgs(approved, time.Now().Format(dateTimeFormat), "product launch", "2").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				expectLeaveSnapshot(mock, "2", "1", approved)
+				expectAuditEvent(mock, "2", "1", "7", auditChangeStatus)
+				mock.ExpectCommit()
+			}
+			err := testDB.ChangeLeaveStatus(context.Background(), test.request)
+			if test.isError == codes.OK {
+				if err != nil {
+					t.Errorf("got error %v: want error: %v", err, false)
+				}
+			} else if err == nil || status.Code(err) != test.isError {
+				t.Errorf("got error %v: want error: %v", err, test.isError)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
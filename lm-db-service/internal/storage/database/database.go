@@ -5,7 +5,10 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"leavemanagement/lm-db-service/internal/metrics"
+	"leavemanagement/lm-db-service/internal/policy"
 	"leavemanagement/lm-db-service/internal/storage/validation"
+	"leavemanagement/lm-db-service/internal/tracing"
 	"leavemanagement/lm-db-service/models"
 	"leavemanagement/lm-db-service/pkg/pb"
 	"math"
@@ -15,10 +18,34 @@ import (
 	"github.com/go-playground/validator"
 	_ "github.com/go-sql-driver/mysql"
 	_ "github.com/golang/mock/mockgen/model"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+var tracer = otel.Tracer("leavemanagement/lm-db-service/internal/storage/database")
+
+// errStaleVersion is returned when an update or status change was made
+// against an older version of an application than the one stored.
+var errStaleVersion = status.Error(codes.Aborted, "leave application was modified by someone else, reload it and retry")
+
+// errAccessDenied is returned by canViewEmployee.
+var errAccessDenied = errors.New("access denied")
+
 type MysqlDB struct {
 	DB *sql.DB
+	// QueryTimeout bounds every statement sent to MySQL. A zero value leaves
+	// the caller's context as the only deadline.
+	QueryTimeout time.Duration
+}
+
+// queryer is implemented by both *sql.DB and *sql.Tx, so that a read can run
+// inside the transaction of its caller.
+type queryer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
 }
 
 const (
@@ -31,6 +58,14 @@ const (
 	hrId
 	managerId
 )
+const (
+	// systemActorId is recorded as the actor of changes made by background
+	// jobs rather than by an employee.
+	systemActorId = "0"
+	// purgeBatchSize bounds how many applications one PurgeDeletedLeaves
+	// call removes, keeping the transaction and its locks short.
+	purgeBatchSize = 500
+)
 const (
 	user           = "root"
 	protocol       = "tcp"
@@ -63,47 +98,413 @@ func (mysql *MysqlDB) Connect(mySql, port string) error {
 	return nil
 }
 func (mysql *MysqlDB) Test() error {
-	if err := mysql.DB.Ping(); err != nil {
+	ctx, cancel := mysql.withQueryTimeout(context.Background())
+	defer cancel()
+	if err := mysql.DB.PingContext(ctx); err != nil {
 		return errors.New("ping to mysql DB failed")
 	}
 	return nil
 }
-func (d MysqlDB) getDesignationId(employeeId string) (string, error) {
+
+// withQueryTimeout derives the context used for a single statement, applying
+// QueryTimeout on top of any deadline already carried by ctx.
+func (d MysqlDB) withQueryTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if d.QueryTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, d.QueryTimeout)
+}
+
+// startQuery opens a span for a single statement and derives its context with
+// withQueryTimeout. The returned function cancels the context and ends the span.
+func (d MysqlDB) startQuery(ctx context.Context, name, operation, table string) (context.Context, trace.Span, func()) {
+	ctx, span := tracing.StartQuery(ctx, tracer, name, operation, table)
+	ctx, cancel := d.withQueryTimeout(ctx)
+	return ctx, span, func() {
+		cancel()
+		span.End()
+	}
+}
+func (mysql *MysqlDB) Close() error {
+	return mysql.DB.Close()
+}
+func (d MysqlDB) getDesignationId(ctx context.Context, employeeId string) (string, error) {
 	var designationId string
 	getDesignationIdQuery := `SELECT designation_id FROM lm_employee where employee_id=?`
-	err := d.DB.QueryRow(getDesignationIdQuery, employeeId).Scan(&designationId)
+	ctx, span, end := d.startQuery(ctx, "getDesignationId", "SELECT", "lm_employee")
+	defer end()
+	err := d.DB.QueryRowContext(ctx, getDesignationIdQuery, employeeId).Scan(&designationId)
 	if err != nil {
+		tracing.RecordError(span, err)
 		return "", err
 	}
 	return designationId, nil
 }
-func (d MysqlDB) getTotalLeavesTaken(employeeId, leaveTypeId string) (int, error) {
+func (d MysqlDB) getManagerId(ctx context.Context, employeeId string) (string, error) {
+	var reportsTo string
+	getManagerIdQuery := `SELECT IFNULL(manager_id,"") FROM lm_employee where employee_id=?`
+	ctx, span, end := d.startQuery(ctx, "getManagerId", "SELECT", "lm_employee")
+	defer end()
+	err := d.DB.QueryRowContext(ctx, getManagerIdQuery, employeeId).Scan(&reportsTo)
+	if err != nil {
+		tracing.RecordError(span, err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", errors.New("employee not found")
+		}
+		return "", err
+	}
+	return reportsTo, nil
+}
+
+// canViewEmployee returns an error unless callerId may see the leave of
+// employeeId: everyone sees their own, HR sees everyone and managers see the
+// employees reporting to them.
+func (d MysqlDB) canViewEmployee(ctx context.Context, callerId, employeeId string) error {
+	if callerId == employeeId {
+		return nil
+	}
+	designationId, err := d.getDesignationId(ctx, callerId)
+	if err != nil {
+		return err
+	}
+	switch designationId {
+	case hrId:
+		return nil
+	case managerId:
+		reportsTo, err := d.getManagerId(ctx, employeeId)
+		if err != nil {
+			return err
+		}
+		if reportsTo == callerId {
+			return nil
+		}
+	}
+	return errAccessDenied
+}
+
+// getTotalLeavesTaken returns the paid days of leaveTypeId the employee has
+// applied for in the leave year containing date, leaving out declined
+// applications, and the days advanced before, which are recovered from this
+// year's allowance.
+func (d MysqlDB) getTotalLeavesTaken(ctx context.Context, q queryer, employeeId, leaveTypeId, date string) (int, error) {
 	var totalLeavesTaken int
 	totalLeavesTakenQuery := `
 						SELECT 
-							IFNULL(SUM(no_of_days),0) 
+							IFNULL(SUM(IF(from_date>=?, no_of_days-loss_of_pay_days, advance_days)),0) 
 						FROM lm_leave_application 
 						WHERE 
 							employee_id =? 
-							AND leave_type_id=?`
-	err := d.DB.QueryRow(totalLeavesTakenQuery, employeeId, leaveTypeId).Scan(&totalLeavesTaken)
+							AND leave_type_id=?
+							AND leave_status<>?
+							AND deleted_at IS NULL
+							AND from_date BETWEEN ? AND ?`
+	yearStart, yearEnd := leaveYear(date)
+	previousYearStart, _ := leaveYear(previousLeaveYear(date))
+	ctx, span, end := d.startQuery(ctx, "totalLeavesTaken", "SELECT", "lm_leave_application")
+	defer end()
+	err := q.QueryRowContext(ctx, totalLeavesTakenQuery, yearStart, employeeId, leaveTypeId, declined, previousYearStart, yearEnd).
+		Scan(&totalLeavesTaken)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return 0, err
+	}
+	unrecovered, err := d.getUnrecoveredAdvance(ctx, q, employeeId, leaveTypeId, date)
 	if err != nil {
 		return 0, err
 	}
-	return totalLeavesTaken, nil
+	return totalLeavesTaken + unrecovered, nil
+}
+
+// getUnrecoveredAdvance returns the days of leaveTypeId the employee advanced
+// before the leave year containing date that were not recovered by the leave
+// year before it. Days advanced beyond the allowance of the next leave year
+// carry on to the one after, until an allowance covers them.
+func (d MysqlDB) getUnrecoveredAdvance(ctx context.Context, q queryer, employeeId, leaveTypeId, date string) (int, error) {
+	previousYear := previousLeaveYear(date)
+	previousYearStart, _ := leaveYear(previousYear)
+	advances, firstYear, err := d.getAdvancesBefore(ctx, q, employeeId, leaveTypeId, previousYearStart)
+	if err != nil || len(advances) == 0 {
+		return 0, err
+	}
+	first, _ := strconv.Atoi(firstYear)
+	last, _ := strconv.Atoi(previousYear)
+	// carried is what is charged to a leave year from the ones before it.
+	carried := 0
+	for year := first; year <= last; year++ {
+		if carried > 0 {
+			allowedDays, err := d.getAllowedDays(ctx, employeeId, leaveTypeId, strconv.Itoa(year))
+			if err != nil {
+				return 0, err
+			}
+			adjustedDays, err := d.getAdjustedDays(ctx, q, employeeId, leaveTypeId, strconv.Itoa(year))
+			if err != nil {
+				return 0, err
+			}
+			carried -= allowedDays + adjustedDays
+			if carried < 0 {
+				carried = 0
+			}
+		}
+		carried += advances[strconv.Itoa(year)]
+	}
+	return carried, nil
+}
+
+// getAdvancesBefore returns the days of leaveTypeId the employee advanced
+// before date by leave year, leaving out declined applications, and the
+// first of those years.
+func (d MysqlDB) getAdvancesBefore(ctx context.Context, q queryer, employeeId, leaveTypeId, date string) (map[string]int, string, error) {
+	advancesQuery := `
+					SELECT YEAR(from_date), SUM(advance_days) 
+					FROM lm_leave_application 
+					WHERE employee_id=? 
+						AND leave_type_id=? 
+						AND leave_status<>? 
+						AND deleted_at IS NULL 
+						AND advance_days>0 
+						AND from_date<? 
+					GROUP BY YEAR(from_date) 
+					ORDER BY YEAR(from_date)`
+	ctx, span, end := d.startQuery(ctx, "advancesBefore", "SELECT", "lm_leave_application")
+	defer end()
+	rows, err := q.QueryContext(ctx, advancesQuery, employeeId, leaveTypeId, declined, date)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, "", err
+	}
+	defer rows.Close()
+	advances := map[string]int{}
+	var firstYear string
+	for rows.Next() {
+		var year string
+		var days int
+		err = rows.Scan(&year, &days)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return nil, "", err
+		}
+		if firstYear == "" {
+			firstYear = year
+		}
+		advances[year] = days
+	}
+	if err = rows.Err(); err != nil {
+		tracing.RecordError(span, err)
+		return nil, "", err
+	}
+	return advances, firstYear, nil
 }
-func (d MysqlDB) getAllowedDays(leaveTypeId string) (int, error) {
+
+// getAllowedDays returns the days of leaveTypeId the employee earns in the
+// leave year containing date, pro-rated by the days of that year between
+// their joining and exit dates using the rounding of the leave type.
+func (d MysqlDB) getAllowedDays(ctx context.Context, employeeId, leaveTypeId, date string) (int, error) {
 	var noOfDaysAllowed int
-	allowedDaysQuery := `SELECT number_of_days_allowed FROM lm_leave_type WHERE leave_type_id=?`
-	err := d.DB.QueryRow(allowedDaysQuery, leaveTypeId).Scan(&noOfDaysAllowed)
+	var rounding string
+	var joining, exit sql.NullString
+	allowedDaysQuery := `
+					SELECT number_of_days_allowed, proration_rounding, date_of_joining, date_of_exit
+					FROM lm_leave_type
+					INNER JOIN lm_employee ON lm_employee.employee_id=?
+					WHERE leave_type_id=?`
+	ctx, span, end := d.startQuery(ctx, "allowedDays", "SELECT", "lm_leave_type")
+	defer end()
+	err := d.DB.QueryRowContext(ctx, allowedDaysQuery, employeeId, leaveTypeId).Scan(&noOfDaysAllowed, &rounding, &joining, &exit)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return 0, err
+	}
+	return proRated(noOfDaysAllowed, rounding, date, nullableDate(joining), nullableDate(exit)), nil
+}
+
+// proRated returns the days of allowed earned in the leave year containing
+// date by an employee in service from joining to exit.
+func proRated(allowed int, rounding, date string, joining, exit *time.Time) int {
+	from, to := leaveYear(date)
+	yearStart, _ := time.Parse(dateFormat, from)
+	yearEnd, _ := time.Parse(dateFormat, to)
+	return policy.ProRate(allowed, policy.Rounding(rounding), yearStart, yearEnd, joining, exit)
+}
+
+// getNegativeBalanceLimit returns how many days below zero the balance of
+// leaveTypeId may go.
+func (d MysqlDB) getNegativeBalanceLimit(ctx context.Context, leaveTypeId string) (int, error) {
+	var limit int
+	negativeBalanceLimitQuery := `SELECT negative_balance_limit FROM lm_leave_type WHERE leave_type_id=?`
+	ctx, span, end := d.startQuery(ctx, "negativeBalanceLimit", "SELECT", "lm_leave_type")
+	defer end()
+	err := d.DB.QueryRowContext(ctx, negativeBalanceLimitQuery, leaveTypeId).Scan(&limit)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return 0, err
+	}
+	return limit, nil
+}
+
+// leaveDays returns the number of days from fromDate to toDate, both
+// included.
+func leaveDays(fromDate, toDate string) int {
+	startDate, _ := time.Parse(dateFormat, fromDate)
+	endDate, _ := time.Parse(dateFormat, toDate)
+	return int(math.Ceil(endDate.Sub(startDate).Hours()/24)) + 1
+}
+
+// leaveYear returns the first and last day of the calendar leave year
+// containing date.
+func leaveYear(date string) (string, string) {
+	year := date
+	if len(year) > 4 {
+		year = year[:4]
+	}
+	return year + "-01-01", year + "-12-31"
+}
+
+// previousLeaveYear returns the leave year before the one containing date.
+func previousLeaveYear(date string) string {
+	year, _ := strconv.Atoi(date[:4])
+	return strconv.Itoa(year - 1)
+}
+
+// leaveSplit is how the days of an application are paid for.
+type leaveSplit struct {
+	// balance is what is left of the allowance after the application,
+	// negative when days are advanced.
+	balance int
+	// advanceDays are the days of the application taken below a zero
+	// balance, recovered from next year's allowance.
+	advanceDays int
+	// lossOfPayDays are the days of the application taken unpaid.
+	lossOfPayDays int
+}
+
+// leaveBalanceAfter works out how noOfDays more of leaveTypeId in the leave
+// year of fromDate are paid for, after what was applied for and encashed. HR
+// adjustments of the year add to the allowance.
+// Days beyond the allowance are advanced down to the negative balance limit
+// of the leave type; the rest is loss of pay if acceptLossOfPay is set,
+// otherwise it is an error. replacedDays are paid days already counted in the
+// total that are being replaced, as when an application is edited.
+func (d MysqlDB) leaveBalanceAfter(ctx context.Context, q queryer, employeeId, leaveTypeId, fromDate string, noOfDays, replacedDays int,
+	acceptLossOfPay bool) (leaveSplit, error) {
+	noOfDaysAllowed, err := d.getAllowedDays(ctx, employeeId, leaveTypeId, fromDate)
+	if err != nil {
+		return leaveSplit{}, err
+	}
+
+	totalLeavesTaken, err := d.getTotalLeavesTaken(ctx, q, employeeId, leaveTypeId, fromDate)
+	if err != nil {
+		return leaveSplit{}, err
+	}
+	encashedDays, err := d.getEncashedDays(ctx, q, employeeId, leaveTypeId, fromDate)
+	if err != nil {
+		return leaveSplit{}, err
+	}
+	totalLeavesTaken += encashedDays - replacedDays
+	adjustedDays, err := d.getAdjustedDays(ctx, q, employeeId, leaveTypeId, fromDate)
+	if err != nil {
+		return leaveSplit{}, err
+	}
+	noOfDaysAllowed += adjustedDays
+
+	remaining := noOfDaysAllowed - totalLeavesTaken
+	if remaining >= noOfDays {
+		return leaveSplit{balance: remaining - noOfDays}, nil
+	}
+	limit, err := d.getNegativeBalanceLimit(ctx, leaveTypeId)
+	if err != nil {
+		return leaveSplit{}, err
+	}
+	paidDays := noOfDays
+	if remaining+limit < paidDays {
+		if !acceptLossOfPay {
+			return leaveSplit{}, errors.New("leaves not remaining")
+		}
+		paidDays = remaining + limit
+		if paidDays < 0 {
+			paidDays = 0
+		}
+	}
+	split := leaveSplit{balance: remaining - paidDays, lossOfPayDays: noOfDays - paidDays}
+	if split.balance < 0 {
+		split.advanceDays = -split.balance
+		if remaining < 0 {
+			split.advanceDays += remaining
+		}
+	}
+	return split, nil
+}
+
+// lockEmployee locks the row of the employee in lm_employee until tx ends, so
+// that changes to the employee's balance checked under it are made one after
+// the other.
+func (d MysqlDB) lockEmployee(ctx context.Context, tx *sql.Tx, employeeId string) error {
+	var lockedId string
+	lockEmployeeQuery := `SELECT employee_id FROM lm_employee WHERE employee_id=? FOR UPDATE`
+	ctx, span, end := d.startQuery(ctx, "lockEmployee", "SELECT", "lm_employee")
+	defer end()
+	err := tx.QueryRowContext(ctx, lockEmployeeQuery, employeeId).Scan(&lockedId)
+	if err != nil {
+		tracing.RecordError(span, err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("employee not found")
+		}
+		return err
+	}
+	return nil
+}
+
+// lockApplicant locks the row of the employee who applied for applicationId,
+// as lockEmployee does. It is taken before the application row, so that it
+// is locked in the same order as by ApplyLeave.
+func (d MysqlDB) lockApplicant(ctx context.Context, tx *sql.Tx, applicationId string) error {
+	var employeeId string
+	applicantQuery := `SELECT employee_id FROM lm_leave_application WHERE application_id=?`
+	queryCtx, span, end := d.startQuery(ctx, "applicant", "SELECT", "lm_leave_application")
+	err := tx.QueryRowContext(queryCtx, applicantQuery, applicationId).Scan(&employeeId)
+	end()
+	if err != nil {
+		tracing.RecordError(span, err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("leave application not found")
+		}
+		return err
+	}
+	return d.lockEmployee(ctx, tx, employeeId)
+}
+
+// dateOnly converts a date read back from MySQL to the YYYY-MM-DD format used
+// in requests.
+func dateOnly(value string) string {
+	date, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return value
+	}
+	return date.Format(dateFormat)
+}
+
+// CountPendingLeaves returns the number of applications still pending that
+// were submitted more than olderThan ago.
+func (d MysqlDB) CountPendingLeaves(ctx context.Context, olderThan time.Duration) (int, error) {
+	var count int
+	countPendingQuery := `
+						SELECT 
+							COUNT(*) 
+						FROM lm_leave_application 
+						WHERE 
+							leave_status=? 
+							AND date_of_application<?
+							AND deleted_at IS NULL`
+	ctx, span, end := d.startQuery(ctx, "countPending", "SELECT", "lm_leave_application")
+	defer end()
+	err := d.DB.QueryRowContext(ctx, countPendingQuery, pending, time.Now().Add(-olderThan)).Scan(&count)
 	if err != nil {
+		tracing.RecordError(span, err)
 		return 0, err
 	}
-	return noOfDaysAllowed, err
+	return count, nil
 }
 
-func (d MysqlDB) ApplyLeave(ctx context.Context, req *pb.ApplyLeaveRequest) error {
-	var leaveBalance int
+func (d MysqlDB) ApplyLeave(ctx context.Context, req *pb.ApplyLeaveRequest) (*pb.ApplyLeaveResponse, error) {
 	leaveTypeId, _ := strconv.ParseInt(req.LeaveTypeId, 10, 32)
 	validate := validator.New()
 	fields := models.ValidateApplyLeave{
@@ -115,15 +516,19 @@ func (d MysqlDB) ApplyLeave(ctx context.Context, req *pb.ApplyLeaveRequest) erro
 	}
 	err := validate.Struct(fields)
 	if err != nil {
-		return errors.New("invalid input")
+		return &pb.ApplyLeaveResponse{}, errors.New("invalid input")
 	}
 	err = validation.ValidateFromDate(req.FromDate)
 	if err != nil {
-		return err
+		return &pb.ApplyLeaveResponse{}, err
 	}
 	err = validation.ValidateToDate(req.ToDate)
 	if err != nil {
-		return err
+		return &pb.ApplyLeaveResponse{}, err
+	}
+	leavePolicy, duration, err := d.checkLeavePolicy(ctx, req.EmployeeId, req.LeaveTypeId, "", req.FromDate, req.ToDate)
+	if err != nil {
+		return &pb.ApplyLeaveResponse{}, err
 	}
 
 	applyLeaveQuery := `
@@ -135,34 +540,74 @@ func (d MysqlDB) ApplyLeave(ctx context.Context, req *pb.ApplyLeaveRequest) erro
 						to_date,
 						no_of_days,
 						leave_balance,
-						comment) 
-					VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
-	startDate, _ := time.Parse(dateFormat, fields.FromDate)
-	endDate, _ := time.Parse(dateFormat, fields.ToDate)
-	noOfDays := int(math.Ceil(endDate.Sub(startDate).Hours()/24)) + 1
-
-	noOfDaysAllowed, err := d.getAllowedDays(req.LeaveTypeId)
-	if err != nil {
-		return err
-	}
-
-	totalLeavesTaken, err := d.getTotalLeavesTaken(req.EmployeeId, req.LeaveTypeId)
+						comment,
+						sandwich_before,
+						sandwich_after,
+						loss_of_pay_days,
+						advance_days) 
+					VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	noOfDays := duration.Counted()
+	warnings, err := d.checkBlackoutPeriods(ctx, req.EmployeeId, req.LeaveTypeId, fields.FromDate, fields.ToDate)
 	if err != nil {
-		return err
-	}
-
-	if noOfDaysAllowed < totalLeavesTaken+noOfDays {
-		return errors.New("leaves not remaining")
-	} else {
-		leaveBalance = noOfDaysAllowed - (totalLeavesTaken + noOfDays)
+		return &pb.ApplyLeaveResponse{}, err
 	}
 
 	dateOfApplication := time.Now().Format(dateTimeFormat)
-	_, err = d.DB.Exec(applyLeaveQuery, fields.EmployeeId, fields.LeaveTypeId, dateOfApplication, fields.FromDate, fields.ToDate, noOfDays, leaveBalance, fields.Comment)
+	var applicationId string
+	var split leaveSplit
+	// The balance and coverage are checked under the employee lock, so that
+	// applications, edits and encashments of the employee spend it one
+	// after the other.
+	err = d.withTx(ctx, func(tx *sql.Tx) error {
+		err := d.lockEmployee(ctx, tx, req.EmployeeId)
+		if err != nil {
+			return err
+		}
+		if leavePolicy.CompOffValidityDays != nil {
+			split.balance, err = d.compOffBalanceAfter(ctx, tx, leavePolicy, req.EmployeeId, req.LeaveTypeId, "", duration.CountedDates())
+		} else {
+			split, err = d.leaveBalanceAfter(ctx, tx, req.EmployeeId, req.LeaveTypeId, fields.FromDate, noOfDays, 0, req.AcceptLossOfPay)
+		}
+		if err != nil {
+			return err
+		}
+		breaches, err := d.coverageBreaches(ctx, tx, req.EmployeeId, fields.FromDate, fields.ToDate)
+		if err != nil {
+			return err
+		}
+		warnings = append(warnings, coverageWarnings(breaches)...)
+
+		execCtx, span, end := d.startQuery(ctx, "applyLeave", "INSERT", "lm_leave_application")
+		defer end()
+		result, err := tx.ExecContext(execCtx, applyLeaveQuery, fields.EmployeeId, fields.LeaveTypeId, dateOfApplication, fields.FromDate, fields.ToDate, noOfDays, split.balance, fields.Comment,
+			duration.SandwichBefore, duration.SandwichAfter, split.lossOfPayDays, split.advanceDays)
+		tracing.RecordResult(span, result)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return err
+		}
+		insertId, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		applicationId = strconv.FormatInt(insertId, 10)
+		after, err := d.getLeaveSnapshot(ctx, tx, applicationId)
+		if err != nil {
+			return err
+		}
+		return d.appendAuditEvent(ctx, tx, req.EmployeeId, auditApply, nil, after)
+	})
 	if err != nil {
-		return err
+		return &pb.ApplyLeaveResponse{}, err
 	}
-	return nil
+	metrics.LeaveSubmitted(req.LeaveTypeId)
+	return &pb.ApplyLeaveResponse{
+		ApplicationId: applicationId,
+		Warnings:      warnings,
+		Duration:      leaveDuration(duration),
+		LossOfPayDays: strconv.Itoa(split.lossOfPayDays),
+		AdvanceDays:   strconv.Itoa(split.advanceDays),
+	}, nil
 }
 func (d MysqlDB) LeavesList(ctx context.Context, req *pb.LeavesListRequest) (*pb.LeavesListResponse, error) {
 	validate := validator.New()
@@ -191,11 +636,15 @@ func (d MysqlDB) LeavesList(ctx context.Context, req *pb.LeavesListRequest) (*pb
 						leave_balance,
 						leave_status,
 						comment, 
-						IFNULL(date_of_approval,"N/A") 
+						IFNULL(date_of_approval,"N/A"),
+						version,
+						loss_of_pay_days,
+						advance_days 
 					FROM lm_leave_application 
-					INNER JOIN lm_employee USING (employee_id)`
+					INNER JOIN lm_employee USING (employee_id)
+					WHERE deleted_at IS NULL`
 
-	designationId, err := d.getDesignationId(req.EmployeeId)
+	designationId, err := d.getDesignationId(ctx, req.EmployeeId)
 	if err != nil {
 		return &pb.LeavesListResponse{}, err
 	}
@@ -203,38 +652,68 @@ func (d MysqlDB) LeavesList(ctx context.Context, req *pb.LeavesListRequest) (*pb
 		return &pb.LeavesListResponse{}, errors.New("access denied")
 	} else {
 		if req.LeaveStatus == pending || req.LeaveStatus == approved || req.LeaveStatus == declined {
-			getAllLeaveQuery = fmt.Sprintf("%v WHERE leave_status=%s", getAllLeaveQuery, req.LeaveStatus)
+			getAllLeaveQuery = fmt.Sprintf("%v AND leave_status=%s", getAllLeaveQuery, req.LeaveStatus)
 		}
-		rows, err := d.DB.Query(getAllLeaveQuery)
+		queryCtx, span, end := d.startQuery(ctx, "getAllLeave", "SELECT", "lm_leave_application")
+		defer end()
+		rows, err := d.DB.QueryContext(queryCtx, getAllLeaveQuery)
 		if err != nil {
+			tracing.RecordError(span, err)
 			return &pb.LeavesListResponse{}, err
 		}
+		defer rows.Close()
 		for rows.Next() {
-			leave := pb.GetLeaveByIdResponse{}
-			err = rows.Scan(
-				&leave.FirstName,
-				&leave.LastName,
-				&leave.ApplicationId,
-				&leave.EmployeeId,
-				&leave.LeaveTypeId,
-				&leave.DateOfApplication,
-				&leave.FromDate,
-				&leave.ToDate,
-				&leave.NoOfDays,
-				&leave.LeaveBalance,
-				&leave.LeaveStatus,
-				&leave.Comment,
-				&leave.DateOfApproval)
+			leave := &pb.GetLeaveByIdResponse{}
+			err = scanLeave(rows, leave)
 			if err != nil {
+				tracing.RecordError(span, err)
 				return &pb.LeavesListResponse{}, err
 			}
-			leaves.LeavesListResponse = append(leaves.LeavesListResponse, &leave)
+			leaves.LeavesListResponse = append(leaves.LeavesListResponse, leave)
+		}
+		if err = rows.Err(); err != nil {
+			tracing.RecordError(span, err)
+			return &pb.LeavesListResponse{}, err
 		}
 	}
 	return leaves, nil
 }
+
+// scanLeave reads a row selected with the columns of GetLeaveByIdResponse in
+// field order.
+func scanLeave(rows *sql.Rows, leave *pb.GetLeaveByIdResponse) error {
+	return rows.Scan(
+		&leave.FirstName,
+		&leave.LastName,
+		&leave.ApplicationId,
+		&leave.EmployeeId,
+		&leave.LeaveTypeId,
+		&leave.DateOfApplication,
+		&leave.FromDate,
+		&leave.ToDate,
+		&leave.NoOfDays,
+		&leave.LeaveBalance,
+		&leave.LeaveStatus,
+		&leave.Comment,
+		&leave.DateOfApproval,
+		&leave.Version,
+		&leave.LossOfPayDays,
+		&leave.AdvanceDays)
+}
+
+// GetLeaveById returns an application to its applicant, or to HR and managers.
 func (d MysqlDB) GetLeaveById(ctx context.Context, req *pb.GetLeaveByIdRequest) (*pb.GetLeaveByIdResponse, error) {
-	var leave *pb.GetLeaveByIdResponse = &pb.GetLeaveByIdResponse{}
+	validate := validator.New()
+	fields := models.ValidateGetLeaveById{
+		EmployeeId:    req.EmployeeId,
+		ApplicationId: req.ApplicationId,
+	}
+	err := validate.Struct(fields)
+	if err != nil {
+		return &pb.GetLeaveByIdResponse{}, errors.New("invalid input")
+	}
+
+	var leave *pb.GetLeaveByIdResponse
 	getGetLeaveByIdQuery := `
 					SELECT 
 						first_name,
@@ -249,83 +728,350 @@ func (d MysqlDB) GetLeaveById(ctx context.Context, req *pb.GetLeaveByIdRequest)
 						leave_balance,
 						leave_status,
 						comment,
-						IFNULL(date_of_approval,"N/A") 
+						IFNULL(date_of_approval,"N/A"),
+						version,
+						loss_of_pay_days,
+						advance_days 
 					FROM lm_leave_application 
 					INNER JOIN lm_employee 
 					USING (employee_id) 
-					WHERE application_id=?`
-	row, err := d.DB.Query(getGetLeaveByIdQuery, req.ApplicationId)
+					WHERE application_id=? AND deleted_at IS NULL`
+	queryCtx, span, end := d.startQuery(ctx, "getLeaveById", "SELECT", "lm_leave_application")
+	defer end()
+	row, err := d.DB.QueryContext(queryCtx, getGetLeaveByIdQuery, req.ApplicationId)
 	if err != nil {
+		tracing.RecordError(span, err)
 		return &pb.GetLeaveByIdResponse{}, err
 	}
+	defer row.Close()
 	for row.Next() {
-		err = row.Scan(
-			&leave.FirstName,
-			&leave.LastName,
-			&leave.ApplicationId,
-			&leave.EmployeeId,
-			&leave.LeaveTypeId,
-			&leave.DateOfApplication,
-			&leave.FromDate,
-			&leave.ToDate,
-			&leave.NoOfDays,
-			&leave.LeaveBalance,
-			&leave.LeaveStatus,
-			&leave.Comment,
-			&leave.DateOfApproval)
+		leave = &pb.GetLeaveByIdResponse{}
+		err = scanLeave(row, leave)
 		if err != nil {
+			tracing.RecordError(span, err)
 			return &pb.GetLeaveByIdResponse{}, err
 		}
 	}
+	if err = row.Err(); err != nil {
+		tracing.RecordError(span, err)
+		return &pb.GetLeaveByIdResponse{}, err
+	}
+	// An application the caller may not see is reported as not found, so
+	// that ids cannot be probed.
+	if leave == nil {
+		return &pb.GetLeaveByIdResponse{}, errors.New("leave application not found")
+	}
+	err = d.canViewEmployee(ctx, req.EmployeeId, leave.EmployeeId)
+	if errors.Is(err, errAccessDenied) {
+		return &pb.GetLeaveByIdResponse{}, errors.New("leave application not found")
+	}
+	if err != nil {
+		return &pb.GetLeaveByIdResponse{}, err
+	}
 	return leave, nil
 }
+
+// ListMyLeaves returns the caller's own applications, latest first,
+// optionally filtered by status and by dates they overlap.
+func (d MysqlDB) ListMyLeaves(ctx context.Context, req *pb.ListMyLeavesRequest) (*pb.LeavesListResponse, error) {
+	validate := validator.New()
+	fields := models.ValidateListMyLeaves{
+		EmployeeId:  req.EmployeeId,
+		LeaveStatus: req.LeaveStatus,
+		FromDate:    req.FromDate,
+		ToDate:      req.ToDate,
+	}
+	err := validate.Struct(fields)
+	if err != nil {
+		return &pb.LeavesListResponse{}, errors.New("invalid input")
+	}
+	if req.FromDate != "" {
+		err = validation.ValidateFromDate(req.FromDate)
+		if err != nil {
+			return &pb.LeavesListResponse{}, err
+		}
+	}
+	if req.ToDate != "" {
+		err = validation.ValidateToDate(req.ToDate)
+		if err != nil {
+			return &pb.LeavesListResponse{}, err
+		}
+	}
+
+	listMyLeavesQuery := `
+					SELECT 
+						first_name,
+						last_name,
+						application_id,
+						employee_id, 
+						leave_type_id, 
+						date_of_application,
+						from_date, 
+						to_date,
+						no_of_days,
+						leave_balance,
+						leave_status,
+						comment, 
+						IFNULL(date_of_approval,"N/A"),
+						version,
+						loss_of_pay_days,
+						advance_days 
+					FROM lm_leave_application 
+					INNER JOIN lm_employee USING (employee_id)
+					WHERE employee_id=? AND deleted_at IS NULL`
+	args := []interface{}{req.EmployeeId}
+	if req.LeaveStatus != "" {
+		listMyLeavesQuery += " AND leave_status=?"
+		args = append(args, req.LeaveStatus)
+	}
+	if req.FromDate != "" {
+		listMyLeavesQuery += " AND to_date>=?"
+		args = append(args, req.FromDate)
+	}
+	if req.ToDate != "" {
+		listMyLeavesQuery += " AND from_date<=?"
+		args = append(args, req.ToDate)
+	}
+	listMyLeavesQuery += " ORDER BY from_date DESC, application_id DESC"
+
+	ctx, span, end := d.startQuery(ctx, "listMyLeaves", "SELECT", "lm_leave_application")
+	defer end()
+	rows, err := d.DB.QueryContext(ctx, listMyLeavesQuery, args...)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return &pb.LeavesListResponse{}, err
+	}
+	defer rows.Close()
+	leaves := &pb.LeavesListResponse{}
+	for rows.Next() {
+		leave := &pb.GetLeaveByIdResponse{}
+		err = scanLeave(rows, leave)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return &pb.LeavesListResponse{}, err
+		}
+		leaves.LeavesListResponse = append(leaves.LeavesListResponse, leave)
+	}
+	if err = rows.Err(); err != nil {
+		tracing.RecordError(span, err)
+		return &pb.LeavesListResponse{}, err
+	}
+	return leaves, nil
+}
 func (d MysqlDB) ChangeLeaveStatus(ctx context.Context, req *pb.ChangeLeaveStatusRequest) error {
 	leaveStatus, _ := strconv.ParseInt(req.LeaveStatus, 10, 32)
 	validate := validator.New()
 	fields := models.ValidateChangeLeaveStatus{
-		EmployeeId:    req.EmployeeId,
-		ApplicationId: req.ApplicationId,
-		LeaveStatus:   int(leaveStatus),
+		EmployeeId:     req.EmployeeId,
+		ApplicationId:  req.ApplicationId,
+		LeaveStatus:    int(leaveStatus),
+		Version:        req.Version,
+		OverrideReason: req.OverrideReason,
 	}
 	err := validate.Struct(fields)
 	if err != nil {
 		return errors.New("invalid input")
 	}
+	overriding := req.OverrideReason != ""
+	if overriding && req.LeaveStatus != approved {
+		return errors.New("invalid input")
+	}
 
-	designationId, err := d.getDesignationId(req.EmployeeId)
+	designationId, err := d.getDesignationId(ctx, req.EmployeeId)
 	if err != nil {
 		return err
 	}
 
-	if designationId != managerId {
+	if overriding && designationId != hrId {
+		return errors.New("only HR can override coverage limits")
+	}
+	if designationId != managerId && designationId != hrId {
 		return errors.New("access denied")
 	} else {
-		changeLeaveStatusQuery := `
+		var leaveTypeId string
+		var advanceApproval bool
+		err = d.withTx(ctx, func(tx *sql.Tx) error {
+			// An approval may spend the balance again, see
+			// balanceOnReapproval.
+			if req.LeaveStatus == approved {
+				err := d.lockApplicant(ctx, tx, req.ApplicationId)
+				if err != nil {
+					return err
+				}
+			}
+			before, err := d.getLeaveSnapshot(ctx, tx, req.ApplicationId)
+			if err != nil {
+				return err
+			}
+			if before.deleted() {
+				return errors.New("leave application not found")
+			}
+			if before.Version != req.Version {
+				return errStaleVersion
+			}
+			// A pending application that goes into advance is approved by
+			// the manager first, which leaves it pending, and then by HR.
+			advance := before.AdvanceDays != "0" && before.LeaveStatus == pending
+			switch {
+			case designationId == managerId:
+				if advance && req.LeaveStatus == approved {
+					if before.AdvanceApprovedBy != "" {
+						return errors.New("advance leave awaits HR approval")
+					}
+					advanceApproval = true
+				}
+			case advance && before.AdvanceApprovedBy == "":
+				return errors.New("advance leave needs manager approval first")
+			case !advance && !overriding:
+				return errors.New("access denied")
+			}
+			if req.LeaveStatus == approved && !overriding {
+				breaches, err := d.coverageBreaches(ctx, tx, before.EmployeeId, dateOnly(before.FromDate), dateOnly(before.ToDate))
+				if err != nil {
+					return err
+				}
+				if len(breaches) > 0 {
+					return coverageError(breaches)
+				}
+			}
+			var split *leaveSplit
+			if req.LeaveStatus == approved && before.LeaveStatus != pending {
+				split, err = d.balanceOnReapproval(ctx, tx, before)
+				if err != nil {
+					return err
+				}
+			}
+			leaveTypeId = before.LeaveTypeId
+			changeLeaveStatusQuery := `
+							UPDATE lm_leave_application 
+							SET 
+								leave_status=?, 
+								date_of_approval=?, 
+								coverage_override_reason=NULLIF(?,""), 
+								version=version+1 
+							WHERE lm_leave_application.application_id=?`
+			args := []interface{}{req.LeaveStatus, time.Now().Format(dateTimeFormat), req.OverrideReason, req.ApplicationId}
+			if advanceApproval {
+				changeLeaveStatusQuery = `
+							UPDATE lm_leave_application 
+							SET 
+								advance_approved_by=?, 
+								version=version+1 
+							WHERE lm_leave_application.application_id=?`
+				args = []interface{}{req.EmployeeId, req.ApplicationId}
+			}
+			if split != nil {
+				changeLeaveStatusQuery = `
 							UPDATE lm_leave_application 
 							SET 
 								leave_status=?, 
-								date_of_approval=? 
+								date_of_approval=?, 
+								coverage_override_reason=NULLIF(?,""), 
+								leave_balance=?, 
+								loss_of_pay_days=?, 
+								advance_days=?, 
+								version=version+1 
 							WHERE lm_leave_application.application_id=?`
-		dateOfApproval := time.Now().Format(dateTimeFormat)
-		_, err = d.DB.Exec(changeLeaveStatusQuery, req.LeaveStatus, dateOfApproval, req.ApplicationId)
+				args = []interface{}{req.LeaveStatus, time.Now().Format(dateTimeFormat), req.OverrideReason,
+					split.balance, split.lossOfPayDays, split.advanceDays, req.ApplicationId}
+			}
+			execCtx, span, end := d.startQuery(ctx, "changeLeaveStatus", "UPDATE", "lm_leave_application")
+			defer end()
+			result, err := tx.ExecContext(execCtx, changeLeaveStatusQuery, args...)
+			tracing.RecordResult(span, result)
+			if err != nil {
+				tracing.RecordError(span, err)
+				return err
+			}
+			after, err := d.getLeaveSnapshot(ctx, tx, req.ApplicationId)
+			if err != nil {
+				return err
+			}
+			return d.appendAuditEvent(ctx, tx, req.EmployeeId, auditChangeStatus, before, after)
+		})
 		if err != nil {
 			return err
 		}
+		if advanceApproval {
+			return nil
+		}
+		switch req.LeaveStatus {
+		case approved:
+			metrics.LeaveApproved(leaveTypeId)
+		case declined:
+			metrics.LeaveRejected(leaveTypeId)
+		}
 	}
 	return nil
 }
+
+// balanceOnReapproval works out the balance of an application approved after
+// it was already decided. A declined application gave its days back and an
+// approved one may be approved again after being changed, so the days are
+// checked against the balance as it is now, with the loss of pay the
+// applicant accepted. It cannot go further into advance than it was
+// approved for, since that takes the manager's and HR's approval.
+// tx holds the lock of the applicant, see lockApplicant.
+func (d MysqlDB) balanceOnReapproval(ctx context.Context, tx *sql.Tx, leave *leaveSnapshot) (*leaveSplit, error) {
+	noOfDays, _ := strconv.Atoi(leave.NoOfDays)
+	lossOfPayDays, _ := strconv.Atoi(leave.LossOfPayDays)
+	advanceDays, _ := strconv.Atoi(leave.AdvanceDays)
+	fromDate := dateOnly(leave.FromDate)
+	compOff, err := d.isCompOffLeaveType(ctx, leave.LeaveTypeId)
+	if err != nil {
+		return nil, err
+	}
+	split := leaveSplit{}
+	if compOff {
+		leavePolicy, err := d.getLeavePolicy(ctx, leave.LeaveTypeId)
+		if err != nil {
+			return nil, err
+		}
+		spend := policy.Leave{}
+		spend.FromDate, _ = time.Parse(dateFormat, fromDate)
+		spend.ToDate, _ = time.Parse(dateFormat, dateOnly(leave.ToDate))
+		spend.SandwichBefore, _ = strconv.Atoi(leave.SandwichBefore)
+		spend.SandwichAfter, _ = strconv.Atoi(leave.SandwichAfter)
+		dates, err := d.leaveDates(ctx, leavePolicy, []policy.Leave{spend})
+		if err != nil {
+			return nil, err
+		}
+		split.balance, err = d.compOffBalanceAfter(ctx, tx, leavePolicy, leave.EmployeeId, leave.LeaveTypeId, leave.ApplicationId, dates[0])
+		if err != nil {
+			return nil, err
+		}
+		return &split, nil
+	}
+	replacedDays := 0
+	if leave.LeaveStatus != declined {
+		replacedDays = noOfDays - lossOfPayDays
+	}
+	split, err = d.leaveBalanceAfter(ctx, tx, leave.EmployeeId, leave.LeaveTypeId, fromDate, noOfDays, replacedDays, lossOfPayDays > 0)
+	if err != nil {
+		return nil, err
+	}
+	if split.advanceDays > advanceDays {
+		return nil, errors.New("leaves not remaining")
+	}
+	return &split, nil
+}
+
+// DeleteLeave marks an application as deleted, recording who deleted it and
+// why. The row is kept for payroll and can be brought back with RestoreLeave
+// until PurgeDeletedLeaves removes it.
 func (d MysqlDB) DeleteLeave(ctx context.Context, req *pb.DeleteLeaveRequest) error {
 	validate := validator.New()
 	fields := models.ValidateDeleteLeave{
 		EmployeeId:    req.EmployeeId,
 		ApplicationId: req.ApplicationId,
+		Reason:        req.Reason,
 	}
 	err := validate.Struct(fields)
 	if err != nil {
 		return errors.New("invalid input")
 	}
 
-	designationId, err := d.getDesignationId(req.EmployeeId)
+	designationId, err := d.getDesignationId(ctx, req.EmployeeId)
 	if err != nil {
 		return err
 	}
@@ -333,59 +1079,349 @@ func (d MysqlDB) DeleteLeave(ctx context.Context, req *pb.DeleteLeaveRequest) er
 	if designationId != hrId {
 		return errors.New("access denied")
 	} else {
-		deleteLeaveQuery := `DELETE FROM lm_leave_application WHERE lm_leave_application.application_id=?`
-		_, err = d.DB.Exec(deleteLeaveQuery, req.ApplicationId)
+		err = d.withTx(ctx, func(tx *sql.Tx) error {
+			before, err := d.getLeaveSnapshot(ctx, tx, req.ApplicationId)
+			if err != nil {
+				return err
+			}
+			if before.deleted() {
+				return errors.New("leave application already deleted")
+			}
+			deleteLeaveQuery := `
+							UPDATE lm_leave_application 
+							SET 
+								deleted_at=?, 
+								deleted_by=?, 
+								delete_reason=?, 
+								version=version+1 
+							WHERE lm_leave_application.application_id=?`
+			deletedAt := time.Now().Format(dateTimeFormat)
+			execCtx, span, end := d.startQuery(ctx, "deleteLeave", "UPDATE", "lm_leave_application")
+			defer end()
+			result, err := tx.ExecContext(execCtx, deleteLeaveQuery, deletedAt, req.EmployeeId, req.Reason, req.ApplicationId)
+			tracing.RecordResult(span, result)
+			if err != nil {
+				tracing.RecordError(span, err)
+				return err
+			}
+			after, err := d.getLeaveSnapshot(ctx, tx, req.ApplicationId)
+			if err != nil {
+				return err
+			}
+			return d.appendAuditEvent(ctx, tx, req.EmployeeId, auditDelete, before, after)
+		})
 		if err != nil {
 			return err
 		}
 	}
 	return nil
 }
-func (d MysqlDB) UpdateLeave(ctx context.Context, req *pb.UpdateLeaveRequest) error {
-	var employeeId string
-	leaveTypeId, _ := strconv.ParseInt(req.LeaveTypeId, 10, 32)
 
+// RestoreLeave undoes DeleteLeave. Only HR can restore an application.
+func (d MysqlDB) RestoreLeave(ctx context.Context, req *pb.RestoreLeaveRequest) error {
 	validate := validator.New()
-	fields := models.ValidateUpdateLeave{
-		ApplicationId: req.ApplicationId,
+	fields := models.ValidateRestoreLeave{
 		EmployeeId:    req.EmployeeId,
-		LeaveTypeId:   int(leaveTypeId),
-		FromDate:      req.FromDate,
-		ToDate:        req.ToDate,
-		Comment:       req.Comment,
+		ApplicationId: req.ApplicationId,
 	}
 	err := validate.Struct(fields)
 	if err != nil {
 		return errors.New("invalid input")
 	}
-	err = validation.ValidateFromDate(req.FromDate)
+
+	designationId, err := d.getDesignationId(ctx, req.EmployeeId)
 	if err != nil {
 		return err
 	}
-	err = validation.ValidateToDate(req.ToDate)
+	if designationId != hrId {
+		return errors.New("access denied")
+	}
+
+	return d.withTx(ctx, func(tx *sql.Tx) error {
+		before, err := d.getLeaveSnapshot(ctx, tx, req.ApplicationId)
+		if err != nil {
+			return err
+		}
+		if !before.deleted() {
+			return errors.New("leave application is not deleted")
+		}
+		restoreLeaveQuery := `
+						UPDATE lm_leave_application 
+						SET 
+							deleted_at=NULL, 
+							deleted_by=NULL, 
+							delete_reason=NULL, 
+							version=version+1 
+						WHERE lm_leave_application.application_id=?`
+		execCtx, span, end := d.startQuery(ctx, "restoreLeave", "UPDATE", "lm_leave_application")
+		defer end()
+		result, err := tx.ExecContext(execCtx, restoreLeaveQuery, req.ApplicationId)
+		tracing.RecordResult(span, result)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return err
+		}
+		after, err := d.getLeaveSnapshot(ctx, tx, req.ApplicationId)
+		if err != nil {
+			return err
+		}
+		return d.appendAuditEvent(ctx, tx, req.EmployeeId, auditRestore, before, after)
+	})
+}
+
+// PurgeDeletedLeaves permanently removes applications that were deleted more
+// than olderThan ago, at most purgeBatchSize per call, and returns how many
+// were removed. Each removal is recorded in the audit trail with
+// systemActorId as the actor.
+func (d MysqlDB) PurgeDeletedLeaves(ctx context.Context, olderThan time.Duration) (int, error) {
+	var purged int
+	err := d.withTx(ctx, func(tx *sql.Tx) error {
+		purgeCandidatesQuery := `
+						SELECT application_id 
+						FROM lm_leave_application 
+						WHERE 
+							deleted_at IS NOT NULL 
+							AND deleted_at<? 
+						ORDER BY application_id 
+						LIMIT ? 
+						FOR UPDATE`
+		queryCtx, span, end := d.startQuery(ctx, "purgeCandidates", "SELECT", "lm_leave_application")
+		defer end()
+		rows, err := tx.QueryContext(queryCtx, purgeCandidatesQuery, time.Now().Add(-olderThan), purgeBatchSize)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return err
+		}
+		var applicationIds []string
+		for rows.Next() {
+			var applicationId string
+			if err := rows.Scan(&applicationId); err != nil {
+				rows.Close()
+				tracing.RecordError(span, err)
+				return err
+			}
+			applicationIds = append(applicationIds, applicationId)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			tracing.RecordError(span, err)
+			return err
+		}
+
+		purgeLeaveQuery := `DELETE FROM lm_leave_application WHERE lm_leave_application.application_id=?`
+		for _, applicationId := range applicationIds {
+			before, err := d.getLeaveSnapshot(ctx, tx, applicationId)
+			if err != nil {
+				return err
+			}
+			execCtx, span, end := d.startQuery(ctx, "purgeLeave", "DELETE", "lm_leave_application")
+			result, err := tx.ExecContext(execCtx, purgeLeaveQuery, applicationId)
+			tracing.RecordResult(span, result)
+			if err != nil {
+				tracing.RecordError(span, err)
+				end()
+				return err
+			}
+			end()
+			if err := d.appendAuditEvent(ctx, tx, systemActorId, auditPurge, before, nil); err != nil {
+				return err
+			}
+		}
+		purged = len(applicationIds)
+		return nil
+	})
 	if err != nil {
-		return err
+		return 0, err
+	}
+	return purged, nil
+}
+
+// updatableFields maps the paths accepted in UpdateLeaveRequest.updateMask to
+// the fields of models.ValidateUpdateLeave they set.
+var updatableFields = map[string]string{
+	"leaveTypeId": "LeaveTypeId",
+	"fromDate":    "FromDate",
+	"toDate":      "ToDate",
+	"comment":     "Comment",
+}
+
+// UpdateLeave changes the fields listed in the update mask, or all of them
+// when the mask is empty. Changing the leave type or dates recomputes the
+// number of days and the balance with the same checks as ApplyLeave, and sends
+// an approved or declined application back to pending for a new decision.
+func (d MysqlDB) UpdateLeave(ctx context.Context, req *pb.UpdateLeaveRequest) (*pb.UpdateLeaveResponse, error) {
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		paths = []string{"leaveTypeId", "fromDate", "toDate", "comment"}
+	}
+	updated := map[string]bool{}
+	validateFields := []string{"ApplicationId", "EmployeeId", "Version"}
+	for _, path := range paths {
+		field, ok := updatableFields[path]
+		if !ok {
+			return &pb.UpdateLeaveResponse{}, errors.New("invalid input")
+		}
+		updated[path] = true
+		validateFields = append(validateFields, field)
 	}
 
-	getEmployeeIdQuery := `SELECT employee_id FROM lm_leave_application where application_id=?`
-	err = d.DB.QueryRow(getEmployeeIdQuery, req.ApplicationId).Scan(&employeeId)
+	leaveTypeId, _ := strconv.ParseInt(req.LeaveTypeId, 10, 32)
+	validate := validator.New()
+	fields := models.ValidateUpdateLeave{
+		ApplicationId: req.ApplicationId,
+		EmployeeId:    req.EmployeeId,
+		LeaveTypeId:   int(leaveTypeId),
+		FromDate:      req.FromDate,
+		ToDate:        req.ToDate,
+		Comment:       req.Comment,
+		Version:       req.Version,
+	}
+	err := validate.StructPartial(fields, validateFields...)
 	if err != nil {
-		return err
+		return &pb.UpdateLeaveResponse{}, errors.New("invalid input")
+	}
+	if updated["fromDate"] {
+		err = validation.ValidateFromDate(req.FromDate)
+		if err != nil {
+			return &pb.UpdateLeaveResponse{}, err
+		}
+	}
+	if updated["toDate"] {
+		err = validation.ValidateToDate(req.ToDate)
+		if err != nil {
+			return &pb.UpdateLeaveResponse{}, err
+		}
 	}
 
-	if employeeId != req.EmployeeId {
-		return errors.New("access denied")
-	} else {
+	var warnings []string
+	var duration policy.Duration
+	var lossOfPayDays, advanceDays string
+	err = d.withTx(ctx, func(tx *sql.Tx) error {
+		// Only applicants edit their applications, so the lock is theirs.
+		err := d.lockEmployee(ctx, tx, req.EmployeeId)
+		if err != nil {
+			return err
+		}
+		before, err := d.getLeaveSnapshot(ctx, tx, req.ApplicationId)
+		if err != nil {
+			return err
+		}
+		if before.deleted() {
+			return errors.New("leave application not found")
+		}
+		if before.EmployeeId != req.EmployeeId {
+			return errors.New("access denied")
+		}
+		if before.Version != req.Version {
+			return errStaleVersion
+		}
+
+		leave := *before
+		leave.FromDate = dateOnly(before.FromDate)
+		leave.ToDate = dateOnly(before.ToDate)
+		if updated["leaveTypeId"] {
+			leave.LeaveTypeId = req.LeaveTypeId
+		}
+		if updated["fromDate"] {
+			leave.FromDate = req.FromDate
+		}
+		if updated["toDate"] {
+			leave.ToDate = req.ToDate
+		}
+		if updated["comment"] {
+			leave.Comment = req.Comment
+		}
+
+		material := leave.LeaveTypeId != before.LeaveTypeId ||
+			leave.FromDate != dateOnly(before.FromDate) ||
+			leave.ToDate != dateOnly(before.ToDate)
+		if material {
+			var leavePolicy policy.Policy
+			leavePolicy, duration, err = d.checkLeavePolicy(ctx, req.EmployeeId, leave.LeaveTypeId, req.ApplicationId, leave.FromDate, leave.ToDate)
+			if err != nil {
+				return err
+			}
+			noOfDays := duration.Counted()
+			var split leaveSplit
+			if leavePolicy.CompOffValidityDays != nil {
+				split.balance, err = d.compOffBalanceAfter(ctx, tx, leavePolicy, req.EmployeeId, leave.LeaveTypeId, req.ApplicationId,
+					duration.CountedDates())
+			} else {
+				replacedDays := 0
+				if leave.LeaveTypeId == before.LeaveTypeId && before.LeaveStatus != declined &&
+					leave.FromDate[:4] == dateOnly(before.FromDate)[:4] {
+					beforeDays, _ := strconv.Atoi(before.NoOfDays)
+					beforeLossOfPayDays, _ := strconv.Atoi(before.LossOfPayDays)
+					replacedDays = beforeDays - beforeLossOfPayDays
+				}
+				split, err = d.leaveBalanceAfter(ctx, tx, req.EmployeeId, leave.LeaveTypeId, leave.FromDate, noOfDays,
+					replacedDays, req.AcceptLossOfPay)
+			}
+			if err != nil {
+				return err
+			}
+			warnings, err = d.checkBlackoutPeriods(ctx, req.EmployeeId, leave.LeaveTypeId, leave.FromDate, leave.ToDate)
+			if err != nil {
+				return err
+			}
+			breaches, err := d.coverageBreaches(ctx, tx, req.EmployeeId, leave.FromDate, leave.ToDate)
+			if err != nil {
+				return err
+			}
+			warnings = append(warnings, coverageWarnings(breaches)...)
+			leave.NoOfDays = strconv.Itoa(noOfDays)
+			leave.LeaveBalance = strconv.Itoa(split.balance)
+			leave.LossOfPayDays = strconv.Itoa(split.lossOfPayDays)
+			leave.AdvanceDays = strconv.Itoa(split.advanceDays)
+			leave.LeaveStatus = pending
+		}
+		reapprove := leave.LeaveStatus != before.LeaveStatus
+		lossOfPayDays = leave.LossOfPayDays
+		advanceDays = leave.AdvanceDays
+
+		// date_of_approval is cleared when the application goes back to
+		// pending and kept otherwise; so is a manager's approval of an
+		// advance, which covered the days as they were.
 		updateLeaveQuery := `UPDATE lm_leave_application SET 
 			leave_type_id=?, 
 			comment=?, 
 			from_date=?, 
-			to_date=? 
+			to_date=?, 
+			no_of_days=?, 
+			leave_balance=?, 
+			leave_status=?, 
+			date_of_approval=IF(?, NULL, date_of_approval), 
+			sandwich_before=IF(?, ?, sandwich_before), 
+			sandwich_after=IF(?, ?, sandwich_after), 
+			loss_of_pay_days=?, 
+			advance_days=?, 
+			advance_approved_by=IF(?, NULL, advance_approved_by), 
+			version=version+1 
 			WHERE lm_leave_application.application_id=?`
-		_, err := d.DB.Exec(updateLeaveQuery, req.LeaveTypeId, req.Comment, req.FromDate, req.ToDate, req.ApplicationId)
+		execCtx, span, end := d.startQuery(ctx, "updateLeave", "UPDATE", "lm_leave_application")
+		defer end()
+		result, err := tx.ExecContext(execCtx, updateLeaveQuery, leave.LeaveTypeId, leave.Comment, leave.FromDate, leave.ToDate,
+			leave.NoOfDays, leave.LeaveBalance, leave.LeaveStatus, reapprove, material, duration.SandwichBefore,
+			material, duration.SandwichAfter, leave.LossOfPayDays, leave.AdvanceDays, material, req.ApplicationId)
+		tracing.RecordResult(span, result)
 		if err != nil {
+			tracing.RecordError(span, err)
 			return err
 		}
+		after, err := d.getLeaveSnapshot(ctx, tx, req.ApplicationId)
+		if err != nil {
+			return err
+		}
+		return d.appendAuditEvent(ctx, tx, req.EmployeeId, auditUpdate, before, after)
+	})
+	if err != nil {
+		return &pb.UpdateLeaveResponse{}, err
 	}
-	return nil
+	response := &pb.UpdateLeaveResponse{Warnings: warnings}
+	if duration.Days != nil {
+		response.Duration = leaveDuration(duration)
+		response.LossOfPayDays = lossOfPayDays
+		response.AdvanceDays = advanceDays
+	}
+	return response, nil
 }
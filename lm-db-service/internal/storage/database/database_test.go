@@ -2,6 +2,7 @@ package database
 
 import (
 	"context"
+	"database/sql/driver"
 	"errors"
 	"leavemanagement/lm-db-service/pkg/pb"
 	"reflect"
@@ -10,6 +11,9 @@ import (
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	_ "github.com/go-sql-driver/mysql"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 func getTestMysqlDB(t *testing.T) (*MysqlDB, sqlmock.Sqlmock) {
@@ -119,17 +123,23 @@ func TestMysqlMock_allowedDays(t *testing.T) {
 	}
 	column := []string{
 		"number_of_days_allowed",
+		"proration_rounding",
+		"date_of_joining",
+		"date_of_exit",
 	}
 	row := sqlmock.NewRows(column).AddRow(
 		"10",
+		"NEAREST",
+		nil,
+		nil,
 	)
-	allowedDaysQuery := `SELECT number_of_days_allowed FROM lm_leave_type WHERE leave_type_id=\?`
-	mock.ExpectQuery(allowedDaysQuery).WithArgs("1").WillReturnRows(row)
+	allowedDaysQuery := `SELECT number_of_days_allowed, proration_rounding, date_of_joining, date_of_exit`
+	mock.ExpectQuery(allowedDaysQuery).WithArgs("1", "1").WillReturnRows(row)
 	for _, test := range tests {
 		t.Run(test.description, func(t *testing.T) {
 			if test.isError == false {
 				expected := 10
-				result, err := testDB.getAllowedDays(test.leaveTypeId)
+				result, err := testDB.getAllowedDays(context.Background(), "1", test.leaveTypeId, "2022-04-20")
 				if err != nil {
 					t.Errorf("got error %v: want error: %v", err, false)
 				}
@@ -137,7 +147,7 @@ func TestMysqlMock_allowedDays(t *testing.T) {
 					t.Errorf("expected %v: got %v", expected, result)
 				}
 			} else if test.isError == true {
-				_, err := testDB.getAllowedDays(test.leaveTypeId)
+				_, err := testDB.getAllowedDays(context.Background(), "1", test.leaveTypeId, "2022-04-20")
 				if err == nil {
 					t.Errorf("got error %v: want error: %v", err, true)
 				}
@@ -145,6 +155,17 @@ func TestMysqlMock_allowedDays(t *testing.T) {
 		})
 	}
 }
+
+// advancesBeforeQuery reads the days advanced by leave year before a date.
+const advancesBeforeQuery = `SELECT YEAR\(from_date\), SUM\(advance_days\)\s+FROM lm_leave_application`
+
+// expectAdvancesBefore expects the lookup of the days employeeId advanced of
+// leaveTypeId before the previous leave year, finding none.
+func expectAdvancesBefore(mock sqlmock.Sqlmock, employeeId, leaveTypeId, before string) {
+	mock.ExpectQuery(advancesBeforeQuery).WithArgs(employeeId, leaveTypeId, declined, before).
+		WillReturnRows(sqlmock.NewRows([]string{"year", "advance_days"}))
+}
+
 func TestMysqlMock_totalLeavesTaken(t *testing.T) {
 	testDB, mock := getTestMysqlDB(t)
 	tests := []struct {
@@ -174,17 +195,21 @@ func TestMysqlMock_totalLeavesTaken(t *testing.T) {
 	)
 	totalLeavesTakenQuery := `
 								SELECT
-									IFNULL\(SUM\(no_of_days\),0\)
+									IFNULL\(SUM\(IF\(from_date>=\?, no_of_days-loss_of_pay_days, advance_days\)\),0\)
 								FROM lm_leave_application
 								WHERE
 									employee_id =\?
-									AND leave_type_id=\?`
+									AND leave_type_id=\?
+									AND leave_status<>\?
+									AND deleted_at IS NULL
+									AND from_date BETWEEN \? AND \?`
 	for _, test := range tests {
 		t.Run(test.description, func(t *testing.T) {
 			if test.isError == false {
 				expected := 1
-				mock.ExpectQuery(totalLeavesTakenQuery).WithArgs(test.employeeId, test.leaveTypeId).WillReturnRows(row)
-				result, err := testDB.getTotalLeavesTaken(test.employeeId, test.leaveTypeId)
+				mock.ExpectQuery(totalLeavesTakenQuery).WithArgs("2022-01-01", test.employeeId, test.leaveTypeId, declined, "2021-01-01", "2022-12-31").WillReturnRows(row)
+				expectAdvancesBefore(mock, test.employeeId, test.leaveTypeId, "2021-01-01")
+				result, err := testDB.getTotalLeavesTaken(context.Background(), testDB.DB, test.employeeId, test.leaveTypeId, "2022-04-20")
 				if err != nil {
 					t.Errorf("got error %v: want error: %v", err, false)
 				}
@@ -192,9 +217,9 @@ func TestMysqlMock_totalLeavesTaken(t *testing.T) {
 					t.Errorf("expected %v: got %v", expected, result)
 				}
 			} else if test.isError == true {
-				mock.ExpectQuery(totalLeavesTakenQuery).WithArgs(test.employeeId, test.leaveTypeId).
+				mock.ExpectQuery(totalLeavesTakenQuery).WithArgs("2022-01-01", test.employeeId, test.leaveTypeId, declined, "2021-01-01", "2022-12-31").
 					WillReturnError(errors.New("error"))
-				_, err := testDB.getTotalLeavesTaken(test.employeeId, test.leaveTypeId)
+				_, err := testDB.getTotalLeavesTaken(context.Background(), testDB.DB, test.employeeId, test.leaveTypeId, "2022-04-20")
 				if err == nil {
 					t.Errorf("got error %v: want error: %v", err, true)
 				}
@@ -202,6 +227,67 @@ func TestMysqlMock_totalLeavesTaken(t *testing.T) {
 		})
 	}
 }
+func TestMysqlMock_unrecoveredAdvance(t *testing.T) {
+	tests := []struct {
+		description string
+		expect      func(mock sqlmock.Sqlmock)
+		expected    int
+		isError     bool
+	}{
+		{
+			description: "no advance",
+			expect: func(mock sqlmock.Sqlmock) {
+				expectAdvancesBefore(mock, "1", "1", "2021-01-01")
+			},
+		},
+		{
+			description: "recovered the year after",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(advancesBeforeQuery).WithArgs("1", "1", declined, "2021-01-01").
+					WillReturnRows(sqlmock.NewRows([]string{"year", "advance_days"}).AddRow("2020", 5))
+				expectAllowedDays(mock, "1", "1", 12)
+				expectAdjustedDays(mock, "1", "1", "2021", 0)
+			},
+		},
+		{
+			description: "carried on",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(advancesBeforeQuery).WithArgs("1", "1", declined, "2021-01-01").
+					WillReturnRows(sqlmock.NewRows([]string{"year", "advance_days"}).AddRow("2019", 8).AddRow("2020", 1))
+				expectAllowedDays(mock, "1", "1", 3)
+				expectAdjustedDays(mock, "1", "1", "2020", 0)
+				expectAllowedDays(mock, "1", "1", 1)
+				expectAdjustedDays(mock, "1", "1", "2021", 1)
+			},
+			expected: 4,
+		},
+		{
+			description: "error",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(advancesBeforeQuery).WithArgs("1", "1", declined, "2021-01-01").
+					WillReturnError(errors.New("error"))
+			},
+			isError: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			testDB, mock := getTestMysqlDB(t)
+			test.expect(mock)
+			actual, err := testDB.getUnrecoveredAdvance(context.Background(), testDB.DB, "1", "1", "2022-04-20")
+			if (err != nil) != test.isError {
+				t.Errorf("got error %v: want error: %v", err, test.isError)
+			}
+			if actual != test.expected {
+				t.Errorf("expected %v: got %v", test.expected, actual)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
 func TestMySqlMock_ApplyLeave(t *testing.T) {
 	testDB, mock := getTestMysqlDB(t)
 	tests := []struct {
@@ -231,6 +317,17 @@ func TestMySqlMock_ApplyLeave(t *testing.T) {
 			},
 			isError: "true",
 		},
+		{
+			description: "audit failed",
+			request: &pb.ApplyLeaveRequest{
+				EmployeeId:  "1",
+				LeaveTypeId: "1",
+				FromDate:    "2022-04-20",
+				ToDate:      "2022-04-21",
+				Comment:     "Fever",
+			},
+			isError: "audit",
+		},
 		{
 			description: "invalid input",
 			request: &pb.ApplyLeaveRequest{
@@ -296,99 +393,103 @@ func TestMySqlMock_ApplyLeave(t *testing.T) {
 						to_date,
 						no_of_days,
 						leave_balance,
-						comment\) 
-					VALUES \(\?, \?, \?, \?, \?, \?, \?, \?\)`
+						comment,
+						sandwich_before,
+						sandwich_after,
+						loss_of_pay_days,
+						advance_days\) 
+					VALUES \(\?, \?, \?, \?, \?, \?, \?, \?, \?, \?, \?, \?\)`
+	allowedDaysQuery := `SELECT number_of_days_allowed, proration_rounding, date_of_joining, date_of_exit`
+	totalLeavesTakenQuery := `
+						SELECT 
+							IFNULL\(SUM\(IF\(from_date>=\?, no_of_days-loss_of_pay_days, advance_days\)\),0\) 
+						FROM lm_leave_application 
+						WHERE 
+							employee_id =\? 
+							AND leave_type_id=\?
+							AND leave_status<>\?
+							AND deleted_at IS NULL
+							AND from_date BETWEEN \? AND \?`
 	for _, test := range tests {
 		t.Run(test.description, func(t *testing.T) {
 			if test.isError == "false" {
-				column := []string{
-					"number_of_days_allowed",
-				}
-				row := sqlmock.NewRows(column).AddRow(
-					"3",
-				)
-				allowedDaysQuery := `SELECT number_of_days_allowed FROM lm_leave_type WHERE leave_type_id=\?`
-				mock.ExpectQuery(allowedDaysQuery).WithArgs("1").WillReturnRows(row)
-				column = []string{
-					"no_of_days",
-				}
-				row = sqlmock.NewRows(column).AddRow(
-					"1",
-				)
-				totalLeavesTakenQuery := `
-									SELECT 
-										IFNULL\(SUM\(no_of_days\),0\) 
-									FROM lm_leave_application 
-									WHERE 
-										employee_id =\? 
-										AND leave_type_id=\?`
-				mock.ExpectQuery(totalLeavesTakenQuery).WithArgs("1", "1").WillReturnRows(row)
+				expectLeavePolicy(mock, "1", "2022-04-20", "2022-04-21")
+				expectBlackoutPeriods(mock, "1", "1", "2022-04-20", "2022-04-21")
+				mock.ExpectBegin()
+				expectLockEmployee(mock, "1")
+				expectAllowedDays(mock, "1", "1", 3)
+				mock.ExpectQuery(totalLeavesTakenQuery).WithArgs("2022-01-01", "1", "1", declined, "2021-01-01", "2022-12-31").
+					WillReturnRows(sqlmock.NewRows([]string{"no_of_days"}).AddRow("1"))
+				expectAdvancesBefore(mock, "1", "1", "2021-01-01")
+				expectEncashedDays(mock, "1", "1", "2022", 0)
+				expectAdjustedDays(mock, "1", "1", "2022", 0)
+				expectCoverageRules(mock, "1")
 				mock.ExpectExec(applyLeaveQuery).
-					WithArgs("1", 1, time.Now().Format(dateTimeFormat), "2022-04-20", "2022-04-21", 2, 0, "Fever").
-					WillReturnResult(sqlmock.NewResult(1, 1))
-				got := testDB.ApplyLeave(context.Background(), test.request)
-				if got != nil {
-					t.Errorf("got error %v: want error: %v", got, false)
+					WithArgs("1", 1, time.Now().Format(dateTimeFormat), "2022-04-20", "2022-04-21", 2, 0, "Fever", 0, 0, 0, 0).
+					WillReturnResult(sqlmock.NewResult(4, 1))
+				expectLeaveSnapshot(mock, "4", "1", pending)
+				expectAuditEvent(mock, "4", "1", "1", auditApply)
+				mock.ExpectCommit()
+				got, err := testDB.ApplyLeave(context.Background(), test.request)
+				if err != nil {
+					t.Errorf("got error %v: want error: %v", err, false)
 				}
-			} else if test.isError == "true" {
-				column := []string{
-					"number_of_days_allowed",
+				if got.ApplicationId != "4" {
+					t.Errorf("expected %v: got %v", "4", got.ApplicationId)
 				}
-				row := sqlmock.NewRows(column).AddRow(
-					"3",
-				)
-				allowedDaysQuery := `SELECT number_of_days_allowed FROM lm_leave_type WHERE leave_type_id=\?`
-				mock.ExpectQuery(allowedDaysQuery).WithArgs("1").WillReturnRows(row)
-				column = []string{
-					"no_of_days",
+			} else if test.isError == "true" {
+				expectLeavePolicy(mock, "1", "2022-04-20", "2022-04-21")
+				expectBlackoutPeriods(mock, "1", "1", "2022-04-20", "2022-04-21")
+				mock.ExpectBegin()
+				expectLockEmployee(mock, "1")
+				expectAllowedDays(mock, "1", "1", 3)
+				mock.ExpectQuery(totalLeavesTakenQuery).WithArgs("2022-01-01", "1", "1", declined, "2021-01-01", "2022-12-31").
+					WillReturnRows(sqlmock.NewRows([]string{"no_of_days"}).AddRow("1"))
+				expectAdvancesBefore(mock, "1", "1", "2021-01-01")
+				expectEncashedDays(mock, "1", "1", "2022", 0)
+				expectAdjustedDays(mock, "1", "1", "2022", 0)
+				expectCoverageRules(mock, "1")
+				mock.ExpectExec(applyLeaveQuery).
+					WithArgs("1", 1, time.Now().Format(dateTimeFormat), "2022-04-20", "2022-04-21", 2, 0, "Fever", 0, 0, 0, 0).
+					WillReturnError(errors.New("error"))
+				mock.ExpectRollback()
+				_, err := testDB.ApplyLeave(context.Background(), test.request)
+				if err == nil {
+					t.Errorf("got error %v: want error: %v", err, true)
 				}
-				row = sqlmock.NewRows(column).AddRow(
-					"1",
-				)
-				totalLeavesTakenQuery := `
-									SELECT 
-										IFNULL\(SUM\(no_of_days\),0\) 
-									FROM lm_leave_application 
-									WHERE 
-										employee_id =\? 
-										AND leave_type_id=\?`
-				mock.ExpectQuery(totalLeavesTakenQuery).WithArgs("1", "1").WillReturnRows(row)
+			} else if test.isError == "audit" {
+				expectLeavePolicy(mock, "1", "2022-04-20", "2022-04-21")
+				expectBlackoutPeriods(mock, "1", "1", "2022-04-20", "2022-04-21")
+				mock.ExpectBegin()
+				expectLockEmployee(mock, "1")
+				expectAllowedDays(mock, "1", "1", 3)
+				mock.ExpectQuery(totalLeavesTakenQuery).WithArgs("2022-01-01", "1", "1", declined, "2021-01-01", "2022-12-31").
+					WillReturnRows(sqlmock.NewRows([]string{"no_of_days"}).AddRow("1"))
+				expectAdvancesBefore(mock, "1", "1", "2021-01-01")
+				expectEncashedDays(mock, "1", "1", "2022", 0)
+				expectAdjustedDays(mock, "1", "1", "2022", 0)
+				expectCoverageRules(mock, "1")
 				mock.ExpectExec(applyLeaveQuery).
-					WithArgs("1", 1, time.Now().Format(dateTimeFormat), "2022-04-20", "2022-04-21", 2, 0, "Fever").
+					WithArgs("1", 1, time.Now().Format(dateTimeFormat), "2022-04-20", "2022-04-21", 2, 0, "Fever", 0, 0, 0, 0).
+					WillReturnResult(sqlmock.NewResult(4, 1))
+				expectLeaveSnapshot(mock, "4", "1", pending)
+				mock.ExpectQuery(`SELECT last_hash FROM lm_audit_chain_head WHERE id=1 FOR UPDATE`).
 					WillReturnError(errors.New("error"))
-				got := testDB.ApplyLeave(context.Background(), test.request)
-				if got == nil {
-					t.Errorf("got error %v: want error: %v", got, true)
+				mock.ExpectRollback()
+				_, err := testDB.ApplyLeave(context.Background(), test.request)
+				if err == nil {
+					t.Errorf("got error %v: want error: %v", err, true)
 				}
 			} else if test.isError == "forAllowedDays" {
-				column := []string{
-					"number_of_days_allowed",
-				}
-				row := sqlmock.NewRows(column).AddRow(
-					"3",
-				)
-				allowedDaysQuery := `SELECT number_of_days_allowed FROM lm_leave_type WHERE leave_type_id=\?`
-				mock.ExpectQuery(allowedDaysQuery).WithArgs("1").WillReturnError(errors.New("error"))
-				column = []string{
-					"no_of_days",
-				}
-				row = sqlmock.NewRows(column).AddRow(
-					"1",
-				)
-				totalLeavesTakenQuery := `
-									SELECT 
-										IFNULL\(SUM\(no_of_days\),0\) 
-									FROM lm_leave_application 
-									WHERE 
-										employee_id =\? 
-										AND leave_type_id=\?`
-				mock.ExpectQuery(totalLeavesTakenQuery).WithArgs("1", "1").WillReturnRows(row)
-				mock.ExpectExec(applyLeaveQuery).
-					WithArgs("1", "1", time.Now().Format(dateTimeFormat), "2022-04-20", "2022-04-21", 2, 0, "Fever").
-					WillReturnResult(sqlmock.NewErrorResult(errors.New("error")))
-				got := testDB.ApplyLeave(context.Background(), test.request)
-				if got == nil {
-					t.Errorf("got error %v: want error: %v", got, true)
+				expectLeavePolicy(mock, "1", "2022-04-20", "2022-04-21")
+				expectBlackoutPeriods(mock, "1", "1", "2022-04-20", "2022-04-21")
+				mock.ExpectBegin()
+				expectLockEmployee(mock, "1")
+				mock.ExpectQuery(allowedDaysQuery).WithArgs("1", "1").WillReturnError(errors.New("error"))
+				mock.ExpectRollback()
+				_, err := testDB.ApplyLeave(context.Background(), test.request)
+				if err == nil {
+					t.Errorf("got error %v: want error: %v", err, true)
 				}
 			}
 		})
@@ -426,7 +527,7 @@ func TestMySqlMock_designation(t *testing.T) {
 					"2",
 				)
 				mock.ExpectQuery(expectedSql).WithArgs("4").WillReturnRows(rows)
-				actual, err := testDB.getDesignationId(test.request)
+				actual, err := testDB.getDesignationId(context.Background(), test.request)
 				if err != nil {
 					t.Errorf("got error %v: want error: %v", err, false)
 				}
@@ -434,7 +535,7 @@ func TestMySqlMock_designation(t *testing.T) {
 					t.Errorf("expected %v: got %v", test.response, actual)
 				}
 			} else if test.isError == true {
-				_, err := testDB.getDesignationId(test.request)
+				_, err := testDB.getDesignationId(context.Background(), test.request)
 				if err == nil {
 					t.Errorf("got error %v: want error: %v", err, true)
 				}
@@ -442,75 +543,108 @@ func TestMySqlMock_designation(t *testing.T) {
 		})
 	}
 }
+
+var leaveColumns = []string{
+	"first_name",
+	"last_name",
+	"application_id",
+	"employee_id",
+	"leave_type_id",
+	"date_of_application",
+	"from_date",
+	"to_date",
+	"no_of_days",
+	"leave_balance",
+	"leave_status",
+	"comment",
+	"date_of_approval",
+	"version",
+	"loss_of_pay_days",
+	"advance_days",
+}
+
 func TestMySqlMock_GetLeaveApplicationById(t *testing.T) {
-	testDB, mock := getTestMysqlDB(t)
+	expected := &pb.GetLeaveByIdResponse{
+		ApplicationId:     "1",
+		EmployeeId:        "5",
+		LeaveTypeId:       "3",
+		DateOfApplication: "2022-04-07T23:19:53+05:30",
+		FromDate:          "2022-04-11T00:00:00+05:30",
+		ToDate:            "2022-04-14T00:00:00+05:30",
+		NoOfDays:          "4",
+		LeaveStatus:       "2",
+		LeaveBalance:      "5",
+		Comment:           "Exams",
+		FirstName:         "Saurabh",
+		LastName:          "Jain",
+		DateOfApproval:    "2022-04-11T00:00:00+05:30",
+		Version:           "3",
+		LossOfPayDays:     "0",
+		AdvanceDays:       "0",
+	}
 	tests := []struct {
-		description string
-		request     *pb.GetLeaveByIdRequest
-		expected    *pb.GetLeaveByIdResponse
-		isError     bool
+		description   string
+		request       *pb.GetLeaveByIdRequest
+		found         bool
+		queryErr      error
+		designationId string
+		reportsTo     string
+		expected      *pb.GetLeaveByIdResponse
+		isError       string
 	}{
 		{
-			description: "common case",
-			request: &pb.GetLeaveByIdRequest{
-				ApplicationId: "1",
-			},
-			expected: &pb.GetLeaveByIdResponse{
-				ApplicationId:     "1",
-				EmployeeId:        "5",
-				LeaveTypeId:       "3",
-				DateOfApplication: "2022-04-07T23:19:53+05:30",
-				FromDate:          "2022-04-11T00:00:00+05:30",
-				ToDate:            "2022-04-14T00:00:00+05:30",
-				NoOfDays:          "4",
-				LeaveStatus:       "2",
-				LeaveBalance:      "5",
-				Comment:           "Exams",
-				FirstName:         "Saurabh",
-				LastName:          "Jain",
-				DateOfApproval:    "2022-04-11T00:00:00+05:30",
-			},
-			isError: false,
+			description: "applicant",
+			request:     &pb.GetLeaveByIdRequest{EmployeeId: "5", ApplicationId: "1"},
+			found:       true,
+			expected:    expected,
 		},
 		{
-			description: "common case",
-			request: &pb.GetLeaveByIdRequest{
-				ApplicationId: "1",
-			},
-			expected: nil,
-			isError:  true,
+			description:   "hr",
+			request:       &pb.GetLeaveByIdRequest{EmployeeId: "7", ApplicationId: "1"},
+			found:         true,
+			designationId: "2",
+			expected:      expected,
+		},
+		{
+			description:   "manager of the applicant",
+			request:       &pb.GetLeaveByIdRequest{EmployeeId: "8", ApplicationId: "1"},
+			found:         true,
+			designationId: managerId,
+			reportsTo:     "8",
+			expected:      expected,
+		},
+		{
+			description:   "manager of another team",
+			request:       &pb.GetLeaveByIdRequest{EmployeeId: "9", ApplicationId: "1"},
+			found:         true,
+			designationId: managerId,
+			reportsTo:     "8",
+			isError:       "leave application not found",
+		},
+		{
+			description:   "another employee",
+			request:       &pb.GetLeaveByIdRequest{EmployeeId: "6", ApplicationId: "1"},
+			found:         true,
+			designationId: "1",
+			isError:       "leave application not found",
+		},
+		{
+			description: "not found",
+			request:     &pb.GetLeaveByIdRequest{EmployeeId: "5", ApplicationId: "1"},
+			isError:     "leave application not found",
+		},
+		{
+			description: "query error",
+			request:     &pb.GetLeaveByIdRequest{EmployeeId: "5", ApplicationId: "1"},
+			queryErr:    errors.New("error"),
+			isError:     "error",
+		},
+		{
+			description: "invalid input",
+			request:     &pb.GetLeaveByIdRequest{ApplicationId: "1"},
+			isError:     "invalid input",
 		},
 	}
-	columns := []string{
-		"first_name",
-		"last_name",
-		"application_id",
-		"employee_id",
-		"leave_type_id",
-		"date_of_application",
-		"from_date",
-		"to_date",
-		"no_of_days",
-		"leave_balance",
-		"leave_status",
-		"comment",
-		"date_of_approval",
-	}
-	rows := sqlmock.NewRows(columns).AddRow(
-		"Saurabh",
-		"Jain",
-		"1",
-		"5",
-		"3",
-		"2022-04-07T23:19:53+05:30",
-		"2022-04-11T00:00:00+05:30",
-		"2022-04-14T00:00:00+05:30",
-		"4",
-		"5",
-		"2",
-		"Exams",
-		"2022-04-11T00:00:00+05:30",
-	)
 	expectedSql := `
 				SELECT 
 					first_name,
@@ -525,28 +659,108 @@ func TestMySqlMock_GetLeaveApplicationById(t *testing.T) {
 					leave_balance,
 					leave_status,
 					comment,
-					IFNULL\(date_of_approval,"N/A"\) 
+					IFNULL\(date_of_approval,"N/A"\),
+					version,
+					loss_of_pay_days,
+					advance_days 
 				FROM lm_leave_application 
 				INNER JOIN lm_employee 
 				USING \(employee_id\) 
-				WHERE application_id=\?`
-	mock.ExpectQuery(expectedSql).WithArgs("1").WillReturnRows(rows)
+				WHERE application_id=\? AND deleted_at IS NULL`
 	for _, test := range tests {
 		t.Run(test.description, func(t *testing.T) {
-			if test.isError == true {
-				mock.ExpectQuery(expectedSql).WithArgs("1").WillReturnError(errors.New("error"))
-				_, err := testDB.GetLeaveById(context.Background(), test.request)
-				if err == nil {
-					t.Errorf("got error %v: want error: %v", err, true)
+			testDB, mock := getTestMysqlDB(t)
+			if test.request.EmployeeId != "" {
+				rows := sqlmock.NewRows(leaveColumns)
+				if test.found {
+					rows.AddRow("Saurabh", "Jain", "1", "5", "3", "2022-04-07T23:19:53+05:30",
+						"2022-04-11T00:00:00+05:30", "2022-04-14T00:00:00+05:30", "4", "5", "2", "Exams",
+						"2022-04-11T00:00:00+05:30", "3", "0", "0")
 				}
-			} else if test.isError == false {
-				actual, err := testDB.GetLeaveById(context.Background(), test.request)
+				query := mock.ExpectQuery(expectedSql).WithArgs("1")
+				if test.queryErr != nil {
+					query.WillReturnError(test.queryErr)
+				} else {
+					query.WillReturnRows(rows)
+				}
+			}
+			if test.designationId != "" {
+				expectDesignation(mock, test.request.EmployeeId, test.designationId)
+			}
+			if test.reportsTo != "" {
+				expectManager(mock, "5", test.reportsTo)
+			}
+			actual, err := testDB.GetLeaveById(context.Background(), test.request)
+			if test.isError == "" {
 				if err != nil {
 					t.Errorf("got error %v: want error: %v", err, false)
 				}
 				if !reflect.DeepEqual(test.expected, actual) {
 					t.Errorf("expected %v: got %v", test.expected, actual)
 				}
+			} else if err == nil || err.Error() != test.isError {
+				t.Errorf("got error %v: want error: %v", err, test.isError)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestMySqlMock_ListMyLeaves(t *testing.T) {
+	tests := []struct {
+		description string
+		request     *pb.ListMyLeavesRequest
+		expectedSql string
+		args        []driver.Value
+		isError     string
+	}{
+		{
+			description: "all",
+			request:     &pb.ListMyLeavesRequest{EmployeeId: "5"},
+			expectedSql: `WHERE employee_id=\? AND deleted_at IS NULL ORDER BY from_date DESC, application_id DESC`,
+			args:        []driver.Value{"5"},
+		},
+		{
+			description: "filtered",
+			request:     &pb.ListMyLeavesRequest{EmployeeId: "5", LeaveStatus: "1", FromDate: "2022-04-01", ToDate: "2022-04-30"},
+			expectedSql: `WHERE employee_id=\? AND deleted_at IS NULL AND leave_status=\? AND to_date>=\? AND from_date<=\? ORDER BY from_date DESC`,
+			args:        []driver.Value{"5", "1", "2022-04-01", "2022-04-30"},
+		},
+		{
+			description: "invalid status",
+			request:     &pb.ListMyLeavesRequest{EmployeeId: "5", LeaveStatus: "3"},
+			isError:     "invalid input",
+		},
+		{
+			description: "invalid input",
+			request:     &pb.ListMyLeavesRequest{},
+			isError:     "invalid input",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			testDB, mock := getTestMysqlDB(t)
+			if test.expectedSql != "" {
+				mock.ExpectQuery(test.expectedSql).WithArgs(test.args...).WillReturnRows(sqlmock.NewRows(leaveColumns).
+					AddRow("Saurabh", "Jain", "1", "5", "3", "2022-04-07T23:19:53+05:30",
+						"2022-04-11T00:00:00+05:30", "2022-04-14T00:00:00+05:30", "4", "5", "1", "Exams",
+						"2022-04-11T00:00:00+05:30", "3", "0", "0"))
+			}
+			actual, err := testDB.ListMyLeaves(context.Background(), test.request)
+			if test.isError == "" {
+				if err != nil {
+					t.Errorf("got error %v: want error: %v", err, false)
+				}
+				if len(actual.LeavesListResponse) != 1 || actual.LeavesListResponse[0].ApplicationId != "1" {
+					t.Errorf("expected %v: got %v", 1, actual.LeavesListResponse)
+				}
+			} else if err == nil || err.Error() != test.isError {
+				t.Errorf("got error %v: want error: %v", err, test.isError)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
 			}
 		})
 	}
@@ -581,6 +795,9 @@ func TestMySqlMock_LeavesList(t *testing.T) {
 						FirstName:         "Saurabh",
 						LastName:          "Jain",
 						DateOfApproval:    "2022-04-11T00:00:00+05:30",
+						Version:           "3",
+						LossOfPayDays:     "0",
+						AdvanceDays:       "0",
 					},
 				},
 			},
@@ -637,6 +854,9 @@ func TestMySqlMock_LeavesList(t *testing.T) {
 		"leave_status",
 		"comment",
 		"date_of_approval",
+		"version",
+		"loss_of_pay_days",
+		"advance_days",
 	}
 	rows := sqlmock.NewRows(columns).AddRow(
 		"Saurabh",
@@ -652,6 +872,9 @@ func TestMySqlMock_LeavesList(t *testing.T) {
 		"2",
 		"Exams",
 		"2022-04-11T00:00:00+05:30",
+		"3",
+		"0",
+		"0",
 	)
 	expectedSql := `
 				SELECT 
@@ -667,10 +890,14 @@ func TestMySqlMock_LeavesList(t *testing.T) {
 					leave_balance,
 					leave_status, 
 					comment, 
-					IFNULL\(date_of_approval,"N/A"\)
+					IFNULL\(date_of_approval,"N/A"\),
+					version,
+					loss_of_pay_days,
+					advance_days
 				FROM lm_leave_application 
 				INNER JOIN lm_employee 
-				USING \(employee_id\)`
+				USING \(employee_id\)
+				WHERE deleted_at IS NULL`
 	for _, test := range tests {
 		t.Run(test.description, func(t *testing.T) {
 			if test.isError == "false" {
@@ -734,6 +961,7 @@ func TestMySqlMock_DeleteLeave(t *testing.T) {
 			request: &pb.DeleteLeaveRequest{
 				EmployeeId:    "7",
 				ApplicationId: "2",
+				Reason:        "duplicate",
 			},
 			isError: "false",
 		},
@@ -742,6 +970,7 @@ func TestMySqlMock_DeleteLeave(t *testing.T) {
 			request: &pb.DeleteLeaveRequest{
 				EmployeeId:    "1",
 				ApplicationId: "2",
+				Reason:        "duplicate",
 			},
 			isError: "accessDenied",
 		},
@@ -750,6 +979,15 @@ func TestMySqlMock_DeleteLeave(t *testing.T) {
 			request: &pb.DeleteLeaveRequest{
 				EmployeeId:    "",
 				ApplicationId: "2",
+				Reason:        "duplicate",
+			},
+			isError: "true",
+		},
+		{
+			description: "missing reason",
+			request: &pb.DeleteLeaveRequest{
+				EmployeeId:    "7",
+				ApplicationId: "2",
 			},
 			isError: "true",
 		},
@@ -758,51 +996,52 @@ func TestMySqlMock_DeleteLeave(t *testing.T) {
 			request: &pb.DeleteLeaveRequest{
 				EmployeeId:    "7",
 				ApplicationId: "2",
+				Reason:        "duplicate",
 			},
 			isError: "true",
 		},
 	}
-	deleteQuery := `DELETE FROM lm_leave_application WHERE lm_leave_application.application_id=\?`
+	deleteQuery := `
+				UPDATE lm_leave_application 
+				SET 
+					deleted_at=\?, 
+					deleted_by=\?, 
+					delete_reason=\?, 
+					version=version\+1 
+				WHERE lm_leave_application.application_id=\?`
+	designationIdQuery := `SELECT designation_id FROM lm_employee where employee_id=\?`
 	for _, test := range tests {
 		t.Run(test.description, func(t *testing.T) {
 			if test.isError == "false" {
-				columns := []string{
-					"designation_id",
-				}
-				rows := sqlmock.NewRows(columns).AddRow(
-					"2",
-				)
-				designationIdQuery := `SELECT designation_id FROM lm_employee where employee_id=\?`
-				mock.ExpectQuery(designationIdQuery).WithArgs("7").WillReturnRows(rows)
-				mock.ExpectExec(deleteQuery).WithArgs("2").WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery(designationIdQuery).WithArgs("7").
+					WillReturnRows(sqlmock.NewRows([]string{"designation_id"}).AddRow("2"))
+				mock.ExpectBegin()
+				expectLeaveSnapshot(mock, "2", "1", approved)
+				mock.ExpectExec(deleteQuery).WithArgs(sqlmock.AnyArg(), "7", "duplicate", "2").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(leaveSnapshotQuery).WithArgs("2").
+					WillReturnRows(deletedLeaveSnapshotRows("2", "1", approved, "2022-04-22T10:00:00+05:30"))
+				expectAuditEvent(mock, "2", "1", "7", auditDelete)
+				mock.ExpectCommit()
 				err := testDB.DeleteLeave(context.Background(), test.request)
 				if err != nil {
 					t.Errorf("got error %v: want error: %v", err, false)
 				}
 			} else if test.isError == "true" {
-				columns := []string{
-					"designation_id",
-				}
-				rows := sqlmock.NewRows(columns).AddRow(
-					"2",
-				)
-				designationIdQuery := `SELECT designation_id FROM lm_employee where employee_id=\?`
-				mock.ExpectQuery(designationIdQuery).WithArgs("1").WillReturnRows(rows)
-				mock.ExpectExec(deleteQuery).WithArgs("2").WillReturnError(errors.New("error"))
+				mock.ExpectQuery(designationIdQuery).WithArgs("7").
+					WillReturnRows(sqlmock.NewRows([]string{"designation_id"}).AddRow("2"))
+				mock.ExpectBegin()
+				expectLeaveSnapshot(mock, "2", "1", approved)
+				mock.ExpectExec(deleteQuery).WithArgs(sqlmock.AnyArg(), "7", "duplicate", "2").
+					WillReturnError(errors.New("error"))
+				mock.ExpectRollback()
 				err := testDB.DeleteLeave(context.Background(), test.request)
 				if err == nil {
 					t.Errorf("got error %v: want error: %v", err, true)
 				}
 			} else if test.isError == "accessDenied" {
-				columns := []string{
-					"designation_id",
-				}
-				rows := sqlmock.NewRows(columns).AddRow(
-					"1",
-				)
-				designationIdQuery := `SELECT designation_id FROM lm_employee where employee_id=\?`
-				mock.ExpectQuery(designationIdQuery).WithArgs("1").WillReturnRows(rows)
-				mock.ExpectExec(deleteQuery).WithArgs("2").WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery(designationIdQuery).WithArgs("1").
+					WillReturnRows(sqlmock.NewRows([]string{"designation_id"}).AddRow("1"))
 				err := testDB.DeleteLeave(context.Background(), test.request)
 				if err == nil {
 					t.Errorf("got error %v: want error: %v", err, true)
@@ -811,8 +1050,170 @@ func TestMySqlMock_DeleteLeave(t *testing.T) {
 		})
 	}
 }
-func TestMySqlMock_ChangeLeaveStatus(t *testing.T) {
+func TestMySqlMock_DeleteLeaveAlreadyDeleted(t *testing.T) {
 	testDB, mock := getTestMysqlDB(t)
+	mock.ExpectQuery(`SELECT designation_id FROM lm_employee where employee_id=\?`).WithArgs("7").
+		WillReturnRows(sqlmock.NewRows([]string{"designation_id"}).AddRow("2"))
+	mock.ExpectBegin()
+	mock.ExpectQuery(leaveSnapshotQuery).WithArgs("2").
+		WillReturnRows(deletedLeaveSnapshotRows("2", "1", approved, "2022-04-22T10:00:00+05:30"))
+	mock.ExpectRollback()
+	err := testDB.DeleteLeave(context.Background(), &pb.DeleteLeaveRequest{EmployeeId: "7", ApplicationId: "2", Reason: "duplicate"})
+	if err == nil {
+		t.Errorf("got error %v: want error: %v", err, true)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+func TestMySqlMock_RestoreLeave(t *testing.T) {
+	tests := []struct {
+		description   string
+		request       *pb.RestoreLeaveRequest
+		designationId string
+		deletedAt     string
+		isError       bool
+	}{
+		{
+			description:   "success",
+			request:       &pb.RestoreLeaveRequest{EmployeeId: "7", ApplicationId: "2"},
+			designationId: "2",
+			deletedAt:     "2022-04-22T10:00:00+05:30",
+			isError:       false,
+		},
+		{
+			description:   "not deleted",
+			request:       &pb.RestoreLeaveRequest{EmployeeId: "7", ApplicationId: "2"},
+			designationId: "2",
+			isError:       true,
+		},
+		{
+			description:   "access denied",
+			request:       &pb.RestoreLeaveRequest{EmployeeId: "8", ApplicationId: "2"},
+			designationId: "3",
+			isError:       true,
+		},
+		{
+			description: "validation error",
+			request:     &pb.RestoreLeaveRequest{EmployeeId: "7"},
+			isError:     true,
+		},
+	}
+	restoreQuery := `
+				UPDATE lm_leave_application 
+				SET 
+					deleted_at=NULL, 
+					deleted_by=NULL, 
+					delete_reason=NULL, 
+					version=version\+1 
+				WHERE lm_leave_application.application_id=\?`
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			testDB, mock := getTestMysqlDB(t)
+			if test.designationId != "" {
+				mock.ExpectQuery(`SELECT designation_id FROM lm_employee where employee_id=\?`).WithArgs(test.request.EmployeeId).
+					WillReturnRows(sqlmock.NewRows([]string{"designation_id"}).AddRow(test.designationId))
+			}
+			if test.designationId == hrId {
+				mock.ExpectBegin()
+				mock.ExpectQuery(leaveSnapshotQuery).WithArgs("2").
+					WillReturnRows(deletedLeaveSnapshotRows("2", "1", approved, test.deletedAt))
+				if test.deletedAt != "" {
+					mock.ExpectExec(restoreQuery).WithArgs("2").WillReturnResult(sqlmock.NewResult(0, 1))
+					expectLeaveSnapshot(mock, "2", "1", approved)
+					expectAuditEvent(mock, "2", "1", "7", auditRestore)
+					mock.ExpectCommit()
+				} else {
+					mock.ExpectRollback()
+				}
+			}
+			err := testDB.RestoreLeave(context.Background(), test.request)
+			if test.isError == false && err != nil {
+				t.Errorf("got error %v: want error: %v", err, false)
+			}
+			if test.isError == true && err == nil {
+				t.Errorf("got error %v: want error: %v", err, true)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+func TestMySqlMock_PurgeDeletedLeaves(t *testing.T) {
+	tests := []struct {
+		description string
+		candidates  []string
+		isError     bool
+	}{
+		{
+			description: "nothing to purge",
+			isError:     false,
+		},
+		{
+			description: "purged",
+			candidates:  []string{"2", "5"},
+			isError:     false,
+		},
+		{
+			description: "delete failed",
+			candidates:  []string{"2"},
+			isError:     true,
+		},
+	}
+	candidatesQuery := `
+				SELECT application_id 
+				FROM lm_leave_application 
+				WHERE 
+					deleted_at IS NOT NULL 
+					AND deleted_at<\? 
+				ORDER BY application_id 
+				LIMIT \? 
+				FOR UPDATE`
+	purgeQuery := `DELETE FROM lm_leave_application WHERE lm_leave_application.application_id=\?`
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			testDB, mock := getTestMysqlDB(t)
+			mock.ExpectBegin()
+			rows := sqlmock.NewRows([]string{"application_id"})
+			for _, applicationId := range test.candidates {
+				rows.AddRow(applicationId)
+			}
+			mock.ExpectQuery(candidatesQuery).WithArgs(sqlmock.AnyArg(), purgeBatchSize).WillReturnRows(rows)
+			for _, applicationId := range test.candidates {
+				mock.ExpectQuery(leaveSnapshotQuery).WithArgs(applicationId).
+					WillReturnRows(deletedLeaveSnapshotRows(applicationId, "1", approved, "2021-04-22T10:00:00+05:30"))
+				if test.isError {
+					mock.ExpectExec(purgeQuery).WithArgs(applicationId).WillReturnError(errors.New("error"))
+					mock.ExpectRollback()
+					break
+				}
+				mock.ExpectExec(purgeQuery).WithArgs(applicationId).WillReturnResult(sqlmock.NewResult(0, 1))
+				expectAuditEvent(mock, applicationId, "1", systemActorId, auditPurge)
+			}
+			if !test.isError {
+				mock.ExpectCommit()
+			}
+			purged, err := testDB.PurgeDeletedLeaves(context.Background(), 365*24*time.Hour)
+			if test.isError == false {
+				if err != nil {
+					t.Errorf("got error %v: want error: %v", err, false)
+				}
+				if purged != len(test.candidates) {
+					t.Errorf("expected %v: got %v", len(test.candidates), purged)
+				}
+			} else if test.isError == true {
+				if err == nil {
+					t.Errorf("got error %v: want error: %v", err, true)
+				}
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+func TestMySqlMock_ChangeLeaveStatus(t *testing.T) {
 	tests := []struct {
 		description string
 		request     *pb.ChangeLeaveStatusRequest
@@ -824,6 +1225,7 @@ func TestMySqlMock_ChangeLeaveStatus(t *testing.T) {
 				EmployeeId:    "8",
 				ApplicationId: "2",
 				LeaveStatus:   "2",
+				Version:       "1",
 			},
 			isError: "false",
 		},
@@ -833,6 +1235,7 @@ func TestMySqlMock_ChangeLeaveStatus(t *testing.T) {
 				EmployeeId:    "8",
 				ApplicationId: "2",
 				LeaveStatus:   "2",
+				Version:       "1",
 			},
 			isError: "accessDenied",
 		},
@@ -842,6 +1245,7 @@ func TestMySqlMock_ChangeLeaveStatus(t *testing.T) {
 				EmployeeId:    "",
 				ApplicationId: "2",
 				LeaveStatus:   "2",
+				Version:       "1",
 			},
 			isError: "true",
 		},
@@ -851,61 +1255,82 @@ func TestMySqlMock_ChangeLeaveStatus(t *testing.T) {
 				EmployeeId:    "8",
 				ApplicationId: "2",
 				LeaveStatus:   "2",
+				Version:       "1",
 			},
 			isError: "true",
 		},
+		{
+			description: "not found",
+			request: &pb.ChangeLeaveStatusRequest{
+				EmployeeId:    "8",
+				ApplicationId: "9",
+				LeaveStatus:   "1",
+				Version:       "1",
+			},
+			isError: "notFound",
+		},
 	}
 	updateQuery := `
 				UPDATE lm_leave_application 
 				SET 
 					leave_status=\?, 
-					date_of_approval=\? 
+					date_of_approval=\?, 
+					coverage_override_reason=NULLIF\(\?,""\), 
+					version=version\+1 
 				WHERE lm_leave_application.application_id=\?`
+	designationIdQuery := `SELECT designation_id FROM lm_employee where employee_id=\?`
 	for _, test := range tests {
 		t.Run(test.description, func(t *testing.T) {
+			testDB, mock := getTestMysqlDB(t)
 			if test.isError == "false" {
-				columns := []string{
-					"designation_id",
-				}
-				rows := sqlmock.NewRows(columns).AddRow(
-					"3",
-				)
-				designationIdQuery := `SELECT designation_id FROM lm_employee where employee_id=\?`
-				mock.ExpectQuery(designationIdQuery).WithArgs("8").WillReturnRows(rows)
-				mock.ExpectExec(updateQuery).WithArgs("2", time.Now().Format(dateTimeFormat), "2").
+				mock.ExpectQuery(designationIdQuery).WithArgs("8").
+					WillReturnRows(sqlmock.NewRows([]string{"designation_id"}).AddRow("3"))
+				mock.ExpectBegin()
+				expectLeaveSnapshot(mock, "2", "1", pending)
+				mock.ExpectExec(updateQuery).WithArgs("2", time.Now().Format(dateTimeFormat), "", "2").
 					WillReturnResult(sqlmock.NewResult(1, 1))
+				expectLeaveSnapshot(mock, "2", "1", declined)
+				expectAuditEvent(mock, "2", "1", "8", auditChangeStatus)
+				mock.ExpectCommit()
 				err := testDB.ChangeLeaveStatus(context.Background(), test.request)
 				if err != nil {
 					t.Errorf("got error %v: want error: %v", err, false)
 				}
+				if err := mock.ExpectationsWereMet(); err != nil {
+					t.Error(err)
+				}
 			}
 			if test.isError == "true" {
-				columns := []string{
-					"designation_id",
-				}
-				rows := sqlmock.NewRows(columns).AddRow(
-					"3",
-				)
-				designationIdQuery := `SELECT designation_id FROM lm_employee where employee_id=\?`
-				mock.ExpectQuery(designationIdQuery).WithArgs("8").WillReturnRows(rows)
-				mock.ExpectExec(updateQuery).WithArgs("2", time.Now().Format(dateTimeFormat)).
+				mock.ExpectQuery(designationIdQuery).WithArgs("8").
+					WillReturnRows(sqlmock.NewRows([]string{"designation_id"}).AddRow("3"))
+				mock.ExpectBegin()
+				expectLeaveSnapshot(mock, "2", "1", pending)
+				mock.ExpectExec(updateQuery).WithArgs("2", time.Now().Format(dateTimeFormat), "", "2").
 					WillReturnError(errors.New("error"))
+				mock.ExpectRollback()
 				err := testDB.ChangeLeaveStatus(context.Background(), test.request)
 				if err == nil {
 					t.Errorf("got error %v: want error: %v", err, true)
 				}
 			}
-			if test.isError == "accessDenied" {
-				columns := []string{
-					"designation_id",
+			if test.isError == "notFound" {
+				mock.ExpectQuery(designationIdQuery).WithArgs("8").
+					WillReturnRows(sqlmock.NewRows([]string{"designation_id"}).AddRow("3"))
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT employee_id FROM lm_leave_application WHERE application_id=\?`).WithArgs("9").
+					WillReturnRows(sqlmock.NewRows([]string{"employee_id"}))
+				mock.ExpectRollback()
+				err := testDB.ChangeLeaveStatus(context.Background(), test.request)
+				if err == nil {
+					t.Errorf("got error %v: want error: %v", err, true)
 				}
-				rows := sqlmock.NewRows(columns).AddRow(
-					"2",
-				)
-				designationIdQuery := `SELECT designation_id FROM lm_employee where employee_id=\?`
-				mock.ExpectQuery(designationIdQuery).WithArgs("8").WillReturnRows(rows)
-				mock.ExpectExec(updateQuery).WithArgs("2", time.Now().Format(dateTimeFormat)).
-					WillReturnResult(sqlmock.NewResult(1, 1))
+				if err := mock.ExpectationsWereMet(); err != nil {
+					t.Error(err)
+				}
+			}
+			if test.isError == "accessDenied" {
+				mock.ExpectQuery(designationIdQuery).WithArgs("8").
+					WillReturnRows(sqlmock.NewRows([]string{"designation_id"}).AddRow("1"))
 				err := testDB.ChangeLeaveStatus(context.Background(), test.request)
 				if err == nil {
 					t.Errorf("got error %v: want error: %v", err, true)
@@ -915,10 +1340,40 @@ func TestMySqlMock_ChangeLeaveStatus(t *testing.T) {
 	}
 }
 func TestMySqlMock_UpdateLeave(t *testing.T) {
-	testDB, mock := getTestMysqlDB(t)
+	updateLeaveQuery := `
+			UPDATE lm_leave_application SET 
+				leave_type_id=\?, 
+				comment=\?, 
+				from_date=\?, 
+				to_date=\?, 
+				no_of_days=\?, 
+				leave_balance=\?, 
+				leave_status=\?, 
+				date_of_approval=IF\(\?, NULL, date_of_approval\), 
+				sandwich_before=IF\(\?, \?, sandwich_before\), 
+				sandwich_after=IF\(\?, \?, sandwich_after\), 
+				loss_of_pay_days=\?, 
+				advance_days=\?, 
+				advance_approved_by=IF\(\?, NULL, advance_approved_by\), 
+				version=version\+1 
+			WHERE lm_leave_application.application_id=\?`
+	totalLeavesTakenQuery := `SELECT\s+IFNULL\(SUM\(IF\(from_date>=\?, no_of_days-loss_of_pay_days, advance_days\)\),0\)`
+	expectBalance := func(mock sqlmock.Sqlmock, leaveTypeId, fromDate, toDate string, allowed, taken int) {
+		expectLeavePolicy(mock, leaveTypeId, fromDate, toDate)
+		expectAllowedDays(mock, "2", leaveTypeId, allowed)
+		mock.ExpectQuery(totalLeavesTakenQuery).WithArgs("2022-01-01", "2", leaveTypeId, declined, "2021-01-01", "2022-12-31").
+			WillReturnRows(sqlmock.NewRows([]string{"no_of_days"}).AddRow(taken))
+		expectAdvancesBefore(mock, "2", leaveTypeId, "2021-01-01")
+		expectEncashedDays(mock, "2", leaveTypeId, "2022", 0)
+		expectAdjustedDays(mock, "2", leaveTypeId, "2022", 0)
+	}
 	tests := []struct {
 		description string
 		request     *pb.UpdateLeaveRequest
+		// leaveStatus is the status of the stored application, empty when
+		// the request is rejected before reading it.
+		leaveStatus string
+		expect      func(mock sqlmock.Sqlmock)
 		isError     bool
 	}{
 		{
@@ -930,11 +1385,59 @@ func TestMySqlMock_UpdateLeave(t *testing.T) {
 				Comment:       "fever",
 				FromDate:      "2022-04-24",
 				ToDate:        "2022-04-25",
+				Version:       "1",
+			},
+			leaveStatus: approved,
+			expect: func(mock sqlmock.Sqlmock) {
+				expectBalance(mock, "3", "2022-04-24", "2022-04-25", 10, 2)
+				expectBlackoutPeriods(mock, "2", "3", "2022-04-24", "2022-04-25")
+				expectCoverageRules(mock, "2")
+				mock.ExpectExec(updateLeaveQuery).
+					WithArgs("3", "fever", "2022-04-24", "2022-04-25", "1", "7", pending, true, true, 0, true, 0, "0", "0", true, "1").
+					WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 			isError: false,
 		},
 		{
-			description: "common case",
+			description: "longer leave of the same type",
+			request: &pb.UpdateLeaveRequest{
+				ApplicationId: "1",
+				EmployeeId:    "2",
+				FromDate:      "2022-04-20",
+				ToDate:        "2022-04-23",
+				Version:       "1",
+				UpdateMask:    &fieldmaskpb.FieldMask{Paths: []string{"fromDate", "toDate"}},
+			},
+			leaveStatus: approved,
+			expect: func(mock sqlmock.Sqlmock) {
+				expectBalance(mock, "1", "2022-04-20", "2022-04-23", 5, 3)
+				expectBlackoutPeriods(mock, "2", "1", "2022-04-20", "2022-04-23")
+				expectCoverageRules(mock, "2")
+				mock.ExpectExec(updateLeaveQuery).
+					WithArgs("1", "Fever", "2022-04-20", "2022-04-23", "3", "1", pending, true, true, 0, true, 0, "0", "0", true, "1").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+			isError: false,
+		},
+		{
+			description: "comment only keeps approval",
+			request: &pb.UpdateLeaveRequest{
+				ApplicationId: "1",
+				EmployeeId:    "2",
+				Comment:       "back pain",
+				Version:       "1",
+				UpdateMask:    &fieldmaskpb.FieldMask{Paths: []string{"comment"}},
+			},
+			leaveStatus: approved,
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(updateLeaveQuery).
+					WithArgs("1", "back pain", "2022-04-20", "2022-04-21", "2", "1", approved, false, false, 0, false, 0, "0", "0", false, "1").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+			isError: false,
+		},
+		{
+			description: "no leaves remaining",
 			request: &pb.UpdateLeaveRequest{
 				ApplicationId: "1",
 				EmployeeId:    "2",
@@ -942,106 +1445,329 @@ func TestMySqlMock_UpdateLeave(t *testing.T) {
 				Comment:       "fever",
 				FromDate:      "2022-04-24",
 				ToDate:        "2022-04-25",
+				Version:       "1",
+			},
+			leaveStatus: pending,
+			expect: func(mock sqlmock.Sqlmock) {
+				expectBalance(mock, "3", "2022-04-24", "2022-04-25", 10, 10)
+				expectNegativeBalanceLimit(mock, "3", 0)
 			},
 			isError: true,
 		},
 		{
-			description: "validation error",
+			description: "excess taken as loss of pay",
 			request: &pb.UpdateLeaveRequest{
-				ApplicationId: "",
+				ApplicationId:   "1",
+				EmployeeId:      "2",
+				LeaveTypeId:     "3",
+				Comment:         "fever",
+				FromDate:        "2022-04-24",
+				ToDate:          "2022-04-25",
+				Version:         "1",
+				AcceptLossOfPay: true,
+			},
+			leaveStatus: pending,
+			expect: func(mock sqlmock.Sqlmock) {
+				expectBalance(mock, "3", "2022-04-24", "2022-04-25", 10, 10)
+				expectNegativeBalanceLimit(mock, "3", 0)
+				expectBlackoutPeriods(mock, "2", "3", "2022-04-24", "2022-04-25")
+				expectCoverageRules(mock, "2")
+				mock.ExpectExec(updateLeaveQuery).
+					WithArgs("3", "fever", "2022-04-24", "2022-04-25", "1", "0", pending, false, true, 0, true, 0, "1", "0", true, "1").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+			isError: false,
+		},
+		{
+			description: "common case",
+			request: &pb.UpdateLeaveRequest{
+				ApplicationId: "1",
 				EmployeeId:    "2",
 				LeaveTypeId:   "3",
 				Comment:       "fever",
 				FromDate:      "2022-04-24",
 				ToDate:        "2022-04-25",
+				Version:       "1",
+			},
+			leaveStatus: pending,
+			expect: func(mock sqlmock.Sqlmock) {
+				expectBalance(mock, "3", "2022-04-24", "2022-04-25", 10, 2)
+				expectBlackoutPeriods(mock, "2", "3", "2022-04-24", "2022-04-25")
+				expectCoverageRules(mock, "2")
+				mock.ExpectExec(updateLeaveQuery).
+					WithArgs("3", "fever", "2022-04-24", "2022-04-25", "1", "7", pending, false, true, 0, true, 0, "0", "0", true, "1").
+					WillReturnError(errors.New("error"))
 			},
 			isError: true,
 		},
 		{
-			description: "invalid employeeId",
+			description: "access denied",
 			request: &pb.UpdateLeaveRequest{
 				ApplicationId: "1",
-				EmployeeId:    "d",
+				EmployeeId:    "4",
 				LeaveTypeId:   "3",
 				Comment:       "fever",
 				FromDate:      "2022-04-24",
 				ToDate:        "2022-04-25",
+				Version:       "1",
+			},
+			leaveStatus: pending,
+			expect:      func(mock sqlmock.Sqlmock) {},
+			isError:     true,
+		},
+		{
+			description: "unknown field in mask",
+			request: &pb.UpdateLeaveRequest{
+				ApplicationId: "1",
+				EmployeeId:    "2",
+				Version:       "1",
+				UpdateMask:    &fieldmaskpb.FieldMask{Paths: []string{"leaveStatus"}},
 			},
 			isError: true,
 		},
 		{
-			description: "access denied",
+			description: "validation error",
 			request: &pb.UpdateLeaveRequest{
-				ApplicationId: "2",
-				EmployeeId:    "4",
+				ApplicationId: "",
+				EmployeeId:    "2",
 				LeaveTypeId:   "3",
 				Comment:       "fever",
 				FromDate:      "2022-04-24",
 				ToDate:        "2022-04-25",
+				Version:       "1",
+			},
+			isError: true,
+		},
+		{
+			description: "masked field empty",
+			request: &pb.UpdateLeaveRequest{
+				ApplicationId: "1",
+				EmployeeId:    "2",
+				Version:       "1",
+				UpdateMask:    &fieldmaskpb.FieldMask{Paths: []string{"comment"}},
 			},
 			isError: true,
 		},
 		{
 			description: "invalid fromDate",
 			request: &pb.UpdateLeaveRequest{
-				ApplicationId: "2",
-				EmployeeId:    "4",
+				ApplicationId: "1",
+				EmployeeId:    "2",
 				LeaveTypeId:   "3",
 				Comment:       "fever",
 				FromDate:      "202-04-24",
 				ToDate:        "2022-04-25",
+				Version:       "1",
 			},
 			isError: true,
 		},
 		{
 			description: "invalid toDate",
 			request: &pb.UpdateLeaveRequest{
-				ApplicationId: "2",
-				EmployeeId:    "4",
+				ApplicationId: "1",
+				EmployeeId:    "2",
 				LeaveTypeId:   "3",
 				Comment:       "fever",
 				FromDate:      "2022-04-24",
 				ToDate:        "202-04-25",
+				Version:       "1",
 			},
 			isError: true,
 		},
 	}
-	updateQuery := `
-			UPDATE lm_leave_application SET 
-				leave_type_id=\?, 
-				comment=\?, 
-				from_date=\?, 
-				to_date=\? 
-			WHERE lm_leave_application.application_id=\?`
 	for _, test := range tests {
 		t.Run(test.description, func(t *testing.T) {
-			if test.isError == false {
-				columns := []string{
-					"employee_id",
+			testDB, mock := getTestMysqlDB(t)
+			if test.leaveStatus != "" {
+				mock.ExpectBegin()
+				expectLockEmployee(mock, test.request.EmployeeId)
+				expectLeaveSnapshot(mock, "1", "2", test.leaveStatus)
+				test.expect(mock)
+				if test.isError {
+					mock.ExpectRollback()
+				} else {
+					expectLeaveSnapshot(mock, "1", "2", pending)
+					expectAuditEvent(mock, "1", "2", "2", auditUpdate)
+					mock.ExpectCommit()
 				}
-				rows := sqlmock.NewRows(columns).AddRow(
-					"2",
-				)
-				getEmployeeIdQuery := `SELECT employee_id FROM lm_leave_application where application_id=\?`
-				mock.ExpectQuery(getEmployeeIdQuery).WithArgs("1").WillReturnRows(rows)
-				mock.ExpectExec(updateQuery).WithArgs("3", "fever", "2022-04-24", "2022-04-25", "1").
-					WillReturnResult(sqlmock.NewResult(1, 1))
-				err := testDB.UpdateLeave(context.Background(), test.request)
+			}
+			_, err := testDB.UpdateLeave(context.Background(), test.request)
+			if test.isError == false && err != nil {
+				t.Errorf("got error %v: want error: %v", err, false)
+			}
+			if test.isError == true && err == nil {
+				t.Errorf("got error %v: want error: %v", err, true)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+func TestMySqlMock_StaleVersion(t *testing.T) {
+	tests := []struct {
+		description string
+		setup       func(mock sqlmock.Sqlmock)
+		lock        func(mock sqlmock.Sqlmock)
+		call        func(testDB *MysqlDB) error
+	}{
+		{
+			description: "change leave status",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`SELECT designation_id FROM lm_employee where employee_id=\?`).WithArgs("8").
+					WillReturnRows(sqlmock.NewRows([]string{"designation_id"}).AddRow("3"))
+			},
+			lock: func(mock sqlmock.Sqlmock) {
+				expectLockApplicant(mock, "2", "1")
+			},
+			call: func(testDB *MysqlDB) error {
+				return testDB.ChangeLeaveStatus(context.Background(), &pb.ChangeLeaveStatusRequest{
+					EmployeeId:    "8",
+					ApplicationId: "2",
+					LeaveStatus:   "1",
+					Version:       "0",
+				})
+			},
+		},
+		{
+			description: "update leave",
+			setup:       func(mock sqlmock.Sqlmock) {},
+			lock: func(mock sqlmock.Sqlmock) {
+				expectLockEmployee(mock, "1")
+			},
+			call: func(testDB *MysqlDB) error {
+				_, err := testDB.UpdateLeave(context.Background(), &pb.UpdateLeaveRequest{
+					ApplicationId: "2",
+					EmployeeId:    "1",
+					LeaveTypeId:   "3",
+					Comment:       "fever",
+					FromDate:      "2022-04-24",
+					ToDate:        "2022-04-25",
+					Version:       "0",
+				})
+				return err
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			testDB, mock := getTestMysqlDB(t)
+			test.setup(mock)
+			mock.ExpectBegin()
+			test.lock(mock)
+			expectLeaveSnapshot(mock, "2", "1", pending)
+			mock.ExpectRollback()
+			err := test.call(testDB)
+			if status.Code(err) != codes.Aborted {
+				t.Errorf("got error %v: want error: %v", err, codes.Aborted)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+func TestMySqlMock_LeavesListRowError(t *testing.T) {
+	testDB, mock := getTestMysqlDB(t)
+	columns := []string{
+		"first_name",
+		"last_name",
+		"application_id",
+		"employee_id",
+		"leave_type_id",
+		"date_of_application",
+		"from_date",
+		"to_date",
+		"no_of_days",
+		"leave_balance",
+		"leave_status",
+		"comment",
+		"date_of_approval",
+		"version",
+		"loss_of_pay_days",
+		"advance_days",
+	}
+	rows := sqlmock.NewRows(columns).AddRow(
+		"Saurabh",
+		"Jain",
+		"1",
+		"5",
+		"3",
+		"2022-04-07T23:19:53+05:30",
+		"2022-04-11T00:00:00+05:30",
+		"2022-04-14T00:00:00+05:30",
+		"4",
+		"5",
+		"2",
+		"Exams",
+		"2022-04-11T00:00:00+05:30",
+		"3",
+		"0",
+		"0",
+	).RowError(0, errors.New("connection reset"))
+	mock.ExpectQuery(`SELECT designation_id FROM lm_employee where employee_id=\?`).WithArgs("7").
+		WillReturnRows(sqlmock.NewRows([]string{"designation_id"}).AddRow("2"))
+	mock.ExpectQuery(`FROM lm_leave_application`).WillReturnRows(rows).RowsWillBeClosed()
+	_, err := testDB.LeavesList(context.Background(), &pb.LeavesListRequest{EmployeeId: "7", LeaveStatus: "2"})
+	if err == nil {
+		t.Errorf("got error %v: want error: %v", err, true)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+func TestMySqlMock_QueryTimeout(t *testing.T) {
+	testDB, mock := getTestMysqlDB(t)
+	testDB.QueryTimeout = 10 * time.Millisecond
+	mock.ExpectQuery(`SELECT designation_id FROM lm_employee where employee_id=\?`).WithArgs("7").
+		WillDelayFor(time.Second).
+		WillReturnRows(sqlmock.NewRows([]string{"designation_id"}).AddRow("2"))
+	_, err := testDB.getDesignationId(context.Background(), "7")
+	if err == nil {
+		t.Errorf("got error %v: want error: %v", err, true)
+	}
+}
+func TestMySqlMock_CountPendingLeaves(t *testing.T) {
+	testDB, mock := getTestMysqlDB(t)
+	tests := []struct {
+		description string
+		expected    int
+		isError     bool
+	}{
+		{
+			description: "success",
+			expected:    3,
+			isError:     false,
+		},
+		{
+			description: "error",
+			isError:     true,
+		},
+	}
+	countPendingQuery := `
+						SELECT
+							COUNT\(\*\)
+						FROM lm_leave_application
+						WHERE
+							leave_status=\?
+							AND date_of_application<\?
+							AND deleted_at IS NULL`
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			if test.isError == false {
+				mock.ExpectQuery(countPendingQuery).WithArgs(pending, sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+				result, err := testDB.CountPendingLeaves(context.Background(), 72*time.Hour)
 				if err != nil {
 					t.Errorf("got error %v: want error: %v", err, false)
 				}
-			} else if test.isError == true {
-				columns := []string{
-					"employee_id",
+				if result != test.expected {
+					t.Errorf("expected %v: got %v", test.expected, result)
 				}
-				rows := sqlmock.NewRows(columns).AddRow(
-					"2",
-				)
-				getEmployeeIdQuery := `SELECT employee_id FROM lm_leave_application where application_id=\?`
-				mock.ExpectQuery(getEmployeeIdQuery).WithArgs("1").WillReturnRows(rows)
-				mock.ExpectExec(updateQuery).WithArgs("3", "fever", "2022-04-24", "2022-04-25", "1").
+			} else if test.isError == true {
+				mock.ExpectQuery(countPendingQuery).WithArgs(pending, sqlmock.AnyArg()).
 					WillReturnError(errors.New("error"))
-				err := testDB.UpdateLeave(context.Background(), test.request)
+				_, err := testDB.CountPendingLeaves(context.Background(), 72*time.Hour)
 				if err == nil {
 					t.Errorf("got error %v: want error: %v", err, true)
 				}
@@ -0,0 +1,97 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"leavemanagement/lm-db-service/internal/policy"
+	"leavemanagement/lm-db-service/internal/tracing"
+	"leavemanagement/lm-db-service/pkg/pb"
+	"time"
+)
+
+// ListEligibleLeaveTypes returns the leave types the calling employee may
+// apply for today.
+func (d MysqlDB) ListEligibleLeaveTypes(ctx context.Context, req *pb.ListEligibleLeaveTypesRequest) (*pb.ListEligibleLeaveTypesResponse, error) {
+	if req.EmployeeId == "" {
+		return &pb.ListEligibleLeaveTypesResponse{}, errors.New("invalid input")
+	}
+	employee, err := d.getEmployeeProfile(ctx, req.EmployeeId)
+	if err != nil {
+		return &pb.ListEligibleLeaveTypesResponse{}, err
+	}
+	today, _ := time.Parse(dateFormat, time.Now().Format(dateFormat))
+
+	leaveTypesQuery := `
+					SELECT
+						leave_type_id,
+						leave_name,
+						number_of_days_allowed,` + leavePolicyColumns + `
+					FROM lm_leave_type
+					ORDER BY leave_type_id`
+	ctx, span, end := d.startQuery(ctx, "leaveTypes", "SELECT", "lm_leave_type")
+	defer end()
+	rows, err := d.DB.QueryContext(ctx, leaveTypesQuery)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return &pb.ListEligibleLeaveTypesResponse{}, err
+	}
+	defer rows.Close()
+	leaveTypes := &pb.ListEligibleLeaveTypesResponse{}
+	for rows.Next() {
+		leaveType := &pb.LeaveType{}
+		leavePolicy, err := scanLeavePolicy(rows.Scan, &leaveType.LeaveTypeId, &leaveType.LeaveName, &leaveType.NumberOfDaysAllowed)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return &pb.ListEligibleLeaveTypesResponse{}, err
+		}
+		if len(policy.CheckEligibility(leavePolicy.Eligibility, employee, today)) == 0 {
+			leaveTypes.LeaveTypes = append(leaveTypes.LeaveTypes, leaveType)
+		}
+	}
+	if err = rows.Err(); err != nil {
+		tracing.RecordError(span, err)
+		return &pb.ListEligibleLeaveTypesResponse{}, err
+	}
+	return leaveTypes, nil
+}
+
+// getEmployeeProfile returns what the eligibility rules check of an
+// employee.
+func (d MysqlDB) getEmployeeProfile(ctx context.Context, employeeId string) (policy.Employee, error) {
+	var employee policy.Employee
+	var joiningDate, probationEndDate sql.NullString
+	employeeProfileQuery := `
+					SELECT
+						gender,
+						designation_id,
+						date_of_joining,
+						employment_type,
+						probation_end_date
+					FROM lm_employee
+					WHERE employee_id=?`
+	ctx, span, end := d.startQuery(ctx, "employeeProfile", "SELECT", "lm_employee")
+	defer end()
+	err := d.DB.QueryRowContext(ctx, employeeProfileQuery, employeeId).
+		Scan(&employee.Gender, &employee.DesignationId, &joiningDate, &employee.EmploymentType, &probationEndDate)
+	if err != nil {
+		tracing.RecordError(span, err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return policy.Employee{}, errors.New("employee not found")
+		}
+		return policy.Employee{}, err
+	}
+	employee.JoiningDate = nullableDate(joiningDate)
+	employee.ProbationEndDate = nullableDate(probationEndDate)
+	return employee, nil
+}
+func nullableDate(value sql.NullString) *time.Time {
+	if !value.Valid {
+		return nil
+	}
+	date, err := time.Parse(dateFormat, dateOnly(value.String))
+	if err != nil {
+		return nil
+	}
+	return &date
+}
@@ -0,0 +1,136 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"leavemanagement/lm-db-service/internal/policy"
+	"leavemanagement/lm-db-service/pkg/pb"
+	"reflect"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var employeeProfileColumns = []string{"gender", "designation_id", "date_of_joining", "employment_type", "probation_end_date"}
+
+const employeeProfileQuery = `SELECT\s+gender,\s+designation_id,\s+date_of_joining`
+
+// expectEmployeeProfile expects the profile lookup of employeeId to find a
+// permanent male employee (gender 0) with designation 1 who joined 100 days
+// ago and is on probation for 30 more days.
+func expectEmployeeProfile(mock sqlmock.Sqlmock, employeeId string) {
+	today := time.Now()
+	mock.ExpectQuery(employeeProfileQuery).WithArgs(employeeId).
+		WillReturnRows(sqlmock.NewRows(employeeProfileColumns).
+			AddRow("0", "1", today.AddDate(0, 0, -100).Format(dateFormat), "PERMANENT", today.AddDate(0, 0, 30).Format(dateFormat)))
+}
+
+func TestMySqlMock_ListEligibleLeaveTypes(t *testing.T) {
+	leaveTypesQuery := `SELECT\s+leave_type_id,\s+leave_name,\s+number_of_days_allowed,\s+min_notice_days,`
+	leaveTypeColumns := append([]string{"leave_type_id", "leave_name", "number_of_days_allowed"}, policyColumns...)
+	tests := []struct {
+		description string
+		request     *pb.ListEligibleLeaveTypesRequest
+		expect      func(mock sqlmock.Sqlmock)
+		expected    []string
+		isError     string
+	}{
+		{
+			description: "success",
+			request:     &pb.ListEligibleLeaveTypesRequest{EmployeeId: "1"},
+			expect: func(mock sqlmock.Sqlmock) {
+				expectEmployeeProfile(mock, "1")
+				mock.ExpectQuery(leaveTypesQuery).
+					WillReturnRows(sqlmock.NewRows(leaveTypeColumns).
+						AddRow("1", "Casual", 12, nil, nil, nil, nil, true, false, true, nil, "", nil, "", false, nil).
+						AddRow("2", "Earned", 18, nil, nil, nil, nil, true, false, true, nil, "", nil, "", true, nil).
+						AddRow("3", "Maternity", 182, nil, nil, nil, nil, true, false, true, "1", "", 80, "", false, nil).
+						AddRow("4", "Paternity", 5, nil, nil, nil, nil, true, false, true, "0", "", 80, "PERMANENT", false, nil).
+						AddRow("5", "Sabbatical", 90, nil, nil, nil, nil, true, false, true, nil, "2,3", 1825, "", false, nil))
+			},
+			expected: []string{"1", "4"},
+		},
+		{
+			description: "employee not found",
+			request:     &pb.ListEligibleLeaveTypesRequest{EmployeeId: "9"},
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(employeeProfileQuery).WithArgs("9").WillReturnRows(sqlmock.NewRows(employeeProfileColumns))
+			},
+			isError: "employee not found",
+		},
+		{
+			description: "query error",
+			request:     &pb.ListEligibleLeaveTypesRequest{EmployeeId: "1"},
+			expect: func(mock sqlmock.Sqlmock) {
+				expectEmployeeProfile(mock, "1")
+				mock.ExpectQuery(leaveTypesQuery).WillReturnError(errors.New("error"))
+			},
+			isError: "error",
+		},
+		{
+			description: "validation error",
+			request:     &pb.ListEligibleLeaveTypesRequest{},
+			expect:      func(mock sqlmock.Sqlmock) {},
+			isError:     "invalid input",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			testDB, mock := getTestMysqlDB(t)
+			test.expect(mock)
+			got, err := testDB.ListEligibleLeaveTypes(context.Background(), test.request)
+			if test.isError != "" {
+				if err == nil || err.Error() != test.isError {
+					t.Errorf("got error %v: want error: %v", err, test.isError)
+				}
+			} else if err != nil {
+				t.Errorf("got error %v: want error: %v", err, false)
+			}
+			var actual []string
+			for _, leaveType := range got.LeaveTypes {
+				actual = append(actual, leaveType.LeaveTypeId)
+			}
+			if !reflect.DeepEqual(actual, test.expected) {
+				t.Errorf("expected %v: got %v", test.expected, actual)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestMySqlMock_ApplyLeaveIneligible(t *testing.T) {
+	testDB, mock := getTestMysqlDB(t)
+	mock.ExpectQuery(leavePolicyQuery).WithArgs("3").
+		WillReturnRows(sqlmock.NewRows(policyColumns).AddRow(nil, nil, nil, nil, true, false, true, "1", "", 80, "", true, nil))
+	expectEmployeeProfile(mock, "1")
+	_, err := testDB.ApplyLeave(context.Background(), &pb.ApplyLeaveRequest{
+		EmployeeId:  "1",
+		LeaveTypeId: "3",
+		FromDate:    time.Now().AddDate(0, 0, 10).Format(dateFormat),
+		ToDate:      time.Now().AddDate(0, 0, 11).Format(dateFormat),
+		Comment:     "Leave",
+	})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("got error %v: want error: %v", err, codes.FailedPrecondition)
+	}
+	violationErr, ok := err.(*policy.ViolationError)
+	if !ok {
+		t.Fatalf("got error %v: want error: %v", err, "ViolationError")
+	}
+	var actual []string
+	for _, violation := range violationErr.Violations {
+		actual = append(actual, violation.Rule)
+	}
+	expected := []string{policy.RuleGender, policy.RuleProbation}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v: got %v", expected, actual)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
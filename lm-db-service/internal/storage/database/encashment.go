@@ -0,0 +1,359 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"leavemanagement/lm-db-service/internal/policy"
+	"leavemanagement/lm-db-service/internal/tracing"
+	"leavemanagement/lm-db-service/models"
+	"leavemanagement/lm-db-service/pkg/pb"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator"
+)
+
+// occasionExit is the occasion of an encashment on exit.
+const occasionExit = "EXIT"
+
+// encashmentRule is how a leave type may be encashed.
+type encashmentRule struct {
+	encashable bool
+	// maxDays caps the days encashed per leave year, nil for no cap.
+	maxDays *int
+	rate    policy.EncashmentRate
+}
+
+// EncashLeave asks to be paid for unused days of a leave type instead of
+// taking them, at year end or, once the exit is recorded, on exit. The days
+// come off the balance of the leave year straight away and stay off unless HR
+// declines the request. The pay is worked out from the employee's monthly
+// salary and kept with the request, so later salary changes do not alter it.
+func (d MysqlDB) EncashLeave(ctx context.Context, req *pb.EncashLeaveRequest) (*pb.EncashLeaveResponse, error) {
+	year := req.Year
+	if year == "" {
+		year = strconv.Itoa(time.Now().Year())
+	}
+	days, _ := strconv.Atoi(req.Days)
+	validate := validator.New()
+	fields := models.ValidateEncashLeave{
+		EmployeeId:  req.EmployeeId,
+		LeaveTypeId: req.LeaveTypeId,
+		Year:        year,
+		Days:        days,
+		Occasion:    req.Occasion,
+	}
+	err := validate.Struct(fields)
+	if err != nil {
+		return &pb.EncashLeaveResponse{}, errors.New("invalid input")
+	}
+
+	rule, err := d.getEncashmentRule(ctx, req.LeaveTypeId)
+	if err != nil {
+		return &pb.EncashLeaveResponse{}, err
+	}
+	if !rule.encashable {
+		return &pb.EncashLeaveResponse{}, errors.New("leave type cannot be encashed")
+	}
+	if req.Occasion == occasionExit {
+		_, exit, err := d.getServiceDates(ctx, req.EmployeeId)
+		if err != nil {
+			return &pb.EncashLeaveResponse{}, err
+		}
+		if exit == nil {
+			return &pb.EncashLeaveResponse{}, errors.New("employee has not exited")
+		}
+	}
+	monthlySalary, err := d.getMonthlySalary(ctx, req.EmployeeId)
+	if err != nil {
+		return &pb.EncashLeaveResponse{}, err
+	}
+	dayRate, err := rule.rate.DayRate(monthlySalary)
+	if err != nil {
+		return &pb.EncashLeaveResponse{}, err
+	}
+
+	encashment := &pb.Encashment{
+		EmployeeId:    req.EmployeeId,
+		LeaveTypeId:   req.LeaveTypeId,
+		Year:          year,
+		Days:          strconv.Itoa(days),
+		Occasion:      req.Occasion,
+		Status:        pending,
+		MonthlySalary: policy.FormatAmount(monthlySalary),
+		DayRate:       policy.FormatAmount(dayRate),
+		Amount:        policy.FormatAmount(dayRate * int64(days)),
+		Formula:       rule.rate.Formula(monthlySalary, dayRate),
+		RequestedAt:   time.Now().Format(dateTimeFormat),
+	}
+	yearStart, _ := leaveYear(year)
+	err = d.withTx(ctx, func(tx *sql.Tx) error {
+		err := d.lockEmployee(ctx, tx, req.EmployeeId)
+		if err != nil {
+			return err
+		}
+		if rule.maxDays != nil {
+			encashedDays, err := d.getEncashedDays(ctx, tx, req.EmployeeId, req.LeaveTypeId, yearStart)
+			if err != nil {
+				return err
+			}
+			if encashedDays+days > *rule.maxDays {
+				return fmt.Errorf("at most %d days can be encashed in a year", *rule.maxDays)
+			}
+		}
+		split, err := d.leaveBalanceAfter(ctx, tx, req.EmployeeId, req.LeaveTypeId, yearStart, days, 0, false)
+		if err != nil {
+			return err
+		}
+		if split.advanceDays > 0 {
+			return errors.New("leaves not remaining")
+		}
+
+		encashLeaveQuery := `
+						INSERT INTO lm_encashment (
+							employee_id,
+							leave_type_id,
+							year,
+							days,
+							occasion,
+							status,
+							monthly_salary,
+							day_rate,
+							amount,
+							formula,
+							requested_at)
+						VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		execCtx, span, end := d.startQuery(ctx, "encashLeave", "INSERT", "lm_encashment")
+		defer end()
+		result, err := tx.ExecContext(execCtx, encashLeaveQuery, encashment.EmployeeId, encashment.LeaveTypeId, encashment.Year,
+			days, encashment.Occasion, encashment.Status, encashment.MonthlySalary, encashment.DayRate, encashment.Amount,
+			encashment.Formula, encashment.RequestedAt)
+		tracing.RecordResult(span, result)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return err
+		}
+		encashmentId, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		encashment.EncashmentId = strconv.FormatInt(encashmentId, 10)
+		return d.appendRecordAuditEvent(ctx, tx, auditSubjectEncashment, encashment.EncashmentId, encashment.EmployeeId,
+			req.EmployeeId, auditRequest, nil, encashment)
+	})
+	if err != nil {
+		return &pb.EncashLeaveResponse{}, err
+	}
+	return &pb.EncashLeaveResponse{Encashment: encashment}, nil
+}
+
+// ChangeEncashmentStatus approves or declines a pending encashment request,
+// only HR has access to it. Declining gives the days back to the balance.
+func (d MysqlDB) ChangeEncashmentStatus(ctx context.Context, req *pb.ChangeEncashmentStatusRequest) error {
+	validate := validator.New()
+	fields := models.ValidateChangeEncashmentStatus{
+		EmployeeId:   req.EmployeeId,
+		EncashmentId: req.EncashmentId,
+		Status:       req.Status,
+	}
+	err := validate.Struct(fields)
+	if err != nil {
+		return errors.New("invalid input")
+	}
+
+	designationId, err := d.getDesignationId(ctx, req.EmployeeId)
+	if err != nil {
+		return err
+	}
+	if designationId != hrId {
+		return errors.New("access denied")
+	}
+
+	return d.withTx(ctx, func(tx *sql.Tx) error {
+		var applicantId, encashmentStatus string
+		encashmentQuery := `SELECT employee_id, status FROM lm_encashment WHERE encashment_id=? FOR UPDATE`
+		queryCtx, span, end := d.startQuery(ctx, "encashment", "SELECT", "lm_encashment")
+		err := tx.QueryRowContext(queryCtx, encashmentQuery, req.EncashmentId).Scan(&applicantId, &encashmentStatus)
+		end()
+		if err != nil {
+			tracing.RecordError(span, err)
+			if errors.Is(err, sql.ErrNoRows) {
+				return errors.New("encashment not found")
+			}
+			return err
+		}
+		if encashmentStatus != pending {
+			return errors.New("encashment already decided")
+		}
+
+		changeEncashmentStatusQuery := `
+						UPDATE lm_encashment
+						SET
+							status=?,
+							decided_by=?,
+							decided_at=?
+						WHERE encashment_id=?`
+		execCtx, span, end := d.startQuery(ctx, "changeEncashmentStatus", "UPDATE", "lm_encashment")
+		defer end()
+		decidedAt := time.Now().Format(dateTimeFormat)
+		result, err := tx.ExecContext(execCtx, changeEncashmentStatusQuery, req.Status, req.EmployeeId,
+			decidedAt, req.EncashmentId)
+		tracing.RecordResult(span, result)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return err
+		}
+		before := &pb.Encashment{EncashmentId: req.EncashmentId, EmployeeId: applicantId, Status: encashmentStatus}
+		after := &pb.Encashment{EncashmentId: req.EncashmentId, EmployeeId: applicantId, Status: req.Status,
+			DecidedBy: req.EmployeeId, DecidedAt: decidedAt}
+		return d.appendRecordAuditEvent(ctx, tx, auditSubjectEncashment, req.EncashmentId, applicantId, req.EmployeeId,
+			auditChangeStatus, before, after)
+	})
+}
+
+// ListEncashments returns encashment requests, oldest first, optionally only
+// those with a status or of a leave year. Employees see their own, managers
+// those of their team and HR everyone's; HR can leave the target employee
+// empty to list all employees' requests, as for payroll export.
+func (d MysqlDB) ListEncashments(ctx context.Context, req *pb.ListEncashmentsRequest) (*pb.ListEncashmentsResponse, error) {
+	validate := validator.New()
+	fields := models.ValidateListEncashments{
+		EmployeeId:       req.EmployeeId,
+		TargetEmployeeId: req.TargetEmployeeId,
+		Status:           req.Status,
+		Year:             req.Year,
+	}
+	err := validate.Struct(fields)
+	if err != nil {
+		return &pb.ListEncashmentsResponse{}, errors.New("invalid input")
+	}
+
+	targetEmployeeId := req.TargetEmployeeId
+	if targetEmployeeId == "" {
+		designationId, err := d.getDesignationId(ctx, req.EmployeeId)
+		if err != nil {
+			return &pb.ListEncashmentsResponse{}, err
+		}
+		if designationId != hrId {
+			targetEmployeeId = req.EmployeeId
+		}
+	} else {
+		err = d.canViewEmployee(ctx, req.EmployeeId, targetEmployeeId)
+		if err != nil {
+			return &pb.ListEncashmentsResponse{}, err
+		}
+	}
+
+	listEncashmentsQuery := `
+					SELECT
+						encashment_id,
+						employee_id,
+						leave_type_id,
+						year,
+						days,
+						occasion,
+						status,
+						monthly_salary,
+						day_rate,
+						amount,
+						formula,
+						requested_at,
+						IFNULL(decided_by,""),
+						IFNULL(decided_at,"")
+					FROM lm_encashment
+					WHERE (?="" OR employee_id=?) AND (?="" OR status=?) AND (?="" OR year=?)
+					ORDER BY encashment_id`
+	ctx, span, end := d.startQuery(ctx, "listEncashments", "SELECT", "lm_encashment")
+	defer end()
+	rows, err := d.DB.QueryContext(ctx, listEncashmentsQuery, targetEmployeeId, targetEmployeeId, req.Status, req.Status,
+		req.Year, req.Year)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return &pb.ListEncashmentsResponse{}, err
+	}
+	defer rows.Close()
+	encashments := &pb.ListEncashmentsResponse{}
+	for rows.Next() {
+		encashment := &pb.Encashment{}
+		err = rows.Scan(&encashment.EncashmentId, &encashment.EmployeeId, &encashment.LeaveTypeId, &encashment.Year,
+			&encashment.Days, &encashment.Occasion, &encashment.Status, &encashment.MonthlySalary, &encashment.DayRate,
+			&encashment.Amount, &encashment.Formula, &encashment.RequestedAt, &encashment.DecidedBy, &encashment.DecidedAt)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return &pb.ListEncashmentsResponse{}, err
+		}
+		encashments.Encashments = append(encashments.Encashments, encashment)
+	}
+	if err = rows.Err(); err != nil {
+		tracing.RecordError(span, err)
+		return &pb.ListEncashmentsResponse{}, err
+	}
+	return encashments, nil
+}
+
+// getEncashmentRule returns how leaveTypeId may be encashed.
+func (d MysqlDB) getEncashmentRule(ctx context.Context, leaveTypeId string) (encashmentRule, error) {
+	var rule encashmentRule
+	var maxDays sql.NullInt64
+	encashmentRuleQuery := `
+					SELECT encashable, max_encash_days, encash_rate_percent, encash_rate_divisor
+					FROM lm_leave_type
+					WHERE leave_type_id=?`
+	ctx, span, end := d.startQuery(ctx, "encashmentRule", "SELECT", "lm_leave_type")
+	defer end()
+	err := d.DB.QueryRowContext(ctx, encashmentRuleQuery, leaveTypeId).Scan(&rule.encashable, &maxDays,
+		&rule.rate.Percent, &rule.rate.Divisor)
+	if err != nil {
+		tracing.RecordError(span, err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return encashmentRule{}, errors.New("leave type not found")
+		}
+		return encashmentRule{}, err
+	}
+	if maxDays.Valid {
+		days := int(maxDays.Int64)
+		rule.maxDays = &days
+	}
+	return rule, nil
+}
+
+// getEncashedDays returns the days of leaveTypeId the employee has asked to
+// encash in the leave year containing date, leaving out declined requests.
+func (d MysqlDB) getEncashedDays(ctx context.Context, q queryer, employeeId, leaveTypeId, date string) (int, error) {
+	var encashedDays int
+	encashedDaysQuery := `
+					SELECT IFNULL(SUM(days),0)
+					FROM lm_encashment
+					WHERE employee_id=? AND leave_type_id=? AND status<>? AND year=?`
+	year, _ := leaveYear(date)
+	ctx, span, end := d.startQuery(ctx, "encashedDays", "SELECT", "lm_encashment")
+	defer end()
+	err := q.QueryRowContext(ctx, encashedDaysQuery, employeeId, leaveTypeId, declined, year[:4]).Scan(&encashedDays)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return 0, err
+	}
+	return encashedDays, nil
+}
+
+// getMonthlySalary returns the monthly salary of the employee in hundredths.
+func (d MysqlDB) getMonthlySalary(ctx context.Context, employeeId string) (int64, error) {
+	var monthlySalary sql.NullString
+	monthlySalaryQuery := `SELECT monthly_salary FROM lm_employee WHERE employee_id=?`
+	ctx, span, end := d.startQuery(ctx, "monthlySalary", "SELECT", "lm_employee")
+	defer end()
+	err := d.DB.QueryRowContext(ctx, monthlySalaryQuery, employeeId).Scan(&monthlySalary)
+	if err != nil {
+		tracing.RecordError(span, err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, errors.New("employee not found")
+		}
+		return 0, err
+	}
+	if !monthlySalary.Valid {
+		return 0, errors.New("monthly salary not set")
+	}
+	return policy.ParseAmount(monthlySalary.String)
+}
@@ -0,0 +1,347 @@
+package database
+
+import (
+	"context"
+	"database/sql/driver"
+	"leavemanagement/lm-db-service/pkg/pb"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"google.golang.org/protobuf/proto"
+)
+
+var encashmentColumns = []string{"encashment_id", "employee_id", "leave_type_id", "year", "days", "occasion", "status",
+	"monthly_salary", "day_rate", "amount", "formula", "requested_at", "decided_by", "decided_at"}
+
+// expectEncashedDays expects the days of leaveTypeId the employee has
+// encashed in year.
+func expectEncashedDays(mock sqlmock.Sqlmock, employeeId, leaveTypeId, year string, days int) {
+	mock.ExpectQuery(`SELECT IFNULL\(SUM\(days\),0\)\s+FROM lm_encashment`).WithArgs(employeeId, leaveTypeId, declined, year).
+		WillReturnRows(sqlmock.NewRows([]string{"days"}).AddRow(days))
+}
+
+// expectLockEmployee expects the row of the employee to be locked.
+func expectLockEmployee(mock sqlmock.Sqlmock, employeeId string) {
+	mock.ExpectQuery(`SELECT employee_id FROM lm_employee WHERE employee_id=\? FOR UPDATE`).WithArgs(employeeId).
+		WillReturnRows(sqlmock.NewRows([]string{"employee_id"}).AddRow(employeeId))
+}
+
+// expectLockApplicant expects the row of the employee who applied for
+// applicationId to be locked.
+func expectLockApplicant(mock sqlmock.Sqlmock, applicationId, employeeId string) {
+	mock.ExpectQuery(`SELECT employee_id FROM lm_leave_application WHERE application_id=\?`).WithArgs(applicationId).
+		WillReturnRows(sqlmock.NewRows([]string{"employee_id"}).AddRow(employeeId))
+	expectLockEmployee(mock, employeeId)
+}
+
+func TestMySqlMock_EncashLeave(t *testing.T) {
+	encashmentRuleQuery := `SELECT encashable, max_encash_days, encash_rate_percent, encash_rate_divisor\s+FROM lm_leave_type`
+	encashmentRuleColumns := []string{"encashable", "max_encash_days", "encash_rate_percent", "encash_rate_divisor"}
+	expectBalance := func(mock sqlmock.Sqlmock, taken int) {
+		expectAllowedDays(mock, "1", "2", 12)
+		mock.ExpectQuery(`SELECT\s+IFNULL\(SUM\(IF\(from_date>=\?, no_of_days-loss_of_pay_days, advance_days\)\),0\)`).WithArgs("2022-01-01", "1", "2", declined, "2021-01-01", "2022-12-31").
+			WillReturnRows(sqlmock.NewRows([]string{"no_of_days"}).AddRow(taken))
+		expectAdvancesBefore(mock, "1", "2", "2021-01-01")
+		expectEncashedDays(mock, "1", "2", "2022", 2)
+		expectAdjustedDays(mock, "1", "2", "2022", 0)
+	}
+	monthlySalaryQuery := `SELECT monthly_salary FROM lm_employee WHERE employee_id=\?`
+	expectMonthlySalary := func(mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(monthlySalaryQuery).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"monthly_salary"}).AddRow("45000.00"))
+		mock.ExpectBegin()
+		expectLockEmployee(mock, "1")
+	}
+	request := &pb.EncashLeaveRequest{EmployeeId: "1", LeaveTypeId: "2", Year: "2022", Days: "5", Occasion: "YEAR_END"}
+	exitRequest := &pb.EncashLeaveRequest{EmployeeId: "1", LeaveTypeId: "2", Year: "2022", Days: "5", Occasion: "EXIT"}
+	tests := []struct {
+		description string
+		request     *pb.EncashLeaveRequest
+		expect      func(mock sqlmock.Sqlmock)
+		isError     string
+	}{
+		{
+			description: "success",
+			request:     request,
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(encashmentRuleQuery).WithArgs("2").
+					WillReturnRows(sqlmock.NewRows(encashmentRuleColumns).AddRow(true, 10, 100, 30))
+				expectMonthlySalary(mock)
+				expectEncashedDays(mock, "1", "2", "2022", 2)
+				expectBalance(mock, 3)
+				mock.ExpectExec(`INSERT INTO lm_encashment`).
+					WithArgs("1", "2", "2022", 5, "YEAR_END", pending, "45000.00", "1500.00", "7500.00",
+						"45000.00 x 100% / 30 = 1500.00 per day", time.Now().Format(dateTimeFormat)).
+					WillReturnResult(sqlmock.NewResult(7, 1))
+				expectRecordAuditEvent(mock, auditSubjectEncashment, "7", "1", "1", auditRequest)
+				mock.ExpectCommit()
+			},
+		},
+		{
+			description: "on exit",
+			request:     exitRequest,
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(encashmentRuleQuery).WithArgs("2").
+					WillReturnRows(sqlmock.NewRows(encashmentRuleColumns).AddRow(true, nil, 100, 30))
+				expectServiceDates(mock, "1", "2020-01-01", "2022-06-30")
+				expectMonthlySalary(mock)
+				expectBalance(mock, 3)
+				mock.ExpectExec(`INSERT INTO lm_encashment`).WillReturnResult(sqlmock.NewResult(7, 1))
+				expectRecordAuditEvent(mock, auditSubjectEncashment, "7", "1", "1", auditRequest)
+				mock.ExpectCommit()
+			},
+		},
+		{
+			description: "on exit before it is recorded",
+			request:     exitRequest,
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(encashmentRuleQuery).WithArgs("2").
+					WillReturnRows(sqlmock.NewRows(encashmentRuleColumns).AddRow(true, nil, 100, 30))
+				expectServiceDates(mock, "1", "2020-01-01", nil)
+			},
+			isError: "employee has not exited",
+		},
+		{
+			description: "not encashable",
+			request:     request,
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(encashmentRuleQuery).WithArgs("2").
+					WillReturnRows(sqlmock.NewRows(encashmentRuleColumns).AddRow(false, nil, 100, 30))
+			},
+			isError: "leave type cannot be encashed",
+		},
+		{
+			description: "no rate divisor",
+			request:     request,
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(encashmentRuleQuery).WithArgs("2").
+					WillReturnRows(sqlmock.NewRows(encashmentRuleColumns).AddRow(true, nil, 100, 0))
+				mock.ExpectQuery(monthlySalaryQuery).WithArgs("1").
+					WillReturnRows(sqlmock.NewRows([]string{"monthly_salary"}).AddRow("45000.00"))
+			},
+			isError: "encash rate divisor must be greater than 0",
+		},
+		{
+			description: "over the yearly cap",
+			request:     request,
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(encashmentRuleQuery).WithArgs("2").
+					WillReturnRows(sqlmock.NewRows(encashmentRuleColumns).AddRow(true, 6, 100, 30))
+				expectMonthlySalary(mock)
+				expectEncashedDays(mock, "1", "2", "2022", 2)
+				mock.ExpectRollback()
+			},
+			isError: "at most 6 days can be encashed in a year",
+		},
+		{
+			description: "balance not remaining",
+			request:     request,
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(encashmentRuleQuery).WithArgs("2").
+					WillReturnRows(sqlmock.NewRows(encashmentRuleColumns).AddRow(true, nil, 100, 30))
+				expectMonthlySalary(mock)
+				expectBalance(mock, 6)
+				expectNegativeBalanceLimit(mock, "2", 0)
+				mock.ExpectRollback()
+			},
+			isError: "leaves not remaining",
+		},
+		{
+			description: "advance not encashable",
+			request:     request,
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(encashmentRuleQuery).WithArgs("2").
+					WillReturnRows(sqlmock.NewRows(encashmentRuleColumns).AddRow(true, nil, 100, 30))
+				expectMonthlySalary(mock)
+				expectBalance(mock, 6)
+				expectNegativeBalanceLimit(mock, "2", 5)
+				mock.ExpectRollback()
+			},
+			isError: "leaves not remaining",
+		},
+		{
+			description: "monthly salary not set",
+			request:     request,
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(encashmentRuleQuery).WithArgs("2").
+					WillReturnRows(sqlmock.NewRows(encashmentRuleColumns).AddRow(true, nil, 100, 30))
+				mock.ExpectQuery(monthlySalaryQuery).WithArgs("1").
+					WillReturnRows(sqlmock.NewRows([]string{"monthly_salary"}).AddRow(nil))
+			},
+			isError: "monthly salary not set",
+		},
+		{
+			description: "validation error",
+			request:     &pb.EncashLeaveRequest{EmployeeId: "1", LeaveTypeId: "2", Year: "2022", Days: "0", Occasion: "YEAR_END"},
+			expect:      func(mock sqlmock.Sqlmock) {},
+			isError:     "invalid input",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			testDB, mock := getTestMysqlDB(t)
+			test.expect(mock)
+			got, err := testDB.EncashLeave(context.Background(), test.request)
+			if test.isError != "" {
+				if err == nil || err.Error() != test.isError {
+					t.Errorf("got error %v: want error: %v", err, test.isError)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("got error %v: want error: %v", err, false)
+				}
+				if got.Encashment.EncashmentId != "7" || got.Encashment.Amount != "7500.00" {
+					t.Errorf("expected %v: got %v", "7 7500.00", got.Encashment)
+				}
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestMySqlMock_ChangeEncashmentStatus(t *testing.T) {
+	encashmentQuery := `SELECT employee_id, status FROM lm_encashment WHERE encashment_id=\? FOR UPDATE`
+	changeEncashmentStatusQuery := `UPDATE lm_encashment\s+SET\s+status=\?,\s+decided_by=\?,\s+decided_at=\?\s+WHERE encashment_id=\?`
+	tests := []struct {
+		description   string
+		request       *pb.ChangeEncashmentStatusRequest
+		designationId string
+		expect        func(mock sqlmock.Sqlmock)
+		isError       string
+	}{
+		{
+			description:   "approve",
+			request:       &pb.ChangeEncashmentStatusRequest{EmployeeId: "2", EncashmentId: "7", Status: approved},
+			designationId: hrId,
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(encashmentQuery).WithArgs("7").
+					WillReturnRows(sqlmock.NewRows([]string{"employee_id", "status"}).AddRow("1", pending))
+				mock.ExpectExec(changeEncashmentStatusQuery).
+					WithArgs(approved, "2", time.Now().Format(dateTimeFormat), "7").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectRecordAuditEvent(mock, auditSubjectEncashment, "7", "1", "2", auditChangeStatus)
+				mock.ExpectCommit()
+			},
+		},
+		{
+			description:   "already decided",
+			request:       &pb.ChangeEncashmentStatusRequest{EmployeeId: "2", EncashmentId: "7", Status: declined},
+			designationId: hrId,
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(encashmentQuery).WithArgs("7").
+					WillReturnRows(sqlmock.NewRows([]string{"employee_id", "status"}).AddRow("1", approved))
+				mock.ExpectRollback()
+			},
+			isError: "encashment already decided",
+		},
+		{
+			description:   "not found",
+			request:       &pb.ChangeEncashmentStatusRequest{EmployeeId: "2", EncashmentId: "7", Status: approved},
+			designationId: hrId,
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(encashmentQuery).WithArgs("7").WillReturnRows(sqlmock.NewRows([]string{"employee_id", "status"}))
+				mock.ExpectRollback()
+			},
+			isError: "encashment not found",
+		},
+		{
+			description:   "not HR",
+			request:       &pb.ChangeEncashmentStatusRequest{EmployeeId: "3", EncashmentId: "7", Status: approved},
+			designationId: managerId,
+			isError:       "access denied",
+		},
+		{
+			description: "validation error",
+			request:     &pb.ChangeEncashmentStatusRequest{EmployeeId: "2", EncashmentId: "7", Status: pending},
+			isError:     "invalid input",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			testDB, mock := getTestMysqlDB(t)
+			if test.designationId != "" {
+				expectDesignation(mock, test.request.EmployeeId, test.designationId)
+			}
+			if test.expect != nil {
+				mock.ExpectBegin()
+				test.expect(mock)
+			}
+			err := testDB.ChangeEncashmentStatus(context.Background(), test.request)
+			if test.isError != "" {
+				if err == nil || err.Error() != test.isError {
+					t.Errorf("got error %v: want error: %v", err, test.isError)
+				}
+			} else if err != nil {
+				t.Errorf("got error %v: want error: %v", err, false)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestMySqlMock_ListEncashments(t *testing.T) {
+	listEncashmentsQuery := `FROM lm_encashment\s+WHERE \(\?="" OR employee_id=\?\) AND \(\?="" OR status=\?\) AND \(\?="" OR year=\?\)`
+	row := []driver.Value{"7", "1", "2", "2022", "5", "YEAR_END", approved, "45000.00", "1500.00", "7500.00",
+		"45000.00 x 100% / 30 = 1500.00 per day", "2022-12-20 10:00:00", "2", "2022-12-21 11:00:00"}
+	expected := &pb.ListEncashmentsResponse{Encashments: []*pb.Encashment{{
+		EncashmentId: "7", EmployeeId: "1", LeaveTypeId: "2", Year: "2022", Days: "5", Occasion: "YEAR_END",
+		Status: approved, MonthlySalary: "45000.00", DayRate: "1500.00", Amount: "7500.00",
+		Formula: "45000.00 x 100% / 30 = 1500.00 per day", RequestedAt: "2022-12-20 10:00:00",
+		DecidedBy: "2", DecidedAt: "2022-12-21 11:00:00",
+	}}}
+	tests := []struct {
+		description string
+		request     *pb.ListEncashmentsRequest
+		expect      func(mock sqlmock.Sqlmock)
+	}{
+		{
+			description: "HR lists everyone's",
+			request:     &pb.ListEncashmentsRequest{EmployeeId: "2", Status: approved, Year: "2022"},
+			expect: func(mock sqlmock.Sqlmock) {
+				expectDesignation(mock, "2", hrId)
+				mock.ExpectQuery(listEncashmentsQuery).WithArgs("", "", approved, approved, "2022", "2022").
+					WillReturnRows(sqlmock.NewRows(encashmentColumns).AddRow(row...))
+			},
+		},
+		{
+			description: "employee lists their own",
+			request:     &pb.ListEncashmentsRequest{EmployeeId: "1", Year: "2022"},
+			expect: func(mock sqlmock.Sqlmock) {
+				expectDesignation(mock, "1", employeeId)
+				mock.ExpectQuery(listEncashmentsQuery).WithArgs("1", "1", "", "", "2022", "2022").
+					WillReturnRows(sqlmock.NewRows(encashmentColumns).AddRow(row...))
+			},
+		},
+		{
+			description: "manager lists their team member's",
+			request:     &pb.ListEncashmentsRequest{EmployeeId: "8", TargetEmployeeId: "1"},
+			expect: func(mock sqlmock.Sqlmock) {
+				expectDesignation(mock, "8", managerId)
+				expectManager(mock, "1", "8")
+				mock.ExpectQuery(listEncashmentsQuery).WithArgs("1", "1", "", "", "", "").
+					WillReturnRows(sqlmock.NewRows(encashmentColumns).AddRow(row...))
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			testDB, mock := getTestMysqlDB(t)
+			test.expect(mock)
+			got, err := testDB.ListEncashments(context.Background(), test.request)
+			if err != nil {
+				t.Errorf("got error %v: want error: %v", err, false)
+			}
+			if !proto.Equal(got, expected) {
+				t.Errorf("expected %v: got %v", expected, got)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
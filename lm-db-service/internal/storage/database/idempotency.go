@@ -0,0 +1,144 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"leavemanagement/lm-db-service/internal/idempotency"
+	"leavemanagement/lm-db-service/internal/tracing"
+	"time"
+)
+
+// ReserveIdempotencyKey implements idempotency.Store. An expired key is
+// treated as never used.
+func (d MysqlDB) ReserveIdempotencyKey(ctx context.Context, key idempotency.Key, requestHash string, window time.Duration) (*idempotency.Record, error) {
+	now := time.Now()
+	deleteExpiredKeyQuery := `
+					DELETE FROM lm_idempotency_key 
+					WHERE 
+						employee_id=? 
+						AND rpc=? 
+						AND idempotency_key=? 
+						AND expires_at<?`
+	deleteCtx, span, end := d.startQuery(ctx, "deleteExpiredIdempotencyKey", "DELETE", "lm_idempotency_key")
+	defer end()
+	result, err := d.DB.ExecContext(deleteCtx, deleteExpiredKeyQuery, key.EmployeeId, key.Method, key.Key, now)
+	tracing.RecordResult(span, result)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+
+	reserveKeyQuery := `
+					INSERT INTO lm_idempotency_key (
+						employee_id, 
+						rpc, 
+						idempotency_key, 
+						request_hash, 
+						created_at, 
+						expires_at) 
+					VALUES (?, ?, ?, ?, ?, ?) 
+					ON DUPLICATE KEY UPDATE idempotency_key=idempotency_key`
+	reserveCtx, span, end := d.startQuery(ctx, "reserveIdempotencyKey", "INSERT", "lm_idempotency_key")
+	defer end()
+	result, err = d.DB.ExecContext(reserveCtx, reserveKeyQuery, key.EmployeeId, key.Method, key.Key, requestHash, now, now.Add(window))
+	tracing.RecordResult(span, result)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	reserved, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if reserved == 1 {
+		return nil, nil
+	}
+
+	record := &idempotency.Record{}
+	var response []byte
+	getKeyQuery := `
+					SELECT 
+						request_hash, 
+						completed, 
+						response 
+					FROM lm_idempotency_key 
+					WHERE 
+						employee_id=? 
+						AND rpc=? 
+						AND idempotency_key=?`
+	getCtx, span, end := d.startQuery(ctx, "getIdempotencyKey", "SELECT", "lm_idempotency_key")
+	defer end()
+	err = d.DB.QueryRowContext(getCtx, getKeyQuery, key.EmployeeId, key.Method, key.Key).Scan(&record.RequestHash, &record.Completed, &response)
+	if err != nil {
+		tracing.RecordError(span, err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.New("idempotency key was released concurrently")
+		}
+		return nil, err
+	}
+	record.Response = response
+	return record, nil
+}
+
+// CompleteIdempotencyKey implements idempotency.Store.
+func (d MysqlDB) CompleteIdempotencyKey(ctx context.Context, key idempotency.Key, response []byte) error {
+	completeKeyQuery := `
+					UPDATE lm_idempotency_key 
+					SET 
+						completed=1, 
+						response=? 
+					WHERE 
+						employee_id=? 
+						AND rpc=? 
+						AND idempotency_key=?`
+	ctx, span, end := d.startQuery(ctx, "completeIdempotencyKey", "UPDATE", "lm_idempotency_key")
+	defer end()
+	result, err := d.DB.ExecContext(ctx, completeKeyQuery, response, key.EmployeeId, key.Method, key.Key)
+	tracing.RecordResult(span, result)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+// ReleaseIdempotencyKey implements idempotency.Store. Completed keys are
+// never released.
+func (d MysqlDB) ReleaseIdempotencyKey(ctx context.Context, key idempotency.Key) error {
+	releaseKeyQuery := `
+					DELETE FROM lm_idempotency_key 
+					WHERE 
+						employee_id=? 
+						AND rpc=? 
+						AND idempotency_key=? 
+						AND completed=0`
+	ctx, span, end := d.startQuery(ctx, "releaseIdempotencyKey", "DELETE", "lm_idempotency_key")
+	defer end()
+	result, err := d.DB.ExecContext(ctx, releaseKeyQuery, key.EmployeeId, key.Method, key.Key)
+	tracing.RecordResult(span, result)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return err
+	}
+	return nil
+}
+
+// PurgeIdempotencyKeys removes keys created more than olderThan ago, at most
+// purgeBatchSize per call, and returns how many were removed.
+func (d MysqlDB) PurgeIdempotencyKeys(ctx context.Context, olderThan time.Duration) (int, error) {
+	purgeKeysQuery := `DELETE FROM lm_idempotency_key WHERE created_at<? LIMIT ?`
+	ctx, span, end := d.startQuery(ctx, "purgeIdempotencyKeys", "DELETE", "lm_idempotency_key")
+	defer end()
+	result, err := d.DB.ExecContext(ctx, purgeKeysQuery, time.Now().Add(-olderThan), purgeBatchSize)
+	tracing.RecordResult(span, result)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return 0, err
+	}
+	purged, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(purged), nil
+}
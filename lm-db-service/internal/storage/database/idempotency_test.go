@@ -0,0 +1,115 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"leavemanagement/lm-db-service/internal/idempotency"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestMySqlMock_ReserveIdempotencyKey(t *testing.T) {
+	key := idempotency.Key{EmployeeId: "1", Method: "/leaveManagement.leaveManagementSerivce/ApplyLeave", Key: "a1"}
+	tests := []struct {
+		description string
+		reserved    int64
+		rows        *sqlmock.Rows
+		expected    *idempotency.Record
+		isError     bool
+	}{
+		{
+			description: "new key",
+			reserved:    1,
+			expected:    nil,
+			isError:     false,
+		},
+		{
+			description: "completed",
+			reserved:    0,
+			rows:        sqlmock.NewRows([]string{"request_hash", "completed", "response"}).AddRow("h1", true, []byte{0x0a, 0x01, 0x34}),
+			expected:    &idempotency.Record{RequestHash: "h1", Completed: true, Response: []byte{0x0a, 0x01, 0x34}},
+			isError:     false,
+		},
+		{
+			description: "in progress",
+			reserved:    0,
+			rows:        sqlmock.NewRows([]string{"request_hash", "completed", "response"}).AddRow("h1", false, nil),
+			expected:    &idempotency.Record{RequestHash: "h1"},
+			isError:     false,
+		},
+		{
+			description: "released concurrently",
+			reserved:    0,
+			rows:        sqlmock.NewRows([]string{"request_hash", "completed", "response"}),
+			isError:     true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			testDB, mock := getTestMysqlDB(t)
+			mock.ExpectExec(`DELETE FROM lm_idempotency_key\s+WHERE\s+employee_id=\?\s+AND rpc=\?\s+AND idempotency_key=\?\s+AND expires_at<\?`).
+				WithArgs("1", key.Method, "a1", sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectExec(`INSERT INTO lm_idempotency_key`).
+				WithArgs("1", key.Method, "a1", "h1", sqlmock.AnyArg(), sqlmock.AnyArg()).
+				WillReturnResult(sqlmock.NewResult(0, test.reserved))
+			if test.rows != nil {
+				mock.ExpectQuery(`FROM lm_idempotency_key`).WithArgs("1", key.Method, "a1").WillReturnRows(test.rows)
+			}
+			record, err := testDB.ReserveIdempotencyKey(context.Background(), key, "h1", time.Hour)
+			if test.isError == false {
+				if err != nil {
+					t.Errorf("got error %v: want error: %v", err, false)
+				}
+				if (record == nil) != (test.expected == nil) ||
+					(record != nil && (record.RequestHash != test.expected.RequestHash ||
+						record.Completed != test.expected.Completed ||
+						string(record.Response) != string(test.expected.Response))) {
+					t.Errorf("expected %v: got %v", test.expected, record)
+				}
+			} else if test.isError == true {
+				if err == nil {
+					t.Errorf("got error %v: want error: %v", err, true)
+				}
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestMySqlMock_CompleteAndReleaseIdempotencyKey(t *testing.T) {
+	key := idempotency.Key{EmployeeId: "1", Method: "/leaveManagement.leaveManagementSerivce/ApplyLeave", Key: "a1"}
+	testDB, mock := getTestMysqlDB(t)
+	mock.ExpectExec(`UPDATE lm_idempotency_key\s+SET\s+completed=1,\s+response=\?`).
+		WithArgs([]byte("response"), "1", key.Method, "a1").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`DELETE FROM lm_idempotency_key\s+WHERE\s+employee_id=\?\s+AND rpc=\?\s+AND idempotency_key=\?\s+AND completed=0`).
+		WithArgs("1", key.Method, "a1").WillReturnError(errors.New("error"))
+	if err := testDB.CompleteIdempotencyKey(context.Background(), key, []byte("response")); err != nil {
+		t.Errorf("got error %v: want error: %v", err, false)
+	}
+	if err := testDB.ReleaseIdempotencyKey(context.Background(), key); err == nil {
+		t.Errorf("got error %v: want error: %v", err, true)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMySqlMock_PurgeIdempotencyKeys(t *testing.T) {
+	testDB, mock := getTestMysqlDB(t)
+	mock.ExpectExec(`DELETE FROM lm_idempotency_key WHERE created_at<\? LIMIT \?`).
+		WithArgs(sqlmock.AnyArg(), purgeBatchSize).WillReturnResult(sqlmock.NewResult(0, 7))
+	purged, err := testDB.PurgeIdempotencyKeys(context.Background(), 24*time.Hour)
+	if err != nil {
+		t.Errorf("got error %v: want error: %v", err, false)
+	}
+	if purged != 7 {
+		t.Errorf("expected %v: got %v", 7, purged)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,97 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"leavemanagement/lm-db-service/internal/storage/validation"
+	"leavemanagement/lm-db-service/internal/tracing"
+	"leavemanagement/lm-db-service/models"
+	"leavemanagement/lm-db-service/pkg/pb"
+
+	"github.com/go-playground/validator"
+)
+
+// ListLossOfPay returns the approved applications with days taken as loss
+// of pay whose from date falls between fromDate and toDate, in order of from
+// date. Employees see their own, managers those of their team and HR
+// everyone's; HR can leave the target employee empty to report on all
+// employees, as for payroll.
+func (d MysqlDB) ListLossOfPay(ctx context.Context, req *pb.ListLossOfPayRequest) (*pb.ListLossOfPayResponse, error) {
+	validate := validator.New()
+	fields := models.ValidateListLossOfPay{
+		EmployeeId:       req.EmployeeId,
+		TargetEmployeeId: req.TargetEmployeeId,
+		FromDate:         req.FromDate,
+		ToDate:           req.ToDate,
+	}
+	err := validate.Struct(fields)
+	if err != nil {
+		return &pb.ListLossOfPayResponse{}, errors.New("invalid input")
+	}
+	err = validation.ValidateFromDate(req.FromDate)
+	if err != nil {
+		return &pb.ListLossOfPayResponse{}, err
+	}
+	err = validation.ValidateToDate(req.ToDate)
+	if err != nil {
+		return &pb.ListLossOfPayResponse{}, err
+	}
+
+	targetEmployeeId := req.TargetEmployeeId
+	if targetEmployeeId == "" {
+		designationId, err := d.getDesignationId(ctx, req.EmployeeId)
+		if err != nil {
+			return &pb.ListLossOfPayResponse{}, err
+		}
+		if designationId != hrId {
+			targetEmployeeId = req.EmployeeId
+		}
+	} else {
+		err = d.canViewEmployee(ctx, req.EmployeeId, targetEmployeeId)
+		if err != nil {
+			return &pb.ListLossOfPayResponse{}, err
+		}
+	}
+
+	listLossOfPayQuery := `
+					SELECT
+						application_id,
+						employee_id,
+						leave_type_id,
+						from_date,
+						to_date,
+						no_of_days,
+						loss_of_pay_days
+					FROM lm_leave_application
+					WHERE (?="" OR employee_id=?)
+						AND leave_status=?
+						AND loss_of_pay_days>0
+						AND deleted_at IS NULL
+						AND from_date BETWEEN ? AND ?
+					ORDER BY from_date, application_id`
+	ctx, span, end := d.startQuery(ctx, "listLossOfPay", "SELECT", "lm_leave_application")
+	defer end()
+	rows, err := d.DB.QueryContext(ctx, listLossOfPayQuery, targetEmployeeId, targetEmployeeId, approved, req.FromDate, req.ToDate)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return &pb.ListLossOfPayResponse{}, err
+	}
+	defer rows.Close()
+	report := &pb.ListLossOfPayResponse{}
+	for rows.Next() {
+		lossOfPay := &pb.LossOfPay{}
+		err = rows.Scan(&lossOfPay.ApplicationId, &lossOfPay.EmployeeId, &lossOfPay.LeaveTypeId, &lossOfPay.FromDate,
+			&lossOfPay.ToDate, &lossOfPay.NoOfDays, &lossOfPay.LossOfPayDays)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return &pb.ListLossOfPayResponse{}, err
+		}
+		lossOfPay.FromDate, lossOfPay.ToDate = dateOnly(lossOfPay.FromDate), dateOnly(lossOfPay.ToDate)
+		report.LossOfPay = append(report.LossOfPay, lossOfPay)
+	}
+	if err = rows.Err(); err != nil {
+		tracing.RecordError(span, err)
+		return &pb.ListLossOfPayResponse{}, err
+	}
+	return report, nil
+}
@@ -0,0 +1,169 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"leavemanagement/lm-db-service/pkg/pb"
+	"strconv"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestMySqlMock_ApplyLeaveLossOfPay(t *testing.T) {
+	tests := []struct {
+		description     string
+		taken           int
+		acceptLossOfPay bool
+		leaveBalance    int
+		lossOfPayDays   int
+		isError         error
+	}{
+		{
+			description:     "balance covers the leave",
+			taken:           1,
+			acceptLossOfPay: true,
+		},
+		{
+			description:     "excess taken as loss of pay",
+			taken:           2,
+			acceptLossOfPay: true,
+			lossOfPayDays:   1,
+		},
+		{
+			description:     "balance used up",
+			taken:           3,
+			acceptLossOfPay: true,
+			lossOfPayDays:   2,
+		},
+		{
+			description: "loss of pay not accepted",
+			taken:       2,
+			isError:     errors.New("leaves not remaining"),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			testDB, mock := getTestMysqlDB(t)
+			expectLeavePolicy(mock, "1", "2022-04-20", "2022-04-21")
+			expectBlackoutPeriods(mock, "1", "1", "2022-04-20", "2022-04-21")
+			mock.ExpectBegin()
+			expectLockEmployee(mock, "1")
+			expectAllowedDays(mock, "1", "1", 3)
+			mock.ExpectQuery(`SELECT IFNULL\(SUM\(IF\(from_date>=\?, no_of_days-loss_of_pay_days, advance_days\)\),0\)`).WithArgs("2022-01-01", "1", "1", declined, "2021-01-01", "2022-12-31").
+				WillReturnRows(sqlmock.NewRows([]string{"no_of_days"}).AddRow(test.taken))
+			expectAdvancesBefore(mock, "1", "1", "2021-01-01")
+			expectEncashedDays(mock, "1", "1", "2022", 0)
+			expectAdjustedDays(mock, "1", "1", "2022", 0)
+			if test.taken > 1 {
+				expectNegativeBalanceLimit(mock, "1", 0)
+			}
+			if test.isError != nil {
+				mock.ExpectRollback()
+			} else {
+				expectCoverageRules(mock, "1")
+				mock.ExpectExec(`INSERT INTO lm_leave_application`).
+					WithArgs("1", 1, time.Now().Format(dateTimeFormat), "2022-04-20", "2022-04-21", 2, test.leaveBalance, "Fever", 0, 0,
+						test.lossOfPayDays, 0).
+					WillReturnResult(sqlmock.NewResult(4, 1))
+				expectLeaveSnapshot(mock, "4", "1", pending)
+				expectAuditEvent(mock, "4", "1", "1", auditApply)
+				mock.ExpectCommit()
+			}
+			got, err := testDB.ApplyLeave(context.Background(), &pb.ApplyLeaveRequest{
+				EmployeeId:      "1",
+				LeaveTypeId:     "1",
+				FromDate:        "2022-04-20",
+				ToDate:          "2022-04-21",
+				Comment:         "Fever",
+				AcceptLossOfPay: test.acceptLossOfPay,
+			})
+			if test.isError == nil {
+				if err != nil {
+					t.Errorf("got error %v: want error: %v", err, false)
+				}
+				if expected := strconv.Itoa(test.lossOfPayDays); got.LossOfPayDays != expected {
+					t.Errorf("expected %v: got %v", expected, got.LossOfPayDays)
+				}
+			} else if err == nil || err.Error() != test.isError.Error() {
+				t.Errorf("got error %v: want error: %v", err, test.isError)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestMySqlMock_ListLossOfPay(t *testing.T) {
+	listLossOfPayQuery := `FROM lm_leave_application\s+WHERE \(\?="" OR employee_id=\?\)\s+AND leave_status=\?\s+AND loss_of_pay_days>0`
+	lossOfPayColumns := []string{"application_id", "employee_id", "leave_type_id", "from_date", "to_date", "no_of_days", "loss_of_pay_days"}
+	expected := &pb.ListLossOfPayResponse{LossOfPay: []*pb.LossOfPay{
+		{ApplicationId: "4", EmployeeId: "1", LeaveTypeId: "1", FromDate: "2022-04-20", ToDate: "2022-04-21", NoOfDays: "2", LossOfPayDays: "1"},
+	}}
+	tests := []struct {
+		description string
+		request     *pb.ListLossOfPayRequest
+		expect      func(mock sqlmock.Sqlmock)
+		isError     string
+	}{
+		{
+			description: "HR reports on everyone",
+			request:     &pb.ListLossOfPayRequest{EmployeeId: "2", FromDate: "2022-04-01", ToDate: "2022-04-30"},
+			expect: func(mock sqlmock.Sqlmock) {
+				expectDesignation(mock, "2", hrId)
+				mock.ExpectQuery(listLossOfPayQuery).WithArgs("", "", approved, "2022-04-01", "2022-04-30").
+					WillReturnRows(sqlmock.NewRows(lossOfPayColumns).
+						AddRow("4", "1", "1", "2022-04-20T00:00:00+05:30", "2022-04-21T00:00:00+05:30", "2", "1"))
+			},
+		},
+		{
+			description: "employee sees their own",
+			request:     &pb.ListLossOfPayRequest{EmployeeId: "1", FromDate: "2022-04-01", ToDate: "2022-04-30"},
+			expect: func(mock sqlmock.Sqlmock) {
+				expectDesignation(mock, "1", employeeId)
+				mock.ExpectQuery(listLossOfPayQuery).WithArgs("1", "1", approved, "2022-04-01", "2022-04-30").
+					WillReturnRows(sqlmock.NewRows(lossOfPayColumns).
+						AddRow("4", "1", "1", "2022-04-20T00:00:00+05:30", "2022-04-21T00:00:00+05:30", "2", "1"))
+			},
+		},
+		{
+			description: "another employee",
+			request:     &pb.ListLossOfPayRequest{EmployeeId: "3", TargetEmployeeId: "1", FromDate: "2022-04-01", ToDate: "2022-04-30"},
+			expect: func(mock sqlmock.Sqlmock) {
+				expectDesignation(mock, "3", employeeId)
+			},
+			isError: "access denied",
+		},
+		{
+			description: "invalid input",
+			request:     &pb.ListLossOfPayRequest{EmployeeId: "2", FromDate: "2022-04-01"},
+			expect:      func(mock sqlmock.Sqlmock) {},
+			isError:     "invalid input",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			testDB, mock := getTestMysqlDB(t)
+			test.expect(mock)
+			got, err := testDB.ListLossOfPay(context.Background(), test.request)
+			if test.isError != "" {
+				if err == nil || err.Error() != test.isError {
+					t.Errorf("got error %v: want error: %v", err, test.isError)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("got error %v: want error: %v", err, false)
+				}
+				if !proto.Equal(got, expected) {
+					t.Errorf("expected %v: got %v", expected, got)
+				}
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
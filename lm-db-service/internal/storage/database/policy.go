@@ -0,0 +1,208 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"leavemanagement/lm-db-service/internal/policy"
+	"leavemanagement/lm-db-service/internal/tracing"
+	"leavemanagement/lm-db-service/pkg/pb"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// sandwichWindow bounds how far from an application the weekends and holidays
+// sandwiched between it and the leave next to it are looked for.
+const sandwichWindow = 15 * 24 * time.Hour
+
+// checkLeavePolicy returns the policy of leaveTypeId and the days an
+// application of it from fromDate to toDate counts as leave, or a
+// *policy.ViolationError listing every eligibility and policy rule of the
+// leave type it breaks. applicationId is the application being changed, empty for a new one.
+func (d MysqlDB) checkLeavePolicy(ctx context.Context, employeeId, leaveTypeId, applicationId, fromDate, toDate string) (policy.Policy, policy.Duration, error) {
+	leavePolicy, err := d.getLeavePolicy(ctx, leaveTypeId)
+	if err != nil {
+		return policy.Policy{}, policy.Duration{}, err
+	}
+	startDate, err := time.Parse(dateFormat, fromDate)
+	if err != nil {
+		return policy.Policy{}, policy.Duration{}, errors.New("write date in YYYY-MM-DD format")
+	}
+	endDate, err := time.Parse(dateFormat, toDate)
+	if err != nil {
+		return policy.Policy{}, policy.Duration{}, errors.New("write date in YYYY-MM-DD format")
+	}
+	today, _ := time.Parse(dateFormat, time.Now().Format(dateFormat))
+	application := policy.Application{
+		Period:    policy.Period{FromDate: startDate, ToDate: endDate},
+		AppliedOn: today,
+	}
+	if leavePolicy.Restricted() {
+		application.Employee, err = d.getEmployeeProfile(ctx, employeeId)
+		if err != nil {
+			return policy.Policy{}, policy.Duration{}, err
+		}
+	}
+	var window time.Duration
+	if leavePolicy.MaxConsecutiveDays != nil {
+		// Leave further away than the limit cannot make the run any longer
+		// than the limit allows without what lies in between.
+		window = time.Duration(*leavePolicy.MaxConsecutiveDays+1) * 24 * time.Hour
+	}
+	if leavePolicy.Sandwich && window < sandwichWindow {
+		window = sandwichWindow
+	}
+	if window > 0 && !endDate.Before(startDate) {
+		if applicationId == "" {
+			applicationId = "0"
+		}
+		application.Others, err = d.getOtherLeaves(ctx, employeeId, leaveTypeId, applicationId,
+			startDate.Add(-window).Format(dateFormat), endDate.Add(window).Format(dateFormat))
+		if err != nil {
+			return policy.Policy{}, policy.Duration{}, err
+		}
+	}
+	if violations := policy.Evaluate(leavePolicy, application); len(violations) > 0 {
+		return policy.Policy{}, policy.Duration{}, &policy.ViolationError{Violations: violations}
+	}
+
+	if !leavePolicy.Sandwich {
+		window = 0
+	}
+	holidays, err := d.getHolidays(ctx, startDate.Add(-window).Format(dateFormat), endDate.Add(window).Format(dateFormat))
+	if err != nil {
+		return policy.Policy{}, policy.Duration{}, err
+	}
+	duration := policy.CountDays(leavePolicy, application, holidays)
+	if duration.Counted() == 0 {
+		return policy.Policy{}, policy.Duration{}, errors.New("leave has no working days")
+	}
+	return leavePolicy, duration, nil
+}
+
+// leaveDuration converts a duration for responses.
+func leaveDuration(duration policy.Duration) *pb.LeaveDuration {
+	leaveDuration := &pb.LeaveDuration{NoOfDays: strconv.Itoa(duration.Counted())}
+	for _, day := range duration.Days {
+		leaveDuration.Days = append(leaveDuration.Days, &pb.LeaveDay{
+			Date:    day.Date.Format(dateFormat),
+			Kind:    day.Kind,
+			Counted: day.Counted,
+		})
+	}
+	return leaveDuration
+}
+
+// leavePolicyColumns are the policy, eligibility and comp-off settings of
+// lm_leave_type read by scanLeavePolicy.
+const leavePolicyColumns = `
+						min_notice_days,
+						max_consecutive_days,
+						min_days_per_request,
+						max_days_per_request,
+						allow_backdated,
+						sandwich,
+						working_days,
+						eligible_gender,
+						eligible_designation_ids,
+						min_tenure_days,
+						eligible_employment_types,
+						probation_excluded,
+						comp_off_validity_days`
+
+// scanLeavePolicy scans leavePolicyColumns after the columns read into dest.
+func scanLeavePolicy(scan func(dest ...interface{}) error, dest ...interface{}) (policy.Policy, error) {
+	var minNotice, maxConsecutive, minDays, maxDays, minTenure, compOffValidity sql.NullInt64
+	var gender sql.NullString
+	var designationIds, employmentTypes string
+	var leavePolicy policy.Policy
+	err := scan(append(dest, &minNotice, &maxConsecutive, &minDays, &maxDays, &leavePolicy.AllowBackdated,
+		&leavePolicy.Sandwich, &leavePolicy.WorkingDays, &gender, &designationIds, &minTenure, &employmentTypes, &leavePolicy.ExcludeProbation, &compOffValidity)...)
+	if err != nil {
+		return policy.Policy{}, err
+	}
+	leavePolicy.MinNoticeDays = nullableDays(minNotice)
+	leavePolicy.MaxConsecutiveDays = nullableDays(maxConsecutive)
+	leavePolicy.MinDaysPerRequest = nullableDays(minDays)
+	leavePolicy.MaxDaysPerRequest = nullableDays(maxDays)
+	leavePolicy.MinTenureDays = nullableDays(minTenure)
+	leavePolicy.CompOffValidityDays = nullableDays(compOffValidity)
+	if gender.Valid {
+		leavePolicy.Gender = &gender.String
+	}
+	if designationIds != "" {
+		leavePolicy.DesignationIds = strings.Split(designationIds, ",")
+	}
+	if employmentTypes != "" {
+		leavePolicy.EmploymentTypes = strings.Split(employmentTypes, ",")
+	}
+	return leavePolicy, nil
+}
+func (d MysqlDB) getLeavePolicy(ctx context.Context, leaveTypeId string) (policy.Policy, error) {
+	leavePolicyQuery := `SELECT` + leavePolicyColumns + ` FROM lm_leave_type WHERE leave_type_id=?`
+	ctx, span, end := d.startQuery(ctx, "leavePolicy", "SELECT", "lm_leave_type")
+	defer end()
+	leavePolicy, err := scanLeavePolicy(d.DB.QueryRowContext(ctx, leavePolicyQuery, leaveTypeId).Scan)
+	if err != nil {
+		tracing.RecordError(span, err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return policy.Policy{}, errors.New("leave type not found")
+		}
+		return policy.Policy{}, err
+	}
+	return leavePolicy, nil
+}
+func nullableDays(value sql.NullInt64) *int {
+	if !value.Valid {
+		return nil
+	}
+	days := int(value.Int64)
+	return &days
+}
+
+// getOtherLeaves returns the other applications of the employee for
+// leaveTypeId overlapping fromDate to toDate, leaving out declined and
+// deleted ones and applicationId.
+func (d MysqlDB) getOtherLeaves(ctx context.Context, employeeId, leaveTypeId, applicationId, fromDate, toDate string) ([]policy.Leave, error) {
+	otherLeavesQuery := `
+					SELECT
+						from_date,
+						to_date,
+						sandwich_before,
+						sandwich_after
+					FROM lm_leave_application
+					WHERE employee_id=?
+						AND leave_type_id=?
+						AND leave_status<>?
+						AND deleted_at IS NULL
+						AND application_id<>?
+						AND to_date>=?
+						AND from_date<=?`
+	ctx, span, end := d.startQuery(ctx, "otherLeaves", "SELECT", "lm_leave_application")
+	defer end()
+	rows, err := d.DB.QueryContext(ctx, otherLeavesQuery, employeeId, leaveTypeId, declined, applicationId, fromDate, toDate)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	defer rows.Close()
+	var leaves []policy.Leave
+	for rows.Next() {
+		var from, to string
+		var leave policy.Leave
+		err = rows.Scan(&from, &to, &leave.SandwichBefore, &leave.SandwichAfter)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return nil, err
+		}
+		leave.FromDate, _ = time.Parse(dateFormat, dateOnly(from))
+		leave.ToDate, _ = time.Parse(dateFormat, dateOnly(to))
+		leaves = append(leaves, leave)
+	}
+	if err = rows.Err(); err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	return leaves, nil
+}
@@ -0,0 +1,198 @@
+package database
+
+import (
+	"context"
+	"leavemanagement/lm-db-service/internal/policy"
+	"leavemanagement/lm-db-service/pkg/pb"
+	"reflect"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+var policyColumns = []string{"min_notice_days", "max_consecutive_days", "min_days_per_request", "max_days_per_request", "allow_backdated", "sandwich",
+	"working_days", "eligible_gender", "eligible_designation_ids", "min_tenure_days", "eligible_employment_types", "probation_excluded",
+	"comp_off_validity_days"}
+
+const leavePolicyQuery = `SELECT\s+min_notice_days,`
+
+var otherLeaveColumns = []string{"from_date", "to_date", "sandwich_before", "sandwich_after"}
+
+const holidaysQuery = `FROM lm_holiday WHERE holiday_date BETWEEN \? AND \?`
+
+// expectLeavePolicy expects the policy lookup of leaveTypeId to find no
+// limits, back dating allowed and no sandwich rule, and the holiday lookup
+// from fromDate to toDate to find none.
+func expectLeavePolicy(mock sqlmock.Sqlmock, leaveTypeId, fromDate, toDate string) {
+	mock.ExpectQuery(leavePolicyQuery).WithArgs(leaveTypeId).
+		WillReturnRows(sqlmock.NewRows(policyColumns).AddRow(nil, nil, nil, nil, true, false, true, nil, "", nil, "", false, nil))
+	mock.ExpectQuery(holidaysQuery).WithArgs(fromDate, toDate).
+		WillReturnRows(sqlmock.NewRows([]string{"holiday_date", "name"}))
+}
+
+func TestMySqlMock_ApplyLeavePolicy(t *testing.T) {
+	today := time.Now()
+	tests := []struct {
+		description string
+		fromDate    string
+		toDate      string
+		expect      func(mock sqlmock.Sqlmock)
+		expected    []string
+	}{
+		{
+			description: "to date before from date",
+			fromDate:    today.AddDate(0, 0, 30).Format(dateFormat),
+			toDate:      today.AddDate(0, 0, 28).Format(dateFormat),
+			expected:    []string{policy.RuleDateOrder},
+		},
+		{
+			description: "short notice, too long and back to back",
+			fromDate:    today.AddDate(0, 0, 3).Format(dateFormat),
+			toDate:      today.AddDate(0, 0, 14).Format(dateFormat),
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`WHERE employee_id=\? AND leave_type_id=\? AND leave_status<>\? AND deleted_at IS NULL AND application_id<>\?`).
+					WithArgs("1", "2", declined, "0", today.AddDate(0, 0, -13).Format(dateFormat), today.AddDate(0, 0, 30).Format(dateFormat)).
+					WillReturnRows(sqlmock.NewRows(otherLeaveColumns).
+						AddRow(today.AddDate(0, 0, 15).Format(dateFormat), today.AddDate(0, 0, 20).Format(dateFormat), 0, 0))
+			},
+			expected: []string{policy.RuleMinNotice, policy.RuleMaxDaysPerRequest, policy.RuleMaxConsecutiveDays},
+		},
+		{
+			description: "back dated",
+			fromDate:    today.AddDate(0, 0, -2).Format(dateFormat),
+			toDate:      today.AddDate(0, 0, -1).Format(dateFormat),
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`application_id<>\?`).WillReturnRows(sqlmock.NewRows(otherLeaveColumns))
+			},
+			expected: []string{policy.RuleBackdating, policy.RuleMinNotice},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			testDB, mock := getTestMysqlDB(t)
+			mock.ExpectQuery(leavePolicyQuery).WithArgs("2").
+				WillReturnRows(sqlmock.NewRows(policyColumns).AddRow(14, 15, nil, 10, false, false, true, nil, "", nil, "", false, nil))
+			if test.expect != nil {
+				test.expect(mock)
+			}
+			_, err := testDB.ApplyLeave(context.Background(), &pb.ApplyLeaveRequest{
+				EmployeeId:  "1",
+				LeaveTypeId: "2",
+				FromDate:    test.fromDate,
+				ToDate:      test.toDate,
+				Comment:     "Vacation",
+			})
+			if status.Code(err) != codes.FailedPrecondition {
+				t.Errorf("got error %v: want error: %v", err, codes.FailedPrecondition)
+			}
+			violationErr, ok := err.(*policy.ViolationError)
+			if !ok {
+				t.Fatalf("got error %v: want error: %v", err, "ViolationError")
+			}
+			var actual []string
+			for _, violation := range violationErr.Violations {
+				actual = append(actual, violation.Rule)
+			}
+			if !reflect.DeepEqual(actual, test.expected) {
+				t.Errorf("expected %v: got %v", test.expected, actual)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestMySqlMock_ApplyLeaveSandwich(t *testing.T) {
+	testDB, mock := getTestMysqlDB(t)
+	mock.ExpectQuery(leavePolicyQuery).WithArgs("2").
+		WillReturnRows(sqlmock.NewRows(policyColumns).AddRow(nil, nil, nil, nil, true, true, true, nil, "", nil, "", false, nil))
+	mock.ExpectQuery(`application_id<>\?`).
+		WithArgs("1", "2", declined, "0", "2022-04-10", "2022-05-10").
+		WillReturnRows(sqlmock.NewRows(otherLeaveColumns).AddRow("2022-04-22T00:00:00+05:30", "2022-04-22T00:00:00+05:30", 0, 0))
+	mock.ExpectQuery(holidaysQuery).WithArgs("2022-04-10", "2022-05-10").
+		WillReturnRows(sqlmock.NewRows([]string{"holiday_date", "name"}))
+	expectBlackoutPeriods(mock, "1", "2", "2022-04-25", "2022-04-25")
+	mock.ExpectBegin()
+	expectLockEmployee(mock, "1")
+	expectAllowedDays(mock, "1", "2", 10)
+	mock.ExpectQuery(`SELECT IFNULL\(SUM\(IF\(from_date>=\?, no_of_days-loss_of_pay_days, advance_days\)\),0\)`).WithArgs("2022-01-01", "1", "2", declined, "2021-01-01", "2022-12-31").
+		WillReturnRows(sqlmock.NewRows([]string{"no_of_days"}).AddRow("1"))
+	expectAdvancesBefore(mock, "1", "2", "2021-01-01")
+	expectEncashedDays(mock, "1", "2", "2022", 0)
+	expectAdjustedDays(mock, "1", "2", "2022", 0)
+	expectCoverageRules(mock, "1")
+	mock.ExpectExec(`INSERT INTO lm_leave_application`).
+		WithArgs("1", 2, time.Now().Format(dateTimeFormat), "2022-04-25", "2022-04-25", 3, 6, "Vacation", 2, 0, 0, 0).
+		WillReturnResult(sqlmock.NewResult(4, 1))
+	expectLeaveSnapshot(mock, "4", "1", pending)
+	expectAuditEvent(mock, "4", "1", "1", auditApply)
+	mock.ExpectCommit()
+	got, err := testDB.ApplyLeave(context.Background(), &pb.ApplyLeaveRequest{
+		EmployeeId:  "1",
+		LeaveTypeId: "2",
+		FromDate:    "2022-04-25",
+		ToDate:      "2022-04-25",
+		Comment:     "Vacation",
+	})
+	if err != nil {
+		t.Fatalf("got error %v: want error: %v", err, false)
+	}
+	expected := &pb.LeaveDuration{NoOfDays: "3", Days: []*pb.LeaveDay{
+		{Date: "2022-04-23", Kind: policy.DayWeekend, Counted: true},
+		{Date: "2022-04-24", Kind: policy.DayWeekend, Counted: true},
+		{Date: "2022-04-25", Kind: policy.DayWorking, Counted: true},
+	}}
+	if !proto.Equal(got.Duration, expected) {
+		t.Errorf("expected %v: got %v", expected, got.Duration)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+// TestMySqlMock_ApplyLeaveCalendarDays pins the counting of a leave type
+// without working_days: the weekend inside the leave counts.
+func TestMySqlMock_ApplyLeaveCalendarDays(t *testing.T) {
+	testDB, mock := getTestMysqlDB(t)
+	mock.ExpectQuery(leavePolicyQuery).WithArgs("1").
+		WillReturnRows(sqlmock.NewRows(policyColumns).AddRow(nil, nil, nil, nil, true, false, false, nil, "", nil, "", false, nil))
+	mock.ExpectQuery(holidaysQuery).WithArgs("2022-04-22", "2022-04-25").
+		WillReturnRows(sqlmock.NewRows([]string{"holiday_date", "name"}))
+	expectBlackoutPeriods(mock, "1", "1", "2022-04-22", "2022-04-25")
+	mock.ExpectBegin()
+	expectLockEmployee(mock, "1")
+	expectAllowedDays(mock, "1", "1", 10)
+	mock.ExpectQuery(`SELECT IFNULL\(SUM\(IF\(from_date>=\?, no_of_days-loss_of_pay_days, advance_days\)\),0\)`).WithArgs("2022-01-01", "1", "1", declined, "2021-01-01", "2022-12-31").
+		WillReturnRows(sqlmock.NewRows([]string{"no_of_days"}).AddRow("1"))
+	expectAdvancesBefore(mock, "1", "1", "2021-01-01")
+	expectEncashedDays(mock, "1", "1", "2022", 0)
+	expectAdjustedDays(mock, "1", "1", "2022", 0)
+	expectCoverageRules(mock, "1")
+	mock.ExpectExec(`INSERT INTO lm_leave_application`).
+		WithArgs("1", 1, time.Now().Format(dateTimeFormat), "2022-04-22", "2022-04-25", 4, 5, "Vacation", 0, 0, 0, 0).
+		WillReturnResult(sqlmock.NewResult(4, 1))
+	expectLeaveSnapshot(mock, "4", "1", pending)
+	expectAuditEvent(mock, "4", "1", "1", auditApply)
+	mock.ExpectCommit()
+	got, err := testDB.ApplyLeave(context.Background(), &pb.ApplyLeaveRequest{
+		EmployeeId:  "1",
+		LeaveTypeId: "1",
+		FromDate:    "2022-04-22",
+		ToDate:      "2022-04-25",
+		Comment:     "Vacation",
+	})
+	if err != nil {
+		t.Fatalf("got error %v: want error: %v", err, false)
+	}
+	if got.Duration.NoOfDays != "4" {
+		t.Errorf("expected %v: got %v", 4, got.Duration.NoOfDays)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,228 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"leavemanagement/lm-db-service/internal/storage/validation"
+	"leavemanagement/lm-db-service/internal/tracing"
+	"leavemanagement/lm-db-service/models"
+	"leavemanagement/lm-db-service/pkg/pb"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator"
+)
+
+// RecordExit records the date an employee leaves and returns their final
+// settlement. Entitlements of the leave year the exit date falls in are
+// pro-rated up to it from then on. Only HR has access to it; recording
+// another date replaces the earlier one.
+func (d MysqlDB) RecordExit(ctx context.Context, req *pb.RecordExitRequest) (*pb.RecordExitResponse, error) {
+	validate := validator.New()
+	fields := models.ValidateRecordExit{
+		EmployeeId:       req.EmployeeId,
+		TargetEmployeeId: req.TargetEmployeeId,
+		ExitDate:         req.ExitDate,
+	}
+	err := validate.Struct(fields)
+	if err != nil {
+		return &pb.RecordExitResponse{}, errors.New("invalid input")
+	}
+	err = validation.ValidateFromDate(req.ExitDate)
+	if err != nil {
+		return &pb.RecordExitResponse{}, err
+	}
+	exitDate, err := time.Parse(dateFormat, req.ExitDate)
+	if err != nil {
+		return &pb.RecordExitResponse{}, errors.New("write date in YYYY-MM-DD format")
+	}
+
+	designationId, err := d.getDesignationId(ctx, req.EmployeeId)
+	if err != nil {
+		return &pb.RecordExitResponse{}, err
+	}
+	if designationId != hrId {
+		return &pb.RecordExitResponse{}, errors.New("access denied")
+	}
+	joining, _, err := d.getServiceDates(ctx, req.TargetEmployeeId)
+	if err != nil {
+		return &pb.RecordExitResponse{}, err
+	}
+	if joining != nil && exitDate.Before(*joining) {
+		return &pb.RecordExitResponse{}, errors.New("exit date before joining date")
+	}
+
+	err = d.withTx(ctx, func(tx *sql.Tx) error {
+		return d.setExitDate(ctx, tx, req.EmployeeId, req.TargetEmployeeId, req.ExitDate)
+	})
+	if err != nil {
+		return &pb.RecordExitResponse{}, err
+	}
+
+	settlement, err := d.finalSettlement(ctx, req.TargetEmployeeId, exitDate)
+	if err != nil {
+		return &pb.RecordExitResponse{}, err
+	}
+	return &pb.RecordExitResponse{Settlement: settlement}, nil
+}
+
+// exitSnapshot is the exit date of an employee as recorded in the audit
+// trail.
+type exitSnapshot struct {
+	EmployeeId string `json:"employeeId"`
+	DateOfExit string `json:"dateOfExit,omitempty"`
+}
+
+// setExitDate records the date an employee leaves inside tx, with the date it
+// replaces in the audit trail.
+func (d MysqlDB) setExitDate(ctx context.Context, tx *sql.Tx, actorId, employeeId, exitDate string) error {
+	before := &exitSnapshot{EmployeeId: employeeId}
+	currentExitQuery := `SELECT IFNULL(date_of_exit,"") FROM lm_employee WHERE employee_id=? FOR UPDATE`
+	queryCtx, span, end := d.startQuery(ctx, "currentExitDate", "SELECT", "lm_employee")
+	err := tx.QueryRowContext(queryCtx, currentExitQuery, employeeId).Scan(&before.DateOfExit)
+	end()
+	if err != nil {
+		tracing.RecordError(span, err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("employee not found")
+		}
+		return err
+	}
+	before.DateOfExit = dateOnly(before.DateOfExit)
+
+	exitDateQuery := `UPDATE lm_employee SET date_of_exit=? WHERE employee_id=?`
+	execCtx, span, end := d.startQuery(ctx, "exitDate", "UPDATE", "lm_employee")
+	defer end()
+	result, err := tx.ExecContext(execCtx, exitDateQuery, exitDate, employeeId)
+	tracing.RecordResult(span, result)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return err
+	}
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	// MySQL counts no row when the date is the one already recorded.
+	if updated == 0 && before.DateOfExit != exitDate {
+		return errors.New("exit date not recorded")
+	}
+	after := &exitSnapshot{EmployeeId: employeeId, DateOfExit: exitDate}
+	return d.appendRecordAuditEvent(ctx, tx, auditSubjectEmployee, employeeId, employeeId, actorId, auditExit, before, after)
+}
+
+// GetFinalSettlement returns the final settlement of an employee who has
+// left, for HR to review: for every leave type, the days earned up to the
+// exit date against those taken. Only HR has access to it.
+func (d MysqlDB) GetFinalSettlement(ctx context.Context, req *pb.GetFinalSettlementRequest) (*pb.GetFinalSettlementResponse, error) {
+	validate := validator.New()
+	fields := models.ValidateGetFinalSettlement{
+		EmployeeId:       req.EmployeeId,
+		TargetEmployeeId: req.TargetEmployeeId,
+	}
+	err := validate.Struct(fields)
+	if err != nil {
+		return &pb.GetFinalSettlementResponse{}, errors.New("invalid input")
+	}
+
+	designationId, err := d.getDesignationId(ctx, req.EmployeeId)
+	if err != nil {
+		return &pb.GetFinalSettlementResponse{}, err
+	}
+	if designationId != hrId {
+		return &pb.GetFinalSettlementResponse{}, errors.New("access denied")
+	}
+	_, exit, err := d.getServiceDates(ctx, req.TargetEmployeeId)
+	if err != nil {
+		return &pb.GetFinalSettlementResponse{}, err
+	}
+	if exit == nil {
+		return &pb.GetFinalSettlementResponse{}, errors.New("employee has not exited")
+	}
+
+	settlement, err := d.finalSettlement(ctx, req.TargetEmployeeId, *exit)
+	if err != nil {
+		return &pb.GetFinalSettlementResponse{}, err
+	}
+	return &pb.GetFinalSettlementResponse{Settlement: settlement}, nil
+}
+
+// finalSettlement works out the final settlement of an employee who left on
+// exitDate from their balances for the leave year it falls in. Approved
+// applications starting by the exit date count as taken whether or not they
+// have ended; those starting after it are left out and reported for HR to
+// cancel, as pending ones are reported for HR to decide on before settling.
+func (d MysqlDB) finalSettlement(ctx context.Context, employeeId string, exitDate time.Time) (*pb.FinalSettlement, error) {
+	year := strconv.Itoa(exitDate.Year())
+	balances, err := d.leaveBalances(ctx, employeeId, year)
+	if err != nil {
+		return nil, err
+	}
+	afterExit, err := d.getLeaveAfterExit(ctx, employeeId, exitDate)
+	if err != nil {
+		return nil, err
+	}
+	settlement := &pb.FinalSettlement{
+		EmployeeId: employeeId,
+		ExitDate:   exitDate.Format(dateFormat),
+		Year:       year,
+	}
+	for _, balance := range balances {
+		afterExitDays := afterExit[balance.leaveTypeId]
+		taken := balance.taken + balance.scheduled - afterExitDays
+		settlement.Items = append(settlement.Items, &pb.SettlementItem{
+			LeaveTypeId:      balance.leaveTypeId,
+			LeaveName:        balance.leaveName,
+			Earned:           strconv.Itoa(balance.entitlement),
+			Adjusted:         strconv.Itoa(balance.adjusted),
+			AdvanceRecovered: strconv.Itoa(balance.advanceRecovered),
+			Taken:            strconv.Itoa(taken),
+			Pending:          strconv.Itoa(balance.pending),
+			Encashed:         strconv.Itoa(balance.encashed),
+			LossOfPay:        strconv.Itoa(balance.lossOfPay),
+			Balance:          strconv.Itoa(balance.available() + balance.pending + afterExitDays),
+			AfterExit:        strconv.Itoa(afterExitDays),
+		})
+	}
+	return settlement, nil
+}
+
+// getLeaveAfterExit returns, by leave type, the paid days of the approved
+// applications of an employee starting after exitDate in its leave year.
+func (d MysqlDB) getLeaveAfterExit(ctx context.Context, employeeId string, exitDate time.Time) (map[string]int, error) {
+	leaveAfterExitQuery := `
+					SELECT leave_type_id, SUM(no_of_days-loss_of_pay_days) 
+					FROM lm_leave_application 
+					WHERE employee_id=? 
+						AND leave_status=? 
+						AND deleted_at IS NULL 
+						AND from_date>? 
+						AND from_date<=? 
+					GROUP BY leave_type_id`
+	_, yearEnd := leaveYear(exitDate.Format(dateFormat))
+	ctx, span, end := d.startQuery(ctx, "leaveAfterExit", "SELECT", "lm_leave_application")
+	defer end()
+	rows, err := d.DB.QueryContext(ctx, leaveAfterExitQuery, employeeId, approved, exitDate.Format(dateFormat), yearEnd)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	defer rows.Close()
+	afterExit := map[string]int{}
+	for rows.Next() {
+		var leaveTypeId string
+		var days int
+		err = rows.Scan(&leaveTypeId, &days)
+		if err != nil {
+			tracing.RecordError(span, err)
+			return nil, err
+		}
+		afterExit[leaveTypeId] = days
+	}
+	if err = rows.Err(); err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	return afterExit, nil
+}
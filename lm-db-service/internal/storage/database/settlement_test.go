@@ -0,0 +1,308 @@
+package database
+
+import (
+	"context"
+	"database/sql/driver"
+	"leavemanagement/lm-db-service/pkg/pb"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"google.golang.org/protobuf/proto"
+)
+
+// expectAllowedDays expects the lookup of the yearly allowance of leaveTypeId
+// for an employee in service the whole leave year.
+func expectAllowedDays(mock sqlmock.Sqlmock, employeeId, leaveTypeId string, allowed int) {
+	mock.ExpectQuery(`SELECT number_of_days_allowed, proration_rounding, date_of_joining, date_of_exit`).
+		WithArgs(employeeId, leaveTypeId).
+		WillReturnRows(sqlmock.NewRows([]string{"number_of_days_allowed", "proration_rounding", "date_of_joining", "date_of_exit"}).
+			AddRow(allowed, "NEAREST", nil, nil))
+}
+
+// expectServiceDates expects the lookup of the joining and exit dates of
+// employeeId.
+func expectServiceDates(mock sqlmock.Sqlmock, employeeId string, joining, exit interface{}) {
+	mock.ExpectQuery(`SELECT date_of_joining, date_of_exit FROM lm_employee WHERE employee_id=\?`).WithArgs(employeeId).
+		WillReturnRows(sqlmock.NewRows([]string{"date_of_joining", "date_of_exit"}).AddRow(joining, exit))
+}
+
+// expectSettlementBalances expects the balances of employee 1 for 2022 that
+// the settlement tests work from, and their approved leave after the exit
+// date of 2022-06-30 as rows of leave type and days.
+func expectSettlementBalances(mock sqlmock.Sqlmock, afterExit ...[]driver.Value) {
+	mock.ExpectQuery(`FROM lm_leave_type\s+LEFT JOIN lm_leave_application`).
+		WillReturnRows(sqlmock.NewRows(leaveBalanceColumns).
+			AddRow("1", "Sick", "NEAREST", 12, 5, 1, 1, 0, 0, 0, 0, 0).
+			AddRow("2", "Casual", "DOWN", 10, 2, 0, 0, 1, 0, 0, 0, 1).
+			AddRow("6", "Comp-off", "NONE", 2, 1, 0, 0, 0, 0, 0, 0, 0))
+	for _, leaveTypeId := range []string{"1", "2", "6"} {
+		expectAdvancesBefore(mock, "1", leaveTypeId, "2021-01-01")
+	}
+	rows := sqlmock.NewRows([]string{"leave_type_id", "days"})
+	for _, row := range afterExit {
+		rows.AddRow(row...)
+	}
+	mock.ExpectQuery(`SELECT leave_type_id, SUM\(no_of_days-loss_of_pay_days\)\s+FROM lm_leave_application`).
+		WithArgs("1", approved, "2022-06-30", "2022-12-31").
+		WillReturnRows(rows)
+}
+
+var settlement = &pb.FinalSettlement{
+	EmployeeId: "1",
+	ExitDate:   "2022-06-30",
+	Year:       "2022",
+	Items: []*pb.SettlementItem{
+		{LeaveTypeId: "1", LeaveName: "Sick", Earned: "6", Adjusted: "0", AdvanceRecovered: "0", Taken: "6", Pending: "1",
+			Encashed: "0", LossOfPay: "0", Balance: "0", AfterExit: "0"},
+		{LeaveTypeId: "2", LeaveName: "Casual", Earned: "4", Adjusted: "1", AdvanceRecovered: "0", Taken: "2", Pending: "0",
+			Encashed: "1", LossOfPay: "0", Balance: "2", AfterExit: "0"},
+		{LeaveTypeId: "6", LeaveName: "Comp-off", Earned: "2", Adjusted: "0", AdvanceRecovered: "0", Taken: "1", Pending: "0",
+			Encashed: "0", LossOfPay: "0", Balance: "1", AfterExit: "0"},
+	},
+}
+
+func TestMySqlMock_ApplyLeaveProRated(t *testing.T) {
+	testDB, mock := getTestMysqlDB(t)
+	expectLeavePolicy(mock, "1", "2022-10-20", "2022-10-21")
+	expectBlackoutPeriods(mock, "1", "1", "2022-10-20", "2022-10-21")
+	mock.ExpectBegin()
+	expectLockEmployee(mock, "1")
+	mock.ExpectQuery(`SELECT number_of_days_allowed, proration_rounding, date_of_joining, date_of_exit`).WithArgs("1", "1").
+		WillReturnRows(sqlmock.NewRows([]string{"number_of_days_allowed", "proration_rounding", "date_of_joining", "date_of_exit"}).
+			AddRow(12, "DOWN", "2022-07-01", nil))
+	mock.ExpectQuery(`SELECT IFNULL\(SUM\(IF\(from_date>=\?, no_of_days-loss_of_pay_days, advance_days\)\),0\)`).
+		WithArgs("2022-01-01", "1", "1", declined, "2021-01-01", "2022-12-31").
+		WillReturnRows(sqlmock.NewRows([]string{"no_of_days"}).AddRow(5))
+	expectAdvancesBefore(mock, "1", "1", "2021-01-01")
+	expectEncashedDays(mock, "1", "1", "2022", 0)
+	expectAdjustedDays(mock, "1", "1", "2022", 0)
+	expectNegativeBalanceLimit(mock, "1", 0)
+	mock.ExpectRollback()
+	_, err := testDB.ApplyLeave(context.Background(), &pb.ApplyLeaveRequest{
+		EmployeeId:  "1",
+		LeaveTypeId: "1",
+		FromDate:    "2022-10-20",
+		ToDate:      "2022-10-21",
+		Comment:     "Fever",
+	})
+	if err == nil || err.Error() != "leaves not remaining" {
+		t.Errorf("got error %v: want error: %v", err, "leaves not remaining")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMySqlMock_GetLeaveBalancesProRated(t *testing.T) {
+	testDB, mock := getTestMysqlDB(t)
+	expectServiceDates(mock, "1", "2022-07-01", nil)
+	mock.ExpectQuery(`FROM lm_leave_type\s+LEFT JOIN lm_leave_application`).
+		WillReturnRows(sqlmock.NewRows(leaveBalanceColumns).
+			AddRow("1", "Sick", "UP", 12, 1, 0, 0, 0, 0, 0, 0, 0).
+			AddRow("6", "Comp-off", "NONE", 2, 0, 0, 0, 0, 0, 0, 0, 0))
+	expectAdvancesBefore(mock, "1", "1", "2021-01-01")
+	expectAdvancesBefore(mock, "1", "6", "2021-01-01")
+	got, err := testDB.GetLeaveBalances(context.Background(), &pb.GetLeaveBalancesRequest{EmployeeId: "1", Year: "2022"})
+	if err != nil {
+		t.Errorf("got error %v: want error: %v", err, false)
+	}
+	for i, expected := range []string{"7", "2"} {
+		if got.LeaveBalances[i].Entitlement != expected {
+			t.Errorf("expected %v: got %v", expected, got.LeaveBalances[i].Entitlement)
+		}
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMySqlMock_RecordExit(t *testing.T) {
+	recordExitQuery := `UPDATE lm_employee SET date_of_exit=\? WHERE employee_id=\?`
+	tests := []struct {
+		description string
+		request     *pb.RecordExitRequest
+		expect      func(mock sqlmock.Sqlmock)
+		isError     string
+	}{
+		{
+			description: "success",
+			request:     &pb.RecordExitRequest{EmployeeId: "2", TargetEmployeeId: "1", ExitDate: "2022-06-30"},
+			expect: func(mock sqlmock.Sqlmock) {
+				expectDesignation(mock, "2", hrId)
+				expectServiceDates(mock, "1", "2020-03-01", nil)
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT IFNULL\(date_of_exit,""\) FROM lm_employee WHERE employee_id=\? FOR UPDATE`).WithArgs("1").
+					WillReturnRows(sqlmock.NewRows([]string{"date_of_exit"}).AddRow(""))
+				mock.ExpectExec(recordExitQuery).WithArgs("2022-06-30", "1").WillReturnResult(sqlmock.NewResult(0, 1))
+				expectRecordAuditEvent(mock, auditSubjectEmployee, "1", "1", "2", auditExit)
+				mock.ExpectCommit()
+				expectServiceDates(mock, "1", "2020-03-01", "2022-06-30")
+				expectSettlementBalances(mock)
+			},
+		},
+		{
+			description: "same date again",
+			request:     &pb.RecordExitRequest{EmployeeId: "2", TargetEmployeeId: "1", ExitDate: "2022-06-30"},
+			expect: func(mock sqlmock.Sqlmock) {
+				expectDesignation(mock, "2", hrId)
+				expectServiceDates(mock, "1", "2020-03-01", "2022-06-30")
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT IFNULL\(date_of_exit,""\) FROM lm_employee WHERE employee_id=\? FOR UPDATE`).WithArgs("1").
+					WillReturnRows(sqlmock.NewRows([]string{"date_of_exit"}).AddRow("2022-06-30"))
+				mock.ExpectExec(recordExitQuery).WithArgs("2022-06-30", "1").WillReturnResult(sqlmock.NewResult(0, 0))
+				expectRecordAuditEvent(mock, auditSubjectEmployee, "1", "1", "2", auditExit)
+				mock.ExpectCommit()
+				expectServiceDates(mock, "1", "2020-03-01", "2022-06-30")
+				expectSettlementBalances(mock)
+			},
+		},
+		{
+			description: "not recorded",
+			request:     &pb.RecordExitRequest{EmployeeId: "2", TargetEmployeeId: "1", ExitDate: "2022-06-30"},
+			expect: func(mock sqlmock.Sqlmock) {
+				expectDesignation(mock, "2", hrId)
+				expectServiceDates(mock, "1", "2020-03-01", nil)
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT IFNULL\(date_of_exit,""\) FROM lm_employee WHERE employee_id=\? FOR UPDATE`).WithArgs("1").
+					WillReturnRows(sqlmock.NewRows([]string{"date_of_exit"}).AddRow(""))
+				mock.ExpectExec(recordExitQuery).WithArgs("2022-06-30", "1").WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			isError: "exit date not recorded",
+		},
+		{
+			description: "before joining",
+			request:     &pb.RecordExitRequest{EmployeeId: "2", TargetEmployeeId: "1", ExitDate: "2022-06-30"},
+			expect: func(mock sqlmock.Sqlmock) {
+				expectDesignation(mock, "2", hrId)
+				expectServiceDates(mock, "1", "2022-07-01", nil)
+			},
+			isError: "exit date before joining date",
+		},
+		{
+			description: "unknown employee",
+			request:     &pb.RecordExitRequest{EmployeeId: "2", TargetEmployeeId: "9", ExitDate: "2022-06-30"},
+			expect: func(mock sqlmock.Sqlmock) {
+				expectDesignation(mock, "2", hrId)
+				mock.ExpectQuery(`SELECT date_of_joining, date_of_exit FROM lm_employee`).WithArgs("9").
+					WillReturnRows(sqlmock.NewRows([]string{"date_of_joining", "date_of_exit"}))
+			},
+			isError: "employee not found",
+		},
+		{
+			description: "not HR",
+			request:     &pb.RecordExitRequest{EmployeeId: "8", TargetEmployeeId: "1", ExitDate: "2022-06-30"},
+			expect: func(mock sqlmock.Sqlmock) {
+				expectDesignation(mock, "8", managerId)
+			},
+			isError: "access denied",
+		},
+		{
+			description: "invalid date",
+			request:     &pb.RecordExitRequest{EmployeeId: "2", TargetEmployeeId: "1", ExitDate: "30-06-2022"},
+			expect:      func(mock sqlmock.Sqlmock) {},
+			isError:     "write date in YYYY-MM-DD format",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			testDB, mock := getTestMysqlDB(t)
+			test.expect(mock)
+			got, err := testDB.RecordExit(context.Background(), test.request)
+			if test.isError != "" {
+				if err == nil || err.Error() != test.isError {
+					t.Errorf("got error %v: want error: %v", err, test.isError)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("got error %v: want error: %v", err, false)
+				}
+				if !proto.Equal(got.Settlement, settlement) {
+					t.Errorf("expected %v: got %v", settlement, got.Settlement)
+				}
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestMySqlMock_GetFinalSettlement(t *testing.T) {
+	tests := []struct {
+		description string
+		request     *pb.GetFinalSettlementRequest
+		expect      func(mock sqlmock.Sqlmock)
+		isError     string
+	}{
+		{
+			description: "success",
+			request:     &pb.GetFinalSettlementRequest{EmployeeId: "2", TargetEmployeeId: "1"},
+			expect: func(mock sqlmock.Sqlmock) {
+				expectDesignation(mock, "2", hrId)
+				expectServiceDates(mock, "1", "2020-03-01", "2022-06-30")
+				expectServiceDates(mock, "1", "2020-03-01", "2022-06-30")
+				expectSettlementBalances(mock)
+			},
+		},
+		{
+			description: "not exited",
+			request:     &pb.GetFinalSettlementRequest{EmployeeId: "2", TargetEmployeeId: "1"},
+			expect: func(mock sqlmock.Sqlmock) {
+				expectDesignation(mock, "2", hrId)
+				expectServiceDates(mock, "1", "2020-03-01", nil)
+			},
+			isError: "employee has not exited",
+		},
+		{
+			description: "not HR",
+			request:     &pb.GetFinalSettlementRequest{EmployeeId: "1", TargetEmployeeId: "1"},
+			expect: func(mock sqlmock.Sqlmock) {
+				expectDesignation(mock, "1", employeeId)
+			},
+			isError: "access denied",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			testDB, mock := getTestMysqlDB(t)
+			test.expect(mock)
+			got, err := testDB.GetFinalSettlement(context.Background(), test.request)
+			if test.isError != "" {
+				if err == nil || err.Error() != test.isError {
+					t.Errorf("got error %v: want error: %v", err, test.isError)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("got error %v: want error: %v", err, false)
+				}
+				if !proto.Equal(got.Settlement, settlement) {
+					t.Errorf("expected %v: got %v", settlement, got.Settlement)
+				}
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestMySqlMock_finalSettlementAfterExit(t *testing.T) {
+	testDB, mock := getTestMysqlDB(t)
+	expectServiceDates(mock, "1", "2020-03-01", "2022-06-30")
+	expectSettlementBalances(mock, []driver.Value{"2", 1})
+	exitDate, _ := time.Parse(dateFormat, "2022-06-30")
+	got, err := testDB.finalSettlement(context.Background(), "1", exitDate)
+	if err != nil {
+		t.Errorf("got error %v: want error: %v", err, false)
+	}
+	expected := &pb.SettlementItem{LeaveTypeId: "2", LeaveName: "Casual", Earned: "4", Adjusted: "1", AdvanceRecovered: "0", Taken: "1",
+		Pending: "0", Encashed: "1", LossOfPay: "0", Balance: "3", AfterExit: "1"}
+	if len(got.GetItems()) != 3 || !proto.Equal(got.Items[1], expected) {
+		t.Errorf("expected %v: got %v", expected, got.GetItems())
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,164 @@
+package tlsconfig
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Reloader serves the server certificate and the client CA pool from files on
+// disk and picks up replaced files without restarting the listener.
+type Reloader struct {
+	CertFile string
+	KeyFile  string
+	// ClientCAFile enables mutual TLS when set. Client certificates are
+	// verified against it.
+	ClientCAFile string
+	// RequireClientCert rejects clients that do not present a certificate.
+	// Without it a certificate is verified only when one is sent.
+	RequireClientCert bool
+	Logger            *zap.Logger
+
+	mu        sync.RWMutex
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+	modTimes  map[string]time.Time
+}
+
+func NewReloader(certFile, keyFile, clientCAFile string, requireClientCert bool, logger *zap.Logger) (*Reloader, error) {
+	if certFile == "" || keyFile == "" {
+		return nil, errors.New("tls certificate and key files are required")
+	}
+	if requireClientCert && clientCAFile == "" {
+		return nil, errors.New("client CA file is required to verify client certificates")
+	}
+	reloader := &Reloader{
+		CertFile:          certFile,
+		KeyFile:           keyFile,
+		ClientCAFile:      clientCAFile,
+		RequireClientCert: requireClientCert,
+		Logger:            logger,
+	}
+	if err := reloader.Reload(); err != nil {
+		return nil, err
+	}
+	return reloader, nil
+}
+
+// Reload reads the certificate, key and client CA files. The previous
+// material stays in use when any of them fails to load.
+func (r *Reloader) Reload() error {
+	modTimes, err := r.statFiles()
+	if err != nil {
+		return err
+	}
+	cert, err := tls.LoadX509KeyPair(r.CertFile, r.KeyFile)
+	if err != nil {
+		return fmt.Errorf("could not load tls key pair: %w", err)
+	}
+	var clientCAs *x509.CertPool
+	if r.ClientCAFile != "" {
+		pem, err := os.ReadFile(r.ClientCAFile)
+		if err != nil {
+			return fmt.Errorf("could not read client CA file: %w", err)
+		}
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(pem) {
+			return errors.New("no certificates found in client CA file")
+		}
+	}
+	r.mu.Lock()
+	r.cert = &cert
+	r.clientCAs = clientCAs
+	r.modTimes = modTimes
+	r.mu.Unlock()
+	return nil
+}
+
+// Watch polls the files every interval and reloads them when any of them
+// changed, until ctx is done.
+func (r *Reloader) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !r.changed() {
+				continue
+			}
+			if err := r.Reload(); err != nil {
+				r.Logger.Error("failed to reload tls certificates", zap.Error(err))
+				continue
+			}
+			r.Logger.Info("reloaded tls certificates")
+		}
+	}
+}
+
+// TLSConfig returns a server config that resolves the certificate and client
+// CA pool on every handshake, so reloads apply to new connections.
+func (r *Reloader) TLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.mu.RLock()
+			defer r.mu.RUnlock()
+			config := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*r.cert},
+			}
+			if r.clientCAs != nil {
+				config.ClientCAs = r.clientCAs
+				config.ClientAuth = tls.VerifyClientCertIfGiven
+				if r.RequireClientCert {
+					config.ClientAuth = tls.RequireAndVerifyClientCert
+				}
+			}
+			return config, nil
+		},
+	}
+}
+
+func (r *Reloader) files() []string {
+	files := []string{r.CertFile, r.KeyFile}
+	if r.ClientCAFile != "" {
+		files = append(files, r.ClientCAFile)
+	}
+	return files
+}
+
+func (r *Reloader) statFiles() (map[string]time.Time, error) {
+	modTimes := make(map[string]time.Time)
+	for _, file := range r.files() {
+		info, err := os.Stat(file)
+		if err != nil {
+			return nil, err
+		}
+		modTimes[file] = info.ModTime()
+	}
+	return modTimes, nil
+}
+
+func (r *Reloader) changed() bool {
+	modTimes, err := r.statFiles()
+	if err != nil {
+		return false
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for file, modTime := range modTimes {
+		if !modTime.Equal(r.modTimes[file]) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,216 @@
+package tlsconfig
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type testCert struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM []byte
+	keyPEM  []byte
+}
+
+func newTestCert(t *testing.T, commonName string, parent *testCert, isCA bool) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	if isCA {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+	}
+	signer, signerKey := template, key
+	if parent != nil {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCert{
+		cert:    cert,
+		key:     key,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		keyPEM:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+	}
+}
+
+func writeFile(t *testing.T, path string, data []byte) {
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// handshake connects to a TLS listener using config and returns the common
+// name of the server certificate.
+func handshake(t *testing.T, server *tls.Config, client *tls.Config) (string, error) {
+	lis, err := tls.Listen("tcp", "127.0.0.1:0", server)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lis.Close()
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		if err := conn.(*tls.Conn).Handshake(); err == nil {
+			conn.Write([]byte{1})
+		}
+	}()
+	conn, err := tls.Dial("tcp", lis.Addr().String(), client)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+	// With TLS 1.3 a rejected client certificate surfaces on the first read.
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if _, err := conn.Read(make([]byte, 1)); err != nil {
+		return "", err
+	}
+	return conn.ConnectionState().PeerCertificates[0].Subject.CommonName, nil
+}
+
+func TestReloader(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCert(t, "test-ca", nil, true)
+	server := newTestCert(t, "server-1", ca, false)
+	client := newTestCert(t, "client", ca, false)
+	certFile := filepath.Join(dir, "server.crt")
+	keyFile := filepath.Join(dir, "server.key")
+	caFile := filepath.Join(dir, "ca.crt")
+	writeFile(t, certFile, server.certPEM)
+	writeFile(t, keyFile, server.keyPEM)
+	writeFile(t, caFile, ca.certPEM)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	clientCert, err := tls.X509KeyPair(client.certPEM, client.keyPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		description       string
+		clientCAFile      string
+		requireClientCert bool
+		clientCerts       []tls.Certificate
+		isError           bool
+	}{
+		{
+			description: "server tls",
+		},
+		{
+			description:       "mutual tls",
+			clientCAFile:      caFile,
+			requireClientCert: true,
+			clientCerts:       []tls.Certificate{clientCert},
+		},
+		{
+			description:       "missing client certificate",
+			clientCAFile:      caFile,
+			requireClientCert: true,
+			isError:           true,
+		},
+		{
+			description:  "optional client certificate",
+			clientCAFile: caFile,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			reloader, err := NewReloader(certFile, keyFile, test.clientCAFile, test.requireClientCert, zap.NewNop())
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = handshake(t, reloader.TLSConfig(), &tls.Config{
+				RootCAs:      roots,
+				Certificates: test.clientCerts,
+				ServerName:   "127.0.0.1",
+			})
+			if test.isError == false && err != nil {
+				t.Errorf("got error %v: want error: %v", err, false)
+			}
+			if test.isError == true && err == nil {
+				t.Errorf("got error %v: want error: %v", err, true)
+			}
+		})
+	}
+
+	t.Run("reload", func(t *testing.T) {
+		reloader, err := NewReloader(certFile, keyFile, "", false, zap.NewNop())
+		if err != nil {
+			t.Fatal(err)
+		}
+		config := reloader.TLSConfig()
+		clientConfig := &tls.Config{RootCAs: roots, ServerName: "127.0.0.1"}
+		if name, err := handshake(t, config, clientConfig); err != nil || name != "server-1" {
+			t.Fatalf("expected %v: got %v, %v", "server-1", name, err)
+		}
+		rotated := newTestCert(t, "server-2", ca, false)
+		writeFile(t, certFile, rotated.certPEM)
+		writeFile(t, keyFile, rotated.keyPEM)
+		future := time.Now().Add(time.Minute)
+		os.Chtimes(certFile, future, future)
+		if !reloader.changed() {
+			t.Fatal("expected certificate change to be detected")
+		}
+		if err := reloader.Reload(); err != nil {
+			t.Fatal(err)
+		}
+		if name, err := handshake(t, config, clientConfig); err != nil || name != "server-2" {
+			t.Errorf("expected %v: got %v, %v", "server-2", name, err)
+		}
+	})
+
+	t.Run("invalid key pair keeps previous certificate", func(t *testing.T) {
+		reloader, err := NewReloader(certFile, keyFile, "", false, zap.NewNop())
+		if err != nil {
+			t.Fatal(err)
+		}
+		writeFile(t, keyFile, []byte("not a key"))
+		if err := reloader.Reload(); err == nil {
+			t.Errorf("got error %v: want error: %v", err, true)
+		}
+		if reloader.cert == nil {
+			t.Error("expected previous certificate to be kept")
+		}
+	})
+}
@@ -0,0 +1,107 @@
+package tracing
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	ExporterNone   = "none"
+	ExporterStdout = "stdout"
+	ExporterOTLP   = "otlp"
+
+	rowsAffectedKey = attribute.Key("db.rows_affected")
+)
+
+// Config selects where spans are exported to.
+type Config struct {
+	Exporter    string
+	ServiceName string
+	// Endpoint is the host:port of the OTLP gRPC collector.
+	Endpoint string
+	Insecure bool
+	// Writer receives spans from the stdout exporter. Defaults to os.Stdout.
+	Writer io.Writer
+}
+
+// Setup installs the global tracer provider and the W3C trace context
+// propagator. The returned function flushes pending spans and must be called
+// before the process exits.
+func Setup(ctx context.Context, config Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch config.Exporter {
+	case ExporterNone, "":
+		return func(context.Context) error { return nil }, nil
+	case ExporterStdout:
+		writer := config.Writer
+		if writer == nil {
+			writer = os.Stdout
+		}
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(writer))
+	case ExporterOTLP:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(config.Endpoint)}
+		if config.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err = otlptracegrpc.New(ctx, opts...)
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %q", config.Exporter)
+	}
+	if err != nil {
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceNameKey.String(config.ServiceName))),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// StartQuery opens a span for a single MySQL statement. name identifies the
+// statement in the code, operation is the SQL verb and table the main table.
+func StartQuery(ctx context.Context, tracer trace.Tracer, name, operation, table string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemMySQL,
+			semconv.DBOperationKey.String(operation),
+			semconv.DBSQLTableKey.String(table),
+		),
+	)
+}
+
+// RecordError marks span as failed with err. A nil err is ignored.
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// RecordResult adds the number of rows affected by an exec to span.
+func RecordResult(span trace.Span, result sql.Result) {
+	if result == nil {
+		return
+	}
+	if rows, err := result.RowsAffected(); err == nil {
+		span.SetAttributes(rowsAffectedKey.Int64(rows))
+	}
+}
@@ -0,0 +1,95 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestSetup(t *testing.T) {
+	tests := []struct {
+		description string
+		exporter    string
+		isError     bool
+	}{
+		{
+			description: "none",
+			exporter:    ExporterNone,
+		},
+		{
+			description: "stdout",
+			exporter:    ExporterStdout,
+		},
+		{
+			description: "unknown exporter",
+			exporter:    "zipkin",
+			isError:     true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			var out bytes.Buffer
+			shutdown, err := Setup(context.Background(), Config{
+				Exporter:    test.exporter,
+				ServiceName: "leaveManagement.leaveManagementSerivce",
+				Writer:      &out,
+			})
+			if test.isError == true {
+				if err == nil {
+					t.Errorf("got error %v: want error: %v", err, true)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got error %v: want error: %v", err, false)
+			}
+			if err := shutdown(context.Background()); err != nil {
+				t.Errorf("got error %v: want error: %v", err, false)
+			}
+		})
+	}
+}
+
+func TestStartQuery(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	tracer := provider.Tracer("test")
+
+	_, span := StartQuery(context.Background(), tracer, "deleteLeave", "DELETE", "lm_leave_application")
+	RecordResult(span, sqlmock.NewResult(0, 2))
+	RecordError(span, errors.New("error"))
+	span.End()
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("expected %v: got %v", 1, len(spans))
+	}
+	got := map[string]string{}
+	for _, attr := range spans[0].Attributes() {
+		got[string(attr.Key)] = attr.Value.Emit()
+	}
+	expected := map[string]string{
+		"db.system":        "mysql",
+		"db.operation":     "DELETE",
+		"db.sql.table":     "lm_leave_application",
+		"db.rows_affected": "2",
+	}
+	for key, value := range expected {
+		if got[key] != value {
+			t.Errorf("%v: expected %v: got %v", key, value, got[key])
+		}
+	}
+	if spans[0].Name() != "deleteLeave" || spans[0].Status().Code != codes.Error {
+		t.Errorf("expected %v: got %v %v", "failed deleteLeave span", spans[0].Name(), spans[0].Status())
+	}
+	if !strings.Contains(spans[0].Status().Description, "error") {
+		t.Errorf("expected %v: got %v", "error", spans[0].Status().Description)
+	}
+}
@@ -0,0 +1,42 @@
+-- Append-only, hash-chained audit trail of leave application mutations.
+
+CREATE TABLE lm_audit_event (
+    event_id        BIGINT NOT NULL AUTO_INCREMENT,
+    application_id  INT(11) NOT NULL,
+    applicant_id    INT(11) NOT NULL,
+    actor_id        INT(11) NOT NULL,
+    action          VARCHAR(20) NOT NULL,
+    before_snapshot TEXT NOT NULL,
+    after_snapshot  TEXT NOT NULL,
+    created_at      VARCHAR(35) NOT NULL,
+    prev_hash       CHAR(64) NOT NULL,
+    hash            CHAR(64) NOT NULL,
+    PRIMARY KEY (event_id),
+    KEY idx_audit_application (application_id),
+    KEY idx_audit_applicant (applicant_id),
+    KEY idx_audit_actor (actor_id)
+);
+
+CREATE TABLE lm_audit_chain_head (
+    id        TINYINT NOT NULL,
+    last_hash CHAR(64) NOT NULL,
+    PRIMARY KEY (id)
+);
+
+INSERT INTO lm_audit_chain_head (id, last_hash) VALUES (1, '');
+
+DELIMITER //
+
+CREATE TRIGGER lm_audit_event_no_update BEFORE UPDATE ON lm_audit_event
+FOR EACH ROW
+BEGIN
+    SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'lm_audit_event is append-only';
+END//
+
+CREATE TRIGGER lm_audit_event_no_delete BEFORE DELETE ON lm_audit_event
+FOR EACH ROW
+BEGIN
+    SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'lm_audit_event is append-only';
+END//
+
+DELIMITER ;
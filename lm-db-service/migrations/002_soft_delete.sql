@@ -0,0 +1,8 @@
+-- Soft delete for leave applications. Deleted rows are hidden from the API
+-- and from balance calculations and are removed by the retention purge job.
+
+ALTER TABLE lm_leave_application
+    ADD COLUMN deleted_at    DATETIME NULL,
+    ADD COLUMN deleted_by    INT(11) NULL,
+    ADD COLUMN delete_reason VARCHAR(200) NULL,
+    ADD KEY idx_leave_application_deleted_at (deleted_at);
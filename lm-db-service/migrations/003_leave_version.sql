@@ -0,0 +1,5 @@
+-- Optimistic concurrency for leave applications. Every change increments
+-- version; UpdateLeave and ChangeLeaveStatus must send the version they read.
+
+ALTER TABLE lm_leave_application
+    ADD COLUMN version INT(11) NOT NULL DEFAULT 1;
@@ -0,0 +1,15 @@
+-- Results of mutating RPCs sent with an idempotency-key header, kept for
+-- -idempotency-window so client retries get the original response.
+
+CREATE TABLE lm_idempotency_key (
+    employee_id     VARCHAR(20) NOT NULL,
+    rpc             VARCHAR(100) NOT NULL,
+    idempotency_key VARCHAR(100) NOT NULL,
+    request_hash    CHAR(64) NOT NULL,
+    completed       TINYINT(1) NOT NULL DEFAULT 0,
+    response        MEDIUMBLOB NULL,
+    created_at      DATETIME NOT NULL,
+    expires_at      DATETIME NOT NULL,
+    PRIMARY KEY (employee_id, rpc, idempotency_key),
+    KEY idx_idempotency_key_created_at (created_at)
+);
@@ -0,0 +1,5 @@
+-- Reporting line used to let managers see the leave of their own team.
+
+ALTER TABLE lm_employee
+    ADD COLUMN manager_id INT(11) NULL,
+    ADD KEY idx_employee_manager (manager_id);
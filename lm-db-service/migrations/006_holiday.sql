@@ -0,0 +1,7 @@
+-- Company holidays shown on the team calendar.
+
+CREATE TABLE lm_holiday (
+    holiday_date DATE NOT NULL,
+    name         VARCHAR(50) NOT NULL,
+    PRIMARY KEY (holiday_date)
+);
@@ -0,0 +1,20 @@
+-- Coverage rules limit how many people of a team (the employees reporting to
+-- manager_id) or of a designation may be on approved leave on the same day.
+-- Set max_absent, max_absent_percent or both; the lower limit applies.
+
+CREATE TABLE lm_coverage_rule (
+    rule_id            INT(11) NOT NULL AUTO_INCREMENT,
+    manager_id         INT(11) NULL,
+    designation_id     INT(11) NULL,
+    max_absent         INT(11) NULL,
+    max_absent_percent INT(3) NULL,
+    PRIMARY KEY (rule_id),
+    KEY idx_coverage_rule_manager (manager_id),
+    KEY idx_coverage_rule_designation (designation_id),
+    CHECK ((manager_id IS NULL) <> (designation_id IS NULL)),
+    CHECK (max_absent IS NOT NULL OR max_absent_percent IS NOT NULL)
+);
+
+-- Reason HR gave for approving an application over a coverage limit.
+ALTER TABLE lm_leave_application
+    ADD COLUMN coverage_override_reason VARCHAR(200) NULL;
@@ -0,0 +1,21 @@
+-- Date ranges during which leave cannot be taken. A period applies to the
+-- employees of designation_id, the team of manager_id and applications of
+-- leave_type_id; a NULL scope column matches everything. Applications of the
+-- leave types in exempt_leave_type_ids (comma separated) are never affected.
+-- BLOCK rejects overlapping applications, WARN only flags them.
+
+CREATE TABLE lm_blackout_period (
+    blackout_id           INT(11) NOT NULL AUTO_INCREMENT,
+    name                  VARCHAR(100) NOT NULL,
+    from_date             DATE NOT NULL,
+    to_date               DATE NOT NULL,
+    designation_id        INT(11) NULL,
+    manager_id            INT(11) NULL,
+    leave_type_id         INT(11) NULL,
+    exempt_leave_type_ids VARCHAR(100) NOT NULL DEFAULT '',
+    enforcement           VARCHAR(5) NOT NULL DEFAULT 'BLOCK',
+    created_by            INT(11) NOT NULL,
+    created_at            DATETIME NOT NULL,
+    PRIMARY KEY (blackout_id),
+    KEY idx_blackout_period_dates (from_date, to_date)
+);
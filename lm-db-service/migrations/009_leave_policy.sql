@@ -0,0 +1,9 @@
+-- Per leave type policy settings checked when leave is applied for or
+-- changed. A NULL limit is not enforced.
+
+ALTER TABLE lm_leave_type
+    ADD COLUMN min_notice_days INT(3) NULL,
+    ADD COLUMN max_consecutive_days INT(3) NULL,
+    ADD COLUMN min_days_per_request INT(3) NULL,
+    ADD COLUMN max_days_per_request INT(3) NULL,
+    ADD COLUMN allow_backdated TINYINT(1) NOT NULL DEFAULT 1;
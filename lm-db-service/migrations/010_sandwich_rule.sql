@@ -0,0 +1,10 @@
+-- Sandwich rule: weekends and holidays between two days of leave count as
+-- leave for the leave types that enable it. Each application records how
+-- many it counted on either side so adjoining applications count a gap once.
+
+ALTER TABLE lm_leave_type
+    ADD COLUMN sandwich TINYINT(1) NOT NULL DEFAULT 0;
+
+ALTER TABLE lm_leave_application
+    ADD COLUMN sandwich_before INT(3) NOT NULL DEFAULT 0,
+    ADD COLUMN sandwich_after INT(3) NOT NULL DEFAULT 0;
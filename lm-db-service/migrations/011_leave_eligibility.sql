@@ -0,0 +1,15 @@
+-- Who may take a leave type: gender, designations, minimum tenure,
+-- employment types and whether employees on probation are left out. An
+-- empty setting is not enforced.
+
+ALTER TABLE lm_employee
+    ADD COLUMN date_of_joining DATE NULL,
+    ADD COLUMN employment_type VARCHAR(20) NOT NULL DEFAULT 'PERMANENT',
+    ADD COLUMN probation_end_date DATE NULL;
+
+ALTER TABLE lm_leave_type
+    ADD COLUMN eligible_gender INT(1) NULL,
+    ADD COLUMN eligible_designation_ids VARCHAR(100) NOT NULL DEFAULT '',
+    ADD COLUMN min_tenure_days INT(5) NULL,
+    ADD COLUMN eligible_employment_types VARCHAR(100) NOT NULL DEFAULT '',
+    ADD COLUMN probation_excluded TINYINT(1) NOT NULL DEFAULT 0;
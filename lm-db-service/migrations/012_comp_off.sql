@@ -0,0 +1,25 @@
+-- Compensatory off: a day of leave earned by working on a weekend or
+-- holiday. The leave type with comp_off_validity_days set is paid for with
+-- these credits, each expiring that many days after the day worked. Status
+-- is 0 pending, 1 approved, 2 declined, then once expired 3 lapsed unused or
+-- 4 used. A day worked is claimed once: requesting it again after a decline
+-- reopens the declined claim.
+
+ALTER TABLE lm_leave_type
+    ADD COLUMN comp_off_validity_days INT(3) NULL;
+
+CREATE TABLE lm_comp_off (
+    comp_off_id  INT(11) NOT NULL AUTO_INCREMENT,
+    employee_id  INT(11) NOT NULL,
+    worked_date  DATE NOT NULL,
+    reason       VARCHAR(200) NOT NULL,
+    status       INT(1) NOT NULL DEFAULT 0,
+    requested_at DATETIME NOT NULL,
+    decided_by   INT(11) NULL,
+    decided_at   DATETIME NULL,
+    expires_on   DATE NULL,
+    settled_at   DATETIME NULL,
+    PRIMARY KEY (comp_off_id),
+    UNIQUE KEY idx_comp_off_employee (employee_id, worked_date),
+    KEY idx_comp_off_expiry (status, expires_on)
+);
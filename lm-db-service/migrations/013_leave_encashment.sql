@@ -0,0 +1,34 @@
+-- Leave encashment: unused days of an encashable leave type paid out at
+-- year end or on exit instead of being taken. The pay per day is
+-- encash_rate_percent of the monthly salary over encash_rate_divisor days,
+-- worked out when the request is made and kept with it. Status is 0
+-- pending, 1 approved and 2 declined.
+
+ALTER TABLE lm_employee
+    ADD COLUMN monthly_salary DECIMAL(12,2) NULL;
+
+ALTER TABLE lm_leave_type
+    ADD COLUMN encashable TINYINT(1) NOT NULL DEFAULT 0,
+    ADD COLUMN max_encash_days INT(3) NULL,
+    ADD COLUMN encash_rate_percent INT(3) NOT NULL DEFAULT 100,
+    ADD COLUMN encash_rate_divisor INT(2) NOT NULL DEFAULT 30;
+
+CREATE TABLE lm_encashment (
+    encashment_id  INT(11) NOT NULL AUTO_INCREMENT,
+    employee_id    INT(11) NOT NULL,
+    leave_type_id  INT(11) NOT NULL,
+    year           CHAR(4) NOT NULL,
+    days           INT(3) NOT NULL,
+    occasion       VARCHAR(10) NOT NULL,
+    status         INT(1) NOT NULL DEFAULT 0,
+    monthly_salary DECIMAL(12,2) NOT NULL,
+    day_rate       DECIMAL(12,2) NOT NULL,
+    amount         DECIMAL(12,2) NOT NULL,
+    formula        VARCHAR(100) NOT NULL,
+    requested_at   DATETIME NOT NULL,
+    decided_by     INT(11) NULL,
+    decided_at     DATETIME NULL,
+    PRIMARY KEY (encashment_id),
+    KEY idx_encashment_employee (employee_id, leave_type_id, year),
+    KEY idx_encashment_status (status, year)
+);
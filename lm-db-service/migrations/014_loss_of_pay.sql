@@ -0,0 +1,6 @@
+-- Loss of pay: the days of an application beyond the leave balance that
+-- the applicant accepted to take unpaid. no_of_days still counts every day
+-- of the leave; only no_of_days - loss_of_pay_days comes off the balance.
+
+ALTER TABLE lm_leave_application
+    ADD COLUMN loss_of_pay_days INT(3) NOT NULL DEFAULT 0;
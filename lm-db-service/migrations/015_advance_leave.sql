@@ -0,0 +1,12 @@
+-- Advance leave: a leave type may let its balance go negative_balance_limit
+-- days below zero, borrowing against the next leave year. advance_days are
+-- the days of an application taken below zero; they come off the
+-- entitlement of the next leave year. An application in advance is approved
+-- by the manager first, recorded in advance_approved_by, and then by HR.
+
+ALTER TABLE lm_leave_type
+    ADD COLUMN negative_balance_limit INT(3) NOT NULL DEFAULT 0;
+
+ALTER TABLE lm_leave_application
+    ADD COLUMN advance_days INT(3) NOT NULL DEFAULT 0,
+    ADD COLUMN advance_approved_by INT(11) NULL;
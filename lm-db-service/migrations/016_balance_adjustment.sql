@@ -0,0 +1,17 @@
+-- Balance adjustments: days HR credits to, or debits from when negative,
+-- the balance of an employee for a leave type and leave year to correct a
+-- mistake. Rows are never edited; a wrong adjustment is undone with an
+-- opposite one, so the table is also the history of corrections.
+
+CREATE TABLE lm_balance_adjustment (
+    adjustment_id INT(11) NOT NULL AUTO_INCREMENT,
+    employee_id   INT(11) NOT NULL,
+    leave_type_id INT(11) NOT NULL,
+    year          CHAR(4) NOT NULL,
+    days          INT(3) NOT NULL,
+    reason        VARCHAR(200) NOT NULL,
+    adjusted_by   INT(11) NOT NULL,
+    adjusted_at   DATETIME NOT NULL,
+    PRIMARY KEY (adjustment_id),
+    KEY idx_balance_adjustment_employee (employee_id, leave_type_id, year)
+);
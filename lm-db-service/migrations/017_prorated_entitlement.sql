@@ -0,0 +1,12 @@
+-- Pro-rated entitlements: an employee who joins or leaves during a leave
+-- year earns the days of number_of_days_allowed in proportion to the days of
+-- the year in service, from date_of_joining (added with the eligibility
+-- rules) to date_of_exit. proration_rounding is how part of a day is
+-- rounded: DOWN, UP or NEAREST (halves up); NONE grants the whole
+-- allowance whatever the dates.
+
+ALTER TABLE lm_employee
+    ADD COLUMN date_of_exit DATE NULL;
+
+ALTER TABLE lm_leave_type
+    ADD COLUMN proration_rounding VARCHAR(10) NOT NULL DEFAULT 'NEAREST';
@@ -0,0 +1,11 @@
+-- Audit events for records other than leave applications: comp-offs,
+-- encashments, balance adjustments, blackout periods and employee exits.
+-- subject names the kind of record and subject_id its id; both are empty
+-- for leave application events, which keep application_id. The events of
+-- other records store 0 as application_id and the employee concerned, or 0,
+-- as applicant_id.
+
+ALTER TABLE lm_audit_event
+    ADD COLUMN subject    VARCHAR(20) NOT NULL DEFAULT '',
+    ADD COLUMN subject_id INT(11) NULL,
+    ADD KEY idx_audit_subject (subject, subject_id);
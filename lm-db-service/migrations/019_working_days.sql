@@ -0,0 +1,10 @@
+-- Working-day counting: leave types that set working_days count only the
+-- working days of an application as leave, with the sandwich rule on top.
+-- The others keep counting every calendar day from the from date to the to
+-- date, as before 010_sandwich_rule.sql. The sandwich rule needs working-day
+-- counting, so the types that enabled it are switched over.
+
+ALTER TABLE lm_leave_type
+    ADD COLUMN working_days TINYINT(1) NOT NULL DEFAULT 0;
+
+UPDATE lm_leave_type SET working_days=1 WHERE sandwich=1;
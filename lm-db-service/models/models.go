@@ -8,12 +8,33 @@ import (
 type DatabaseIF interface {
 	Connect(string, string) error
 	Test() error
-	ApplyLeave(context.Context, *pb.ApplyLeaveRequest) error
+	Close() error
+	ApplyLeave(context.Context, *pb.ApplyLeaveRequest) (*pb.ApplyLeaveResponse, error)
 	ChangeLeaveStatus(context.Context, *pb.ChangeLeaveStatusRequest) error
 	GetLeaveById(context.Context, *pb.GetLeaveByIdRequest) (*pb.GetLeaveByIdResponse, error)
+	ListMyLeaves(context.Context, *pb.ListMyLeavesRequest) (*pb.LeavesListResponse, error)
 	DeleteLeave(context.Context, *pb.DeleteLeaveRequest) error
-	UpdateLeave(context.Context, *pb.UpdateLeaveRequest) error
+	RestoreLeave(context.Context, *pb.RestoreLeaveRequest) error
+	UpdateLeave(context.Context, *pb.UpdateLeaveRequest) (*pb.UpdateLeaveResponse, error)
 	LeavesList(context.Context, *pb.LeavesListRequest) (*pb.LeavesListResponse, error)
+	GetLeaveBalances(context.Context, *pb.GetLeaveBalancesRequest) (*pb.GetLeaveBalancesResponse, error)
+	ListAuditEvents(context.Context, *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error)
+	TeamCalendar(context.Context, *pb.TeamCalendarRequest) (*pb.TeamCalendarResponse, error)
+	CreateBlackoutPeriod(context.Context, *pb.CreateBlackoutPeriodRequest) (*pb.CreateBlackoutPeriodResponse, error)
+	ListBlackoutPeriods(context.Context, *pb.ListBlackoutPeriodsRequest) (*pb.ListBlackoutPeriodsResponse, error)
+	DeleteBlackoutPeriod(context.Context, *pb.DeleteBlackoutPeriodRequest) error
+	ListEligibleLeaveTypes(context.Context, *pb.ListEligibleLeaveTypesRequest) (*pb.ListEligibleLeaveTypesResponse, error)
+	RequestCompOff(context.Context, *pb.RequestCompOffRequest) (*pb.RequestCompOffResponse, error)
+	ChangeCompOffStatus(context.Context, *pb.ChangeCompOffStatusRequest) error
+	ListCompOffs(context.Context, *pb.ListCompOffsRequest) (*pb.ListCompOffsResponse, error)
+	EncashLeave(context.Context, *pb.EncashLeaveRequest) (*pb.EncashLeaveResponse, error)
+	ChangeEncashmentStatus(context.Context, *pb.ChangeEncashmentStatusRequest) error
+	ListEncashments(context.Context, *pb.ListEncashmentsRequest) (*pb.ListEncashmentsResponse, error)
+	ListLossOfPay(context.Context, *pb.ListLossOfPayRequest) (*pb.ListLossOfPayResponse, error)
+	AdjustBalance(context.Context, *pb.AdjustBalanceRequest) (*pb.AdjustBalanceResponse, error)
+	ListBalanceAdjustments(context.Context, *pb.ListBalanceAdjustmentsRequest) (*pb.ListBalanceAdjustmentsResponse, error)
+	RecordExit(context.Context, *pb.RecordExitRequest) (*pb.RecordExitResponse, error)
+	GetFinalSettlement(context.Context, *pb.GetFinalSettlementRequest) (*pb.GetFinalSettlementResponse, error)
 }
 
 type ValidateApplyLeave struct {
@@ -27,14 +48,31 @@ type ValidateLeavesList struct {
 	EmployeeId  string `validate:"required"`
 	LeaveStatus int    `validate:"required,gte=0,lte=2"`
 }
-type ValidateChangeLeaveStatus struct {
+type ValidateGetLeaveById struct {
 	EmployeeId    string `validate:"required"`
 	ApplicationId string `validate:"required"`
-	LeaveStatus   int    `validate:"required,gte=0,lte=2"`
+}
+type ValidateListMyLeaves struct {
+	EmployeeId  string `validate:"required"`
+	LeaveStatus string `validate:"omitempty,oneof=0 1 2"`
+	FromDate    string
+	ToDate      string
+}
+type ValidateChangeLeaveStatus struct {
+	EmployeeId     string `validate:"required"`
+	ApplicationId  string `validate:"required"`
+	LeaveStatus    int    `validate:"required,gte=0,lte=2"`
+	Version        string `validate:"required,numeric"`
+	OverrideReason string `validate:"max=200"`
 }
 type ValidateDeleteLeave struct {
 	EmployeeId    string `validate:"required"`
 	ApplicationId string `validate:"required"`
+	Reason        string `validate:"required,max=200"`
+}
+type ValidateRestoreLeave struct {
+	EmployeeId    string `validate:"required"`
+	ApplicationId string `validate:"required"`
 }
 type ValidateUpdateLeave struct {
 	ApplicationId string `validate:"required"`
@@ -43,4 +81,96 @@ type ValidateUpdateLeave struct {
 	FromDate      string `validate:"required"`
 	ToDate        string `validate:"required"`
 	Comment       string `validate:"required"`
+	Version       string `validate:"required,numeric"`
+}
+type ValidateGetLeaveBalances struct {
+	EmployeeId       string `validate:"required"`
+	TargetEmployeeId string `validate:"required"`
+	Year             string `validate:"required,numeric,len=4"`
+}
+type ValidateListAuditEvents struct {
+	EmployeeId string `validate:"required"`
+}
+type ValidateTeamCalendar struct {
+	EmployeeId string `validate:"required"`
+	ManagerId  string `validate:"required"`
+	FromDate   string `validate:"required"`
+	ToDate     string `validate:"required"`
+}
+type ValidateCreateBlackoutPeriod struct {
+	EmployeeId         string   `validate:"required"`
+	Name               string   `validate:"required,max=100"`
+	FromDate           string   `validate:"required"`
+	ToDate             string   `validate:"required"`
+	DesignationId      string   `validate:"omitempty,numeric"`
+	ManagerId          string   `validate:"omitempty,numeric"`
+	LeaveTypeId        string   `validate:"omitempty,numeric"`
+	ExemptLeaveTypeIds []string `validate:"dive,numeric"`
+	Enforcement        string   `validate:"oneof=BLOCK WARN"`
+}
+type ValidateDeleteBlackoutPeriod struct {
+	EmployeeId string `validate:"required"`
+	BlackoutId string `validate:"required,numeric"`
+}
+type ValidateRequestCompOff struct {
+	EmployeeId string `validate:"required"`
+	WorkedDate string `validate:"required"`
+	Reason     string `validate:"required,max=200"`
+}
+type ValidateChangeCompOffStatus struct {
+	EmployeeId string `validate:"required"`
+	CompOffId  string `validate:"required,numeric"`
+	Status     string `validate:"oneof=1 2"`
+}
+type ValidateListCompOffs struct {
+	EmployeeId       string `validate:"required"`
+	TargetEmployeeId string `validate:"required"`
+	Status           string `validate:"omitempty,oneof=0 1 2 3 4"`
+}
+type ValidateEncashLeave struct {
+	EmployeeId  string `validate:"required"`
+	LeaveTypeId string `validate:"required,numeric"`
+	Year        string `validate:"required,numeric,len=4"`
+	Days        int    `validate:"required,gte=1"`
+	Occasion    string `validate:"oneof=YEAR_END EXIT"`
+}
+type ValidateChangeEncashmentStatus struct {
+	EmployeeId   string `validate:"required"`
+	EncashmentId string `validate:"required,numeric"`
+	Status       string `validate:"oneof=1 2"`
+}
+type ValidateListEncashments struct {
+	EmployeeId       string `validate:"required"`
+	TargetEmployeeId string
+	Status           string `validate:"omitempty,oneof=0 1 2"`
+	Year             string `validate:"omitempty,numeric,len=4"`
+}
+type ValidateListLossOfPay struct {
+	EmployeeId       string `validate:"required"`
+	TargetEmployeeId string
+	FromDate         string `validate:"required"`
+	ToDate           string `validate:"required"`
+}
+type ValidateAdjustBalance struct {
+	EmployeeId       string `validate:"required"`
+	TargetEmployeeId string `validate:"required,numeric"`
+	LeaveTypeId      string `validate:"required,numeric"`
+	Year             string `validate:"required,numeric,len=4"`
+	Days             int    `validate:"required"`
+	Reason           string `validate:"required,max=200"`
+}
+type ValidateListBalanceAdjustments struct {
+	EmployeeId       string `validate:"required"`
+	TargetEmployeeId string
+	LeaveTypeId      string `validate:"omitempty,numeric"`
+	Year             string `validate:"omitempty,numeric,len=4"`
+}
+type ValidateRecordExit struct {
+	EmployeeId       string `validate:"required"`
+	TargetEmployeeId string `validate:"required,numeric"`
+	ExitDate         string `validate:"required"`
+}
+type ValidateGetFinalSettlement struct {
+	EmployeeId       string `validate:"required"`
+	TargetEmployeeId string `validate:"required,numeric"`
 }
@@ -9,6 +9,7 @@ package pb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -30,6 +31,9 @@ type ApplyLeaveRequest struct {
 	FromDate    string `protobuf:"bytes,3,opt,name=fromDate,proto3" json:"fromDate,omitempty"`
 	ToDate      string `protobuf:"bytes,4,opt,name=toDate,proto3" json:"toDate,omitempty"`
 	Comment     string `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	// acceptLossOfPay takes the days beyond the balance as unpaid leave
+	// instead of rejecting the application.
+	AcceptLossOfPay bool `protobuf:"varint,6,opt,name=acceptLossOfPay,proto3" json:"acceptLossOfPay,omitempty"`
 }
 
 func (x *ApplyLeaveRequest) Reset() {
@@ -99,16 +103,156 @@ func (x *ApplyLeaveRequest) GetComment() string {
 	return ""
 }
 
+func (x *ApplyLeaveRequest) GetAcceptLossOfPay() bool {
+	if x != nil {
+		return x.AcceptLossOfPay
+	}
+	return false
+}
+
+type LeaveDay struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// kind is WORKING, WEEKEND or HOLIDAY.
+	Kind    string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Counted bool   `protobuf:"varint,3,opt,name=counted,proto3" json:"counted,omitempty"`
+}
+
+func (x *LeaveDay) Reset() {
+	*x = LeaveDay{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaveDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveDay) ProtoMessage() {}
+
+func (x *LeaveDay) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveDay.ProtoReflect.Descriptor instead.
+func (*LeaveDay) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LeaveDay) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *LeaveDay) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *LeaveDay) GetCounted() bool {
+	if x != nil {
+		return x.Counted
+	}
+	return false
+}
+
+type LeaveDuration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// noOfDays is the number of days counted as leave.
+	NoOfDays string `protobuf:"bytes,1,opt,name=noOfDays,proto3" json:"noOfDays,omitempty"`
+	// days lists every day of the leave, and the weekends and holidays
+	// sandwiched between it and the leave next to it, with whether it counts.
+	Days []*LeaveDay `protobuf:"bytes,2,rep,name=days,proto3" json:"days,omitempty"`
+}
+
+func (x *LeaveDuration) Reset() {
+	*x = LeaveDuration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaveDuration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveDuration) ProtoMessage() {}
+
+func (x *LeaveDuration) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveDuration.ProtoReflect.Descriptor instead.
+func (*LeaveDuration) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LeaveDuration) GetNoOfDays() string {
+	if x != nil {
+		return x.NoOfDays
+	}
+	return ""
+}
+
+func (x *LeaveDuration) GetDays() []*LeaveDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
 type ApplyLeaveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ApplicationId string `protobuf:"bytes,1,opt,name=applicationId,proto3" json:"applicationId,omitempty"`
+	// warnings lists the blackout periods the leave overlaps and the coverage
+	// limits it would exceed if approved.
+	Warnings []string       `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	Duration *LeaveDuration `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	// lossOfPayDays is the number of days taken as unpaid leave.
+	LossOfPayDays string `protobuf:"bytes,4,opt,name=lossOfPayDays,proto3" json:"lossOfPayDays,omitempty"`
+	// advanceDays is the number of days taken below a zero balance, which
+	// need HR's approval after the manager's.
+	AdvanceDays string `protobuf:"bytes,5,opt,name=advanceDays,proto3" json:"advanceDays,omitempty"`
 }
 
 func (x *ApplyLeaveResponse) Reset() {
 	*x = ApplyLeaveResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_lm_proto_msgTypes[1]
+		mi := &file_pb_lm_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -121,7 +265,7 @@ func (x *ApplyLeaveResponse) String() string {
 func (*ApplyLeaveResponse) ProtoMessage() {}
 
 func (x *ApplyLeaveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_lm_proto_msgTypes[1]
+	mi := &file_pb_lm_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -134,7 +278,42 @@ func (x *ApplyLeaveResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyLeaveResponse.ProtoReflect.Descriptor instead.
 func (*ApplyLeaveResponse) Descriptor() ([]byte, []int) {
-	return file_pb_lm_proto_rawDescGZIP(), []int{1}
+	return file_pb_lm_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ApplyLeaveResponse) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *ApplyLeaveResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *ApplyLeaveResponse) GetDuration() *LeaveDuration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *ApplyLeaveResponse) GetLossOfPayDays() string {
+	if x != nil {
+		return x.LossOfPayDays
+	}
+	return ""
+}
+
+func (x *ApplyLeaveResponse) GetAdvanceDays() string {
+	if x != nil {
+		return x.AdvanceDays
+	}
+	return ""
 }
 
 type ChangeLeaveStatusRequest struct {
@@ -145,12 +324,17 @@ type ChangeLeaveStatusRequest struct {
 	EmployeeId    string `protobuf:"bytes,1,opt,name=employeeId,proto3" json:"employeeId,omitempty"`
 	ApplicationId string `protobuf:"bytes,2,opt,name=applicationId,proto3" json:"applicationId,omitempty"`
 	LeaveStatus   string `protobuf:"bytes,3,opt,name=leaveStatus,proto3" json:"leaveStatus,omitempty"`
+	Version       string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	// overrideReason lets HR approve an application over a coverage limit.
+	// It is not needed when HR approves an application in advance after
+	// the manager did.
+	OverrideReason string `protobuf:"bytes,5,opt,name=overrideReason,proto3" json:"overrideReason,omitempty"`
 }
 
 func (x *ChangeLeaveStatusRequest) Reset() {
 	*x = ChangeLeaveStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_lm_proto_msgTypes[2]
+		mi := &file_pb_lm_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -163,7 +347,7 @@ func (x *ChangeLeaveStatusRequest) String() string {
 func (*ChangeLeaveStatusRequest) ProtoMessage() {}
 
 func (x *ChangeLeaveStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_lm_proto_msgTypes[2]
+	mi := &file_pb_lm_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -176,7 +360,7 @@ func (x *ChangeLeaveStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangeLeaveStatusRequest.ProtoReflect.Descriptor instead.
 func (*ChangeLeaveStatusRequest) Descriptor() ([]byte, []int) {
-	return file_pb_lm_proto_rawDescGZIP(), []int{2}
+	return file_pb_lm_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ChangeLeaveStatusRequest) GetEmployeeId() string {
@@ -200,6 +384,20 @@ func (x *ChangeLeaveStatusRequest) GetLeaveStatus() string {
 	return ""
 }
 
+func (x *ChangeLeaveStatusRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ChangeLeaveStatusRequest) GetOverrideReason() string {
+	if x != nil {
+		return x.OverrideReason
+	}
+	return ""
+}
+
 type ChangeLeaveStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -209,7 +407,7 @@ type ChangeLeaveStatusResponse struct {
 func (x *ChangeLeaveStatusResponse) Reset() {
 	*x = ChangeLeaveStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_lm_proto_msgTypes[3]
+		mi := &file_pb_lm_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -222,7 +420,7 @@ func (x *ChangeLeaveStatusResponse) String() string {
 func (*ChangeLeaveStatusResponse) ProtoMessage() {}
 
 func (x *ChangeLeaveStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_lm_proto_msgTypes[3]
+	mi := &file_pb_lm_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -235,7 +433,7 @@ func (x *ChangeLeaveStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangeLeaveStatusResponse.ProtoReflect.Descriptor instead.
 func (*ChangeLeaveStatusResponse) Descriptor() ([]byte, []int) {
-	return file_pb_lm_proto_rawDescGZIP(), []int{3}
+	return file_pb_lm_proto_rawDescGZIP(), []int{5}
 }
 
 type GetLeaveByIdRequest struct {
@@ -244,12 +442,13 @@ type GetLeaveByIdRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	ApplicationId string `protobuf:"bytes,1,opt,name=applicationId,proto3" json:"applicationId,omitempty"`
+	EmployeeId    string `protobuf:"bytes,2,opt,name=employeeId,proto3" json:"employeeId,omitempty"`
 }
 
 func (x *GetLeaveByIdRequest) Reset() {
 	*x = GetLeaveByIdRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_lm_proto_msgTypes[4]
+		mi := &file_pb_lm_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -262,7 +461,7 @@ func (x *GetLeaveByIdRequest) String() string {
 func (*GetLeaveByIdRequest) ProtoMessage() {}
 
 func (x *GetLeaveByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_lm_proto_msgTypes[4]
+	mi := &file_pb_lm_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -275,7 +474,7 @@ func (x *GetLeaveByIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLeaveByIdRequest.ProtoReflect.Descriptor instead.
 func (*GetLeaveByIdRequest) Descriptor() ([]byte, []int) {
-	return file_pb_lm_proto_rawDescGZIP(), []int{4}
+	return file_pb_lm_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetLeaveByIdRequest) GetApplicationId() string {
@@ -285,6 +484,13 @@ func (x *GetLeaveByIdRequest) GetApplicationId() string {
 	return ""
 }
 
+func (x *GetLeaveByIdRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
 type GetLeaveByIdResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -303,12 +509,15 @@ type GetLeaveByIdResponse struct {
 	DateOfApproval    string `protobuf:"bytes,11,opt,name=dateOfApproval,proto3" json:"dateOfApproval,omitempty"`
 	FirstName         string `protobuf:"bytes,12,opt,name=firstName,proto3" json:"firstName,omitempty"`
 	LastName          string `protobuf:"bytes,13,opt,name=lastName,proto3" json:"lastName,omitempty"`
+	Version           string `protobuf:"bytes,14,opt,name=version,proto3" json:"version,omitempty"`
+	LossOfPayDays     string `protobuf:"bytes,15,opt,name=lossOfPayDays,proto3" json:"lossOfPayDays,omitempty"`
+	AdvanceDays       string `protobuf:"bytes,16,opt,name=advanceDays,proto3" json:"advanceDays,omitempty"`
 }
 
 func (x *GetLeaveByIdResponse) Reset() {
 	*x = GetLeaveByIdResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_lm_proto_msgTypes[5]
+		mi := &file_pb_lm_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -321,7 +530,7 @@ func (x *GetLeaveByIdResponse) String() string {
 func (*GetLeaveByIdResponse) ProtoMessage() {}
 
 func (x *GetLeaveByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_lm_proto_msgTypes[5]
+	mi := &file_pb_lm_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -334,7 +543,7 @@ func (x *GetLeaveByIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLeaveByIdResponse.ProtoReflect.Descriptor instead.
 func (*GetLeaveByIdResponse) Descriptor() ([]byte, []int) {
-	return file_pb_lm_proto_rawDescGZIP(), []int{5}
+	return file_pb_lm_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetLeaveByIdResponse) GetApplicationId() string {
@@ -428,6 +637,27 @@ func (x *GetLeaveByIdResponse) GetLastName() string {
 	return ""
 }
 
+func (x *GetLeaveByIdResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetLeaveByIdResponse) GetLossOfPayDays() string {
+	if x != nil {
+		return x.LossOfPayDays
+	}
+	return ""
+}
+
+func (x *GetLeaveByIdResponse) GetAdvanceDays() string {
+	if x != nil {
+		return x.AdvanceDays
+	}
+	return ""
+}
+
 type LeavesListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -440,7 +670,7 @@ type LeavesListRequest struct {
 func (x *LeavesListRequest) Reset() {
 	*x = LeavesListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_lm_proto_msgTypes[6]
+		mi := &file_pb_lm_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -453,7 +683,7 @@ func (x *LeavesListRequest) String() string {
 func (*LeavesListRequest) ProtoMessage() {}
 
 func (x *LeavesListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_lm_proto_msgTypes[6]
+	mi := &file_pb_lm_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -466,7 +696,7 @@ func (x *LeavesListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeavesListRequest.ProtoReflect.Descriptor instead.
 func (*LeavesListRequest) Descriptor() ([]byte, []int) {
-	return file_pb_lm_proto_rawDescGZIP(), []int{6}
+	return file_pb_lm_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *LeavesListRequest) GetEmployeeId() string {
@@ -494,7 +724,7 @@ type LeavesListResponse struct {
 func (x *LeavesListResponse) Reset() {
 	*x = LeavesListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_lm_proto_msgTypes[7]
+		mi := &file_pb_lm_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -507,7 +737,7 @@ func (x *LeavesListResponse) String() string {
 func (*LeavesListResponse) ProtoMessage() {}
 
 func (x *LeavesListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_lm_proto_msgTypes[7]
+	mi := &file_pb_lm_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -520,7 +750,7 @@ func (x *LeavesListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeavesListResponse.ProtoReflect.Descriptor instead.
 func (*LeavesListResponse) Descriptor() ([]byte, []int) {
-	return file_pb_lm_proto_rawDescGZIP(), []int{7}
+	return file_pb_lm_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *LeavesListResponse) GetLeavesListResponse() []*GetLeaveByIdResponse {
@@ -530,32 +760,36 @@ func (x *LeavesListResponse) GetLeavesListResponse() []*GetLeaveByIdResponse {
 	return nil
 }
 
-type DeleteLeaveRequest struct {
+type ListMyLeavesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EmployeeId    string `protobuf:"bytes,1,opt,name=employeeId,proto3" json:"employeeId,omitempty"`
-	ApplicationId string `protobuf:"bytes,2,opt,name=applicationId,proto3" json:"applicationId,omitempty"`
+	EmployeeId string `protobuf:"bytes,1,opt,name=employeeId,proto3" json:"employeeId,omitempty"`
+	// leaveStatus, fromDate and toDate are optional filters. Applications
+	// overlapping the dates are returned.
+	LeaveStatus string `protobuf:"bytes,2,opt,name=leaveStatus,proto3" json:"leaveStatus,omitempty"`
+	FromDate    string `protobuf:"bytes,3,opt,name=fromDate,proto3" json:"fromDate,omitempty"`
+	ToDate      string `protobuf:"bytes,4,opt,name=toDate,proto3" json:"toDate,omitempty"`
 }
 
-func (x *DeleteLeaveRequest) Reset() {
-	*x = DeleteLeaveRequest{}
+func (x *ListMyLeavesRequest) Reset() {
+	*x = ListMyLeavesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_lm_proto_msgTypes[8]
+		mi := &file_pb_lm_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteLeaveRequest) String() string {
+func (x *ListMyLeavesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteLeaveRequest) ProtoMessage() {}
+func (*ListMyLeavesRequest) ProtoMessage() {}
 
-func (x *DeleteLeaveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_lm_proto_msgTypes[8]
+func (x *ListMyLeavesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -566,48 +800,66 @@ func (x *DeleteLeaveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteLeaveRequest.ProtoReflect.Descriptor instead.
-func (*DeleteLeaveRequest) Descriptor() ([]byte, []int) {
-	return file_pb_lm_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ListMyLeavesRequest.ProtoReflect.Descriptor instead.
+func (*ListMyLeavesRequest) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DeleteLeaveRequest) GetEmployeeId() string {
+func (x *ListMyLeavesRequest) GetEmployeeId() string {
 	if x != nil {
 		return x.EmployeeId
 	}
 	return ""
 }
 
-func (x *DeleteLeaveRequest) GetApplicationId() string {
+func (x *ListMyLeavesRequest) GetLeaveStatus() string {
 	if x != nil {
-		return x.ApplicationId
+		return x.LeaveStatus
 	}
 	return ""
 }
 
-type DeleteLeaveResponse struct {
+func (x *ListMyLeavesRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *ListMyLeavesRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+type DeleteLeaveRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	EmployeeId    string `protobuf:"bytes,1,opt,name=employeeId,proto3" json:"employeeId,omitempty"`
+	ApplicationId string `protobuf:"bytes,2,opt,name=applicationId,proto3" json:"applicationId,omitempty"`
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *DeleteLeaveResponse) Reset() {
-	*x = DeleteLeaveResponse{}
+func (x *DeleteLeaveRequest) Reset() {
+	*x = DeleteLeaveRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_lm_proto_msgTypes[9]
+		mi := &file_pb_lm_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteLeaveResponse) String() string {
+func (x *DeleteLeaveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteLeaveResponse) ProtoMessage() {}
+func (*DeleteLeaveRequest) ProtoMessage() {}
 
-func (x *DeleteLeaveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_lm_proto_msgTypes[9]
+func (x *DeleteLeaveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -618,14 +870,73 @@ func (x *DeleteLeaveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteLeaveResponse.ProtoReflect.Descriptor instead.
-func (*DeleteLeaveResponse) Descriptor() ([]byte, []int) {
-	return file_pb_lm_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use DeleteLeaveRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLeaveRequest) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{11}
 }
 
-type UpdateLeaveRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+func (x *DeleteLeaveRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *DeleteLeaveRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *DeleteLeaveRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DeleteLeaveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteLeaveResponse) Reset() {
+	*x = DeleteLeaveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteLeaveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLeaveResponse) ProtoMessage() {}
+
+func (x *DeleteLeaveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLeaveResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLeaveResponse) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{12}
+}
+
+type UpdateLeaveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	ApplicationId string `protobuf:"bytes,1,opt,name=applicationId,proto3" json:"applicationId,omitempty"`
@@ -634,12 +945,19 @@ type UpdateLeaveRequest struct {
 	FromDate      string `protobuf:"bytes,4,opt,name=fromDate,proto3" json:"fromDate,omitempty"`
 	ToDate        string `protobuf:"bytes,5,opt,name=toDate,proto3" json:"toDate,omitempty"`
 	Comment       string `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+	Version       string `protobuf:"bytes,7,opt,name=version,proto3" json:"version,omitempty"`
+	// updateMask lists the fields to change (leaveTypeId, fromDate, toDate,
+	// comment). All of them are replaced when it is empty.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,8,opt,name=updateMask,proto3" json:"updateMask,omitempty"`
+	// acceptLossOfPay takes the days beyond the balance as unpaid leave when
+	// the dates or leave type change.
+	AcceptLossOfPay bool `protobuf:"varint,9,opt,name=acceptLossOfPay,proto3" json:"acceptLossOfPay,omitempty"`
 }
 
 func (x *UpdateLeaveRequest) Reset() {
 	*x = UpdateLeaveRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_lm_proto_msgTypes[10]
+		mi := &file_pb_lm_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -652,7 +970,7 @@ func (x *UpdateLeaveRequest) String() string {
 func (*UpdateLeaveRequest) ProtoMessage() {}
 
 func (x *UpdateLeaveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_lm_proto_msgTypes[10]
+	mi := &file_pb_lm_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -665,7 +983,7 @@ func (x *UpdateLeaveRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateLeaveRequest.ProtoReflect.Descriptor instead.
 func (*UpdateLeaveRequest) Descriptor() ([]byte, []int) {
-	return file_pb_lm_proto_rawDescGZIP(), []int{10}
+	return file_pb_lm_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UpdateLeaveRequest) GetApplicationId() string {
@@ -710,16 +1028,49 @@ func (x *UpdateLeaveRequest) GetComment() string {
 	return ""
 }
 
+func (x *UpdateLeaveRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *UpdateLeaveRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateLeaveRequest) GetAcceptLossOfPay() bool {
+	if x != nil {
+		return x.AcceptLossOfPay
+	}
+	return false
+}
+
 type UpdateLeaveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// warnings lists the blackout periods the leave overlaps that do not
+	// block it.
+	Warnings []string `protobuf:"bytes,1,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	// duration is set when the dates or leave type changed.
+	Duration *LeaveDuration `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	// lossOfPayDays is set with duration to the number of days taken as
+	// unpaid leave.
+	LossOfPayDays string `protobuf:"bytes,3,opt,name=lossOfPayDays,proto3" json:"lossOfPayDays,omitempty"`
+	// advanceDays is set with duration to the number of days taken below a
+	// zero balance.
+	AdvanceDays string `protobuf:"bytes,4,opt,name=advanceDays,proto3" json:"advanceDays,omitempty"`
 }
 
 func (x *UpdateLeaveResponse) Reset() {
 	*x = UpdateLeaveResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_lm_proto_msgTypes[11]
+		mi := &file_pb_lm_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -732,7 +1083,7 @@ func (x *UpdateLeaveResponse) String() string {
 func (*UpdateLeaveResponse) ProtoMessage() {}
 
 func (x *UpdateLeaveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_lm_proto_msgTypes[11]
+	mi := &file_pb_lm_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -745,246 +1096,4815 @@ func (x *UpdateLeaveResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateLeaveResponse.ProtoReflect.Descriptor instead.
 func (*UpdateLeaveResponse) Descriptor() ([]byte, []int) {
-	return file_pb_lm_proto_rawDescGZIP(), []int{11}
+	return file_pb_lm_proto_rawDescGZIP(), []int{14}
 }
 
-var File_pb_lm_proto protoreflect.FileDescriptor
-
-var file_pb_lm_proto_rawDesc = []byte{
-	0x0a, 0x0b, 0x70, 0x62, 0x2f, 0x6c, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x6c,
-	0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xa3,
-	0x01, 0x0a, 0x11, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79,
-	0x65, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61,
-	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61,
-	0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4c, 0x65, 0x61,
-	0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x18, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f,
-	0x79, 0x65, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70,
-	0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a,
-	0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
-	0x1b, 0x0a, 0x19, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x0a, 0x13,
-	0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xbe, 0x03, 0x0a, 0x14, 0x47, 0x65,
-	0x74, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c,
-	0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d,
-	0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x65, 0x61, 0x76,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c,
-	0x65, 0x61, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x64, 0x61,
-	0x74, 0x65, 0x4f, 0x66, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x41, 0x70, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d,
-	0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d,
-	0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x6e, 0x6f, 0x4f, 0x66, 0x44, 0x61, 0x79, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x6e, 0x6f, 0x4f, 0x66, 0x44, 0x61, 0x79, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x65, 0x61, 0x76,
-	0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x6c, 0x65, 0x61, 0x76, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b,
-	0x6c, 0x65, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18,
-	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x65,
-	0x4f, 0x66, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
-	0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x55, 0x0a, 0x11, 0x4c, 0x65,
-	0x61, 0x76, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1e, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12,
-	0x20, 0x0a, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0x6b, 0x0a, 0x12, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x12, 0x6c, 0x65, 0x61, 0x76, 0x65,
-	0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x42, 0x79,
-	0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x12, 0x6c, 0x65, 0x61, 0x76,
-	0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5a,
-	0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79,
-	0x65, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0xca, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x76,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1e,
-	0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x20,
-	0x0a, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64,
-	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f,
-	0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x15,
-	0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xcf, 0x04, 0x0a, 0x16, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x69, 0x76, 0x63, 0x65,
-	0x12, 0x57, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x12, 0x22,
-	0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x11, 0x43, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29,
-	0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6c, 0x65, 0x61, 0x76,
-	0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0a, 0x4c, 0x65, 0x61, 0x76, 0x65,
-	0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x22, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x65, 0x61, 0x76,
-	0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x65, 0x61, 0x76,
-	0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x5d, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x42, 0x79, 0x49, 0x64,
-	0x12, 0x24, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x76,
-	0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x5a, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x12, 0x23,
-	0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x76,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0b, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x12, 0x23, 0x2e, 0x6c, 0x65, 0x61,
-	0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x24, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x05, 0x5a, 0x03, 0x2f, 0x70, 0x62, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *UpdateLeaveResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
 }
 
-var (
-	file_pb_lm_proto_rawDescOnce sync.Once
-	file_pb_lm_proto_rawDescData = file_pb_lm_proto_rawDesc
-)
+func (x *UpdateLeaveResponse) GetDuration() *LeaveDuration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
 
-func file_pb_lm_proto_rawDescGZIP() []byte {
-	file_pb_lm_proto_rawDescOnce.Do(func() {
-		file_pb_lm_proto_rawDescData = protoimpl.X.CompressGZIP(file_pb_lm_proto_rawDescData)
-	})
-	return file_pb_lm_proto_rawDescData
+func (x *UpdateLeaveResponse) GetLossOfPayDays() string {
+	if x != nil {
+		return x.LossOfPayDays
+	}
+	return ""
 }
 
-var file_pb_lm_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
-var file_pb_lm_proto_goTypes = []interface{}{
-	(*ApplyLeaveRequest)(nil),         // 0: leaveManagement.ApplyLeaveRequest
-	(*ApplyLeaveResponse)(nil),        // 1: leaveManagement.ApplyLeaveResponse
-	(*ChangeLeaveStatusRequest)(nil),  // 2: leaveManagement.ChangeLeaveStatusRequest
-	(*ChangeLeaveStatusResponse)(nil), // 3: leaveManagement.ChangeLeaveStatusResponse
-	(*GetLeaveByIdRequest)(nil),       // 4: leaveManagement.GetLeaveByIdRequest
-	(*GetLeaveByIdResponse)(nil),      // 5: leaveManagement.GetLeaveByIdResponse
-	(*LeavesListRequest)(nil),         // 6: leaveManagement.LeavesListRequest
-	(*LeavesListResponse)(nil),        // 7: leaveManagement.LeavesListResponse
-	(*DeleteLeaveRequest)(nil),        // 8: leaveManagement.DeleteLeaveRequest
-	(*DeleteLeaveResponse)(nil),       // 9: leaveManagement.DeleteLeaveResponse
-	(*UpdateLeaveRequest)(nil),        // 10: leaveManagement.UpdateLeaveRequest
-	(*UpdateLeaveResponse)(nil),       // 11: leaveManagement.UpdateLeaveResponse
+func (x *UpdateLeaveResponse) GetAdvanceDays() string {
+	if x != nil {
+		return x.AdvanceDays
+	}
+	return ""
 }
-var file_pb_lm_proto_depIdxs = []int32{
-	5,  // 0: leaveManagement.LeavesListResponse.leavesListResponse:type_name -> leaveManagement.GetLeaveByIdResponse
-	0,  // 1: leaveManagement.leaveManagementSerivce.ApplyLeave:input_type -> leaveManagement.ApplyLeaveRequest
-	2,  // 2: leaveManagement.leaveManagementSerivce.ChangeLeaveStatus:input_type -> leaveManagement.ChangeLeaveStatusRequest
-	6,  // 3: leaveManagement.leaveManagementSerivce.LeavesList:input_type -> leaveManagement.LeavesListRequest
-	4,  // 4: leaveManagement.leaveManagementSerivce.GetLeaveById:input_type -> leaveManagement.GetLeaveByIdRequest
-	8,  // 5: leaveManagement.leaveManagementSerivce.DeleteLeave:input_type -> leaveManagement.DeleteLeaveRequest
-	10, // 6: leaveManagement.leaveManagementSerivce.UpdateLeave:input_type -> leaveManagement.UpdateLeaveRequest
-	1,  // 7: leaveManagement.leaveManagementSerivce.ApplyLeave:output_type -> leaveManagement.ApplyLeaveResponse
-	3,  // 8: leaveManagement.leaveManagementSerivce.ChangeLeaveStatus:output_type -> leaveManagement.ChangeLeaveStatusResponse
-	7,  // 9: leaveManagement.leaveManagementSerivce.LeavesList:output_type -> leaveManagement.LeavesListResponse
-	5,  // 10: leaveManagement.leaveManagementSerivce.GetLeaveById:output_type -> leaveManagement.GetLeaveByIdResponse
-	9,  // 11: leaveManagement.leaveManagementSerivce.DeleteLeave:output_type -> leaveManagement.DeleteLeaveResponse
-	11, // 12: leaveManagement.leaveManagementSerivce.UpdateLeave:output_type -> leaveManagement.UpdateLeaveResponse
-	7,  // [7:13] is the sub-list for method output_type
-	1,  // [1:7] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
+
+type RestoreLeaveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmployeeId    string `protobuf:"bytes,1,opt,name=employeeId,proto3" json:"employeeId,omitempty"`
+	ApplicationId string `protobuf:"bytes,2,opt,name=applicationId,proto3" json:"applicationId,omitempty"`
 }
 
-func init() { file_pb_lm_proto_init() }
-func file_pb_lm_proto_init() {
-	if File_pb_lm_proto != nil {
-		return
+func (x *RestoreLeaveRequest) Reset() {
+	*x = RestoreLeaveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_pb_lm_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApplyLeaveRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+}
+
+func (x *RestoreLeaveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreLeaveRequest) ProtoMessage() {}
+
+func (x *RestoreLeaveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_pb_lm_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApplyLeaveResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreLeaveRequest.ProtoReflect.Descriptor instead.
+func (*RestoreLeaveRequest) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RestoreLeaveRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *RestoreLeaveRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+type RestoreLeaveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RestoreLeaveResponse) Reset() {
+	*x = RestoreLeaveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreLeaveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreLeaveResponse) ProtoMessage() {}
+
+func (x *RestoreLeaveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_pb_lm_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangeLeaveStatusRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreLeaveResponse.ProtoReflect.Descriptor instead.
+func (*RestoreLeaveResponse) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{16}
+}
+
+type GetLeaveBalancesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmployeeId string `protobuf:"bytes,1,opt,name=employeeId,proto3" json:"employeeId,omitempty"`
+	// targetEmployeeId is the employee whose balances are returned, the
+	// caller when empty.
+	TargetEmployeeId string `protobuf:"bytes,2,opt,name=targetEmployeeId,proto3" json:"targetEmployeeId,omitempty"`
+	// year is the leave year, the current one when empty.
+	Year string `protobuf:"bytes,3,opt,name=year,proto3" json:"year,omitempty"`
+}
+
+func (x *GetLeaveBalancesRequest) Reset() {
+	*x = GetLeaveBalancesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLeaveBalancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaveBalancesRequest) ProtoMessage() {}
+
+func (x *GetLeaveBalancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_pb_lm_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangeLeaveStatusResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaveBalancesRequest.ProtoReflect.Descriptor instead.
+func (*GetLeaveBalancesRequest) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetLeaveBalancesRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *GetLeaveBalancesRequest) GetTargetEmployeeId() string {
+	if x != nil {
+		return x.TargetEmployeeId
+	}
+	return ""
+}
+
+func (x *GetLeaveBalancesRequest) GetYear() string {
+	if x != nil {
+		return x.Year
+	}
+	return ""
+}
+
+type LeaveBalance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaveTypeId string `protobuf:"bytes,1,opt,name=leaveTypeId,proto3" json:"leaveTypeId,omitempty"`
+	LeaveName   string `protobuf:"bytes,2,opt,name=leaveName,proto3" json:"leaveName,omitempty"`
+	Entitlement string `protobuf:"bytes,3,opt,name=entitlement,proto3" json:"entitlement,omitempty"`
+	Taken       string `protobuf:"bytes,4,opt,name=taken,proto3" json:"taken,omitempty"`
+	Pending     string `protobuf:"bytes,5,opt,name=pending,proto3" json:"pending,omitempty"`
+	Scheduled   string `protobuf:"bytes,6,opt,name=scheduled,proto3" json:"scheduled,omitempty"`
+	Available   string `protobuf:"bytes,7,opt,name=available,proto3" json:"available,omitempty"`
+	// encashed is the days encashed or waiting for HR to approve it.
+	Encashed string `protobuf:"bytes,8,opt,name=encashed,proto3" json:"encashed,omitempty"`
+	// lossOfPay is the days taken as unpaid leave on approved and pending
+	// applications, not counted in taken, scheduled or pending.
+	LossOfPay string `protobuf:"bytes,9,opt,name=lossOfPay,proto3" json:"lossOfPay,omitempty"`
+	// advance is the days taken below a zero balance on approved and
+	// pending applications, included in taken, scheduled and pending.
+	Advance string `protobuf:"bytes,10,opt,name=advance,proto3" json:"advance,omitempty"`
+	// advanceRecovered is the days advanced in the leave year before that
+	// come off this year's entitlement.
+	AdvanceRecovered string `protobuf:"bytes,11,opt,name=advanceRecovered,proto3" json:"advanceRecovered,omitempty"`
+	// adjusted is the days HR credited, or debited when negative, with
+	// AdjustBalance.
+	Adjusted string `protobuf:"bytes,12,opt,name=adjusted,proto3" json:"adjusted,omitempty"`
+}
+
+func (x *LeaveBalance) Reset() {
+	*x = LeaveBalance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaveBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveBalance) ProtoMessage() {}
+
+func (x *LeaveBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveBalance.ProtoReflect.Descriptor instead.
+func (*LeaveBalance) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *LeaveBalance) GetLeaveTypeId() string {
+	if x != nil {
+		return x.LeaveTypeId
+	}
+	return ""
+}
+
+func (x *LeaveBalance) GetLeaveName() string {
+	if x != nil {
+		return x.LeaveName
+	}
+	return ""
+}
+
+func (x *LeaveBalance) GetEntitlement() string {
+	if x != nil {
+		return x.Entitlement
+	}
+	return ""
+}
+
+func (x *LeaveBalance) GetTaken() string {
+	if x != nil {
+		return x.Taken
+	}
+	return ""
+}
+
+func (x *LeaveBalance) GetPending() string {
+	if x != nil {
+		return x.Pending
+	}
+	return ""
+}
+
+func (x *LeaveBalance) GetScheduled() string {
+	if x != nil {
+		return x.Scheduled
+	}
+	return ""
+}
+
+func (x *LeaveBalance) GetAvailable() string {
+	if x != nil {
+		return x.Available
+	}
+	return ""
+}
+
+func (x *LeaveBalance) GetEncashed() string {
+	if x != nil {
+		return x.Encashed
+	}
+	return ""
+}
+
+func (x *LeaveBalance) GetLossOfPay() string {
+	if x != nil {
+		return x.LossOfPay
+	}
+	return ""
+}
+
+func (x *LeaveBalance) GetAdvance() string {
+	if x != nil {
+		return x.Advance
+	}
+	return ""
+}
+
+func (x *LeaveBalance) GetAdvanceRecovered() string {
+	if x != nil {
+		return x.AdvanceRecovered
+	}
+	return ""
+}
+
+func (x *LeaveBalance) GetAdjusted() string {
+	if x != nil {
+		return x.Adjusted
+	}
+	return ""
+}
+
+type GetLeaveBalancesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmployeeId    string          `protobuf:"bytes,1,opt,name=employeeId,proto3" json:"employeeId,omitempty"`
+	Year          string          `protobuf:"bytes,2,opt,name=year,proto3" json:"year,omitempty"`
+	LeaveBalances []*LeaveBalance `protobuf:"bytes,3,rep,name=leaveBalances,proto3" json:"leaveBalances,omitempty"`
+}
+
+func (x *GetLeaveBalancesResponse) Reset() {
+	*x = GetLeaveBalancesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLeaveBalancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaveBalancesResponse) ProtoMessage() {}
+
+func (x *GetLeaveBalancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaveBalancesResponse.ProtoReflect.Descriptor instead.
+func (*GetLeaveBalancesResponse) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetLeaveBalancesResponse) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *GetLeaveBalancesResponse) GetYear() string {
+	if x != nil {
+		return x.Year
+	}
+	return ""
+}
+
+func (x *GetLeaveBalancesResponse) GetLeaveBalances() []*LeaveBalance {
+	if x != nil {
+		return x.LeaveBalances
+	}
+	return nil
+}
+
+type ListAuditEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmployeeId    string `protobuf:"bytes,1,opt,name=employeeId,proto3" json:"employeeId,omitempty"`
+	ApplicationId string `protobuf:"bytes,2,opt,name=applicationId,proto3" json:"applicationId,omitempty"`
+	ApplicantId   string `protobuf:"bytes,3,opt,name=applicantId,proto3" json:"applicantId,omitempty"`
+	ActorId       string `protobuf:"bytes,4,opt,name=actorId,proto3" json:"actorId,omitempty"`
+	Subject       string `protobuf:"bytes,5,opt,name=subject,proto3" json:"subject,omitempty"`
+	SubjectId     string `protobuf:"bytes,6,opt,name=subjectId,proto3" json:"subjectId,omitempty"`
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListAuditEventsRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetApplicantId() string {
+	if x != nil {
+		return x.ApplicantId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+type AuditEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventId       string `protobuf:"bytes,1,opt,name=eventId,proto3" json:"eventId,omitempty"`
+	ApplicationId string `protobuf:"bytes,2,opt,name=applicationId,proto3" json:"applicationId,omitempty"`
+	ApplicantId   string `protobuf:"bytes,3,opt,name=applicantId,proto3" json:"applicantId,omitempty"`
+	ActorId       string `protobuf:"bytes,4,opt,name=actorId,proto3" json:"actorId,omitempty"`
+	Action        string `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	Before        string `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
+	After         string `protobuf:"bytes,7,opt,name=after,proto3" json:"after,omitempty"`
+	CreatedAt     string `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	PrevHash      string `protobuf:"bytes,9,opt,name=prevHash,proto3" json:"prevHash,omitempty"`
+	Hash          string `protobuf:"bytes,10,opt,name=hash,proto3" json:"hash,omitempty"`
+	Subject       string `protobuf:"bytes,11,opt,name=subject,proto3" json:"subject,omitempty"`
+	SubjectId     string `protobuf:"bytes,12,opt,name=subjectId,proto3" json:"subjectId,omitempty"`
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AuditEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetApplicantId() string {
+	if x != nil {
+		return x.ApplicantId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetPrevHash() string {
+	if x != nil {
+		return x.PrevHash
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+type ListAuditEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuditEvents []*AuditEvent `protobuf:"bytes,1,rep,name=auditEvents,proto3" json:"auditEvents,omitempty"`
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListAuditEventsResponse) GetAuditEvents() []*AuditEvent {
+	if x != nil {
+		return x.AuditEvents
+	}
+	return nil
+}
+
+type TeamCalendarRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmployeeId string `protobuf:"bytes,1,opt,name=employeeId,proto3" json:"employeeId,omitempty"`
+	// managerId is the manager whose team is shown, the caller when empty.
+	// Only HR may name another manager.
+	ManagerId string `protobuf:"bytes,2,opt,name=managerId,proto3" json:"managerId,omitempty"`
+	FromDate  string `protobuf:"bytes,3,opt,name=fromDate,proto3" json:"fromDate,omitempty"`
+	ToDate    string `protobuf:"bytes,4,opt,name=toDate,proto3" json:"toDate,omitempty"`
+}
+
+func (x *TeamCalendarRequest) Reset() {
+	*x = TeamCalendarRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TeamCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamCalendarRequest) ProtoMessage() {}
+
+func (x *TeamCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamCalendarRequest.ProtoReflect.Descriptor instead.
+func (*TeamCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TeamCalendarRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *TeamCalendarRequest) GetManagerId() string {
+	if x != nil {
+		return x.ManagerId
+	}
+	return ""
+}
+
+func (x *TeamCalendarRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *TeamCalendarRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+type TeamMemberLeave struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmployeeId    string `protobuf:"bytes,1,opt,name=employeeId,proto3" json:"employeeId,omitempty"`
+	FirstName     string `protobuf:"bytes,2,opt,name=firstName,proto3" json:"firstName,omitempty"`
+	LastName      string `protobuf:"bytes,3,opt,name=lastName,proto3" json:"lastName,omitempty"`
+	ApplicationId string `protobuf:"bytes,4,opt,name=applicationId,proto3" json:"applicationId,omitempty"`
+	LeaveTypeId   string `protobuf:"bytes,5,opt,name=leaveTypeId,proto3" json:"leaveTypeId,omitempty"`
+	LeaveStatus   string `protobuf:"bytes,6,opt,name=leaveStatus,proto3" json:"leaveStatus,omitempty"`
+}
+
+func (x *TeamMemberLeave) Reset() {
+	*x = TeamMemberLeave{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TeamMemberLeave) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamMemberLeave) ProtoMessage() {}
+
+func (x *TeamMemberLeave) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamMemberLeave.ProtoReflect.Descriptor instead.
+func (*TeamMemberLeave) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TeamMemberLeave) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *TeamMemberLeave) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *TeamMemberLeave) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *TeamMemberLeave) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *TeamMemberLeave) GetLeaveTypeId() string {
+	if x != nil {
+		return x.LeaveTypeId
+	}
+	return ""
+}
+
+func (x *TeamMemberLeave) GetLeaveStatus() string {
+	if x != nil {
+		return x.LeaveStatus
+	}
+	return ""
+}
+
+type TeamCalendarDay struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// holiday is the name of the holiday on this date, empty on working days.
+	Holiday string             `protobuf:"bytes,2,opt,name=holiday,proto3" json:"holiday,omitempty"`
+	OnLeave []*TeamMemberLeave `protobuf:"bytes,3,rep,name=onLeave,proto3" json:"onLeave,omitempty"`
+	// available is the team size less the members on approved leave, zero
+	// on holidays.
+	Available string `protobuf:"bytes,4,opt,name=available,proto3" json:"available,omitempty"`
+	// availableIfApproved also leaves out the members with pending leave.
+	AvailableIfApproved string `protobuf:"bytes,5,opt,name=availableIfApproved,proto3" json:"availableIfApproved,omitempty"`
+}
+
+func (x *TeamCalendarDay) Reset() {
+	*x = TeamCalendarDay{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TeamCalendarDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamCalendarDay) ProtoMessage() {}
+
+func (x *TeamCalendarDay) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamCalendarDay.ProtoReflect.Descriptor instead.
+func (*TeamCalendarDay) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TeamCalendarDay) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *TeamCalendarDay) GetHoliday() string {
+	if x != nil {
+		return x.Holiday
+	}
+	return ""
+}
+
+func (x *TeamCalendarDay) GetOnLeave() []*TeamMemberLeave {
+	if x != nil {
+		return x.OnLeave
+	}
+	return nil
+}
+
+func (x *TeamCalendarDay) GetAvailable() string {
+	if x != nil {
+		return x.Available
+	}
+	return ""
+}
+
+func (x *TeamCalendarDay) GetAvailableIfApproved() string {
+	if x != nil {
+		return x.AvailableIfApproved
+	}
+	return ""
+}
+
+type TeamCalendarResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ManagerId string             `protobuf:"bytes,1,opt,name=managerId,proto3" json:"managerId,omitempty"`
+	TeamSize  string             `protobuf:"bytes,2,opt,name=teamSize,proto3" json:"teamSize,omitempty"`
+	Days      []*TeamCalendarDay `protobuf:"bytes,3,rep,name=days,proto3" json:"days,omitempty"`
+}
+
+func (x *TeamCalendarResponse) Reset() {
+	*x = TeamCalendarResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TeamCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamCalendarResponse) ProtoMessage() {}
+
+func (x *TeamCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamCalendarResponse.ProtoReflect.Descriptor instead.
+func (*TeamCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TeamCalendarResponse) GetManagerId() string {
+	if x != nil {
+		return x.ManagerId
+	}
+	return ""
+}
+
+func (x *TeamCalendarResponse) GetTeamSize() string {
+	if x != nil {
+		return x.TeamSize
+	}
+	return ""
+}
+
+func (x *TeamCalendarResponse) GetDays() []*TeamCalendarDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+type BlackoutPeriod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlackoutId string `protobuf:"bytes,1,opt,name=blackoutId,proto3" json:"blackoutId,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	FromDate   string `protobuf:"bytes,3,opt,name=fromDate,proto3" json:"fromDate,omitempty"`
+	ToDate     string `protobuf:"bytes,4,opt,name=toDate,proto3" json:"toDate,omitempty"`
+	// designationId, managerId and leaveTypeId narrow the period down to a
+	// designation, the team of a manager and a leave type, all when empty.
+	DesignationId      string   `protobuf:"bytes,5,opt,name=designationId,proto3" json:"designationId,omitempty"`
+	ManagerId          string   `protobuf:"bytes,6,opt,name=managerId,proto3" json:"managerId,omitempty"`
+	LeaveTypeId        string   `protobuf:"bytes,7,opt,name=leaveTypeId,proto3" json:"leaveTypeId,omitempty"`
+	ExemptLeaveTypeIds []string `protobuf:"bytes,8,rep,name=exemptLeaveTypeIds,proto3" json:"exemptLeaveTypeIds,omitempty"`
+	// enforcement is BLOCK to reject overlapping applications, the default,
+	// or WARN to accept them with a warning.
+	Enforcement string `protobuf:"bytes,9,opt,name=enforcement,proto3" json:"enforcement,omitempty"`
+}
+
+func (x *BlackoutPeriod) Reset() {
+	*x = BlackoutPeriod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlackoutPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlackoutPeriod) ProtoMessage() {}
+
+func (x *BlackoutPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlackoutPeriod.ProtoReflect.Descriptor instead.
+func (*BlackoutPeriod) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BlackoutPeriod) GetBlackoutId() string {
+	if x != nil {
+		return x.BlackoutId
+	}
+	return ""
+}
+
+func (x *BlackoutPeriod) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BlackoutPeriod) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *BlackoutPeriod) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+func (x *BlackoutPeriod) GetDesignationId() string {
+	if x != nil {
+		return x.DesignationId
+	}
+	return ""
+}
+
+func (x *BlackoutPeriod) GetManagerId() string {
+	if x != nil {
+		return x.ManagerId
+	}
+	return ""
+}
+
+func (x *BlackoutPeriod) GetLeaveTypeId() string {
+	if x != nil {
+		return x.LeaveTypeId
+	}
+	return ""
+}
+
+func (x *BlackoutPeriod) GetExemptLeaveTypeIds() []string {
+	if x != nil {
+		return x.ExemptLeaveTypeIds
+	}
+	return nil
+}
+
+func (x *BlackoutPeriod) GetEnforcement() string {
+	if x != nil {
+		return x.Enforcement
+	}
+	return ""
+}
+
+type CreateBlackoutPeriodRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmployeeId     string          `protobuf:"bytes,1,opt,name=employeeId,proto3" json:"employeeId,omitempty"`
+	BlackoutPeriod *BlackoutPeriod `protobuf:"bytes,2,opt,name=blackoutPeriod,proto3" json:"blackoutPeriod,omitempty"`
+}
+
+func (x *CreateBlackoutPeriodRequest) Reset() {
+	*x = CreateBlackoutPeriodRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBlackoutPeriodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBlackoutPeriodRequest) ProtoMessage() {}
+
+func (x *CreateBlackoutPeriodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBlackoutPeriodRequest.ProtoReflect.Descriptor instead.
+func (*CreateBlackoutPeriodRequest) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateBlackoutPeriodRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *CreateBlackoutPeriodRequest) GetBlackoutPeriod() *BlackoutPeriod {
+	if x != nil {
+		return x.BlackoutPeriod
+	}
+	return nil
+}
+
+type CreateBlackoutPeriodResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlackoutId string `protobuf:"bytes,1,opt,name=blackoutId,proto3" json:"blackoutId,omitempty"`
+}
+
+func (x *CreateBlackoutPeriodResponse) Reset() {
+	*x = CreateBlackoutPeriodResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBlackoutPeriodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBlackoutPeriodResponse) ProtoMessage() {}
+
+func (x *CreateBlackoutPeriodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBlackoutPeriodResponse.ProtoReflect.Descriptor instead.
+func (*CreateBlackoutPeriodResponse) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateBlackoutPeriodResponse) GetBlackoutId() string {
+	if x != nil {
+		return x.BlackoutId
+	}
+	return ""
+}
+
+type ListBlackoutPeriodsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmployeeId string `protobuf:"bytes,1,opt,name=employeeId,proto3" json:"employeeId,omitempty"`
+	FromDate   string `protobuf:"bytes,2,opt,name=fromDate,proto3" json:"fromDate,omitempty"`
+	ToDate     string `protobuf:"bytes,3,opt,name=toDate,proto3" json:"toDate,omitempty"`
+}
+
+func (x *ListBlackoutPeriodsRequest) Reset() {
+	*x = ListBlackoutPeriodsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBlackoutPeriodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlackoutPeriodsRequest) ProtoMessage() {}
+
+func (x *ListBlackoutPeriodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlackoutPeriodsRequest.ProtoReflect.Descriptor instead.
+func (*ListBlackoutPeriodsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListBlackoutPeriodsRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *ListBlackoutPeriodsRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *ListBlackoutPeriodsRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+type ListBlackoutPeriodsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlackoutPeriods []*BlackoutPeriod `protobuf:"bytes,1,rep,name=blackoutPeriods,proto3" json:"blackoutPeriods,omitempty"`
+}
+
+func (x *ListBlackoutPeriodsResponse) Reset() {
+	*x = ListBlackoutPeriodsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBlackoutPeriodsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlackoutPeriodsResponse) ProtoMessage() {}
+
+func (x *ListBlackoutPeriodsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlackoutPeriodsResponse.ProtoReflect.Descriptor instead.
+func (*ListBlackoutPeriodsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListBlackoutPeriodsResponse) GetBlackoutPeriods() []*BlackoutPeriod {
+	if x != nil {
+		return x.BlackoutPeriods
+	}
+	return nil
+}
+
+type DeleteBlackoutPeriodRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmployeeId string `protobuf:"bytes,1,opt,name=employeeId,proto3" json:"employeeId,omitempty"`
+	BlackoutId string `protobuf:"bytes,2,opt,name=blackoutId,proto3" json:"blackoutId,omitempty"`
+}
+
+func (x *DeleteBlackoutPeriodRequest) Reset() {
+	*x = DeleteBlackoutPeriodRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBlackoutPeriodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBlackoutPeriodRequest) ProtoMessage() {}
+
+func (x *DeleteBlackoutPeriodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBlackoutPeriodRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBlackoutPeriodRequest) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeleteBlackoutPeriodRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *DeleteBlackoutPeriodRequest) GetBlackoutId() string {
+	if x != nil {
+		return x.BlackoutId
+	}
+	return ""
+}
+
+type DeleteBlackoutPeriodResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteBlackoutPeriodResponse) Reset() {
+	*x = DeleteBlackoutPeriodResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBlackoutPeriodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBlackoutPeriodResponse) ProtoMessage() {}
+
+func (x *DeleteBlackoutPeriodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBlackoutPeriodResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBlackoutPeriodResponse) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{33}
+}
+
+type ListEligibleLeaveTypesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmployeeId string `protobuf:"bytes,1,opt,name=employeeId,proto3" json:"employeeId,omitempty"`
+}
+
+func (x *ListEligibleLeaveTypesRequest) Reset() {
+	*x = ListEligibleLeaveTypesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEligibleLeaveTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEligibleLeaveTypesRequest) ProtoMessage() {}
+
+func (x *ListEligibleLeaveTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEligibleLeaveTypesRequest.ProtoReflect.Descriptor instead.
+func (*ListEligibleLeaveTypesRequest) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListEligibleLeaveTypesRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+type LeaveType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaveTypeId         string `protobuf:"bytes,1,opt,name=leaveTypeId,proto3" json:"leaveTypeId,omitempty"`
+	LeaveName           string `protobuf:"bytes,2,opt,name=leaveName,proto3" json:"leaveName,omitempty"`
+	NumberOfDaysAllowed string `protobuf:"bytes,3,opt,name=numberOfDaysAllowed,proto3" json:"numberOfDaysAllowed,omitempty"`
+}
+
+func (x *LeaveType) Reset() {
+	*x = LeaveType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaveType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveType) ProtoMessage() {}
+
+func (x *LeaveType) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveType.ProtoReflect.Descriptor instead.
+func (*LeaveType) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *LeaveType) GetLeaveTypeId() string {
+	if x != nil {
+		return x.LeaveTypeId
+	}
+	return ""
+}
+
+func (x *LeaveType) GetLeaveName() string {
+	if x != nil {
+		return x.LeaveName
+	}
+	return ""
+}
+
+func (x *LeaveType) GetNumberOfDaysAllowed() string {
+	if x != nil {
+		return x.NumberOfDaysAllowed
+	}
+	return ""
+}
+
+type ListEligibleLeaveTypesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaveTypes []*LeaveType `protobuf:"bytes,1,rep,name=leaveTypes,proto3" json:"leaveTypes,omitempty"`
+}
+
+func (x *ListEligibleLeaveTypesResponse) Reset() {
+	*x = ListEligibleLeaveTypesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEligibleLeaveTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEligibleLeaveTypesResponse) ProtoMessage() {}
+
+func (x *ListEligibleLeaveTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEligibleLeaveTypesResponse.ProtoReflect.Descriptor instead.
+func (*ListEligibleLeaveTypesResponse) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListEligibleLeaveTypesResponse) GetLeaveTypes() []*LeaveType {
+	if x != nil {
+		return x.LeaveTypes
+	}
+	return nil
+}
+
+type CompOff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompOffId  string `protobuf:"bytes,1,opt,name=compOffId,proto3" json:"compOffId,omitempty"`
+	EmployeeId string `protobuf:"bytes,2,opt,name=employeeId,proto3" json:"employeeId,omitempty"`
+	WorkedDate string `protobuf:"bytes,3,opt,name=workedDate,proto3" json:"workedDate,omitempty"`
+	Reason     string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// status is 0 pending, 1 approved, 2 declined, 3 lapsed unused and 4
+	// used before it expired.
+	Status string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	// expiresOn is the last day the credit can be spent, set on approval.
+	ExpiresOn string `protobuf:"bytes,6,opt,name=expiresOn,proto3" json:"expiresOn,omitempty"`
+}
+
+func (x *CompOff) Reset() {
+	*x = CompOff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompOff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompOff) ProtoMessage() {}
+
+func (x *CompOff) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompOff.ProtoReflect.Descriptor instead.
+func (*CompOff) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CompOff) GetCompOffId() string {
+	if x != nil {
+		return x.CompOffId
+	}
+	return ""
+}
+
+func (x *CompOff) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *CompOff) GetWorkedDate() string {
+	if x != nil {
+		return x.WorkedDate
+	}
+	return ""
+}
+
+func (x *CompOff) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CompOff) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CompOff) GetExpiresOn() string {
+	if x != nil {
+		return x.ExpiresOn
+	}
+	return ""
+}
+
+type RequestCompOffRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmployeeId string `protobuf:"bytes,1,opt,name=employeeId,proto3" json:"employeeId,omitempty"`
+	WorkedDate string `protobuf:"bytes,2,opt,name=workedDate,proto3" json:"workedDate,omitempty"`
+	Reason     string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *RequestCompOffRequest) Reset() {
+	*x = RequestCompOffRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestCompOffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestCompOffRequest) ProtoMessage() {}
+
+func (x *RequestCompOffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestCompOffRequest.ProtoReflect.Descriptor instead.
+func (*RequestCompOffRequest) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RequestCompOffRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *RequestCompOffRequest) GetWorkedDate() string {
+	if x != nil {
+		return x.WorkedDate
+	}
+	return ""
+}
+
+func (x *RequestCompOffRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RequestCompOffResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompOffId string `protobuf:"bytes,1,opt,name=compOffId,proto3" json:"compOffId,omitempty"`
+}
+
+func (x *RequestCompOffResponse) Reset() {
+	*x = RequestCompOffResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestCompOffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestCompOffResponse) ProtoMessage() {}
+
+func (x *RequestCompOffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestCompOffResponse.ProtoReflect.Descriptor instead.
+func (*RequestCompOffResponse) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RequestCompOffResponse) GetCompOffId() string {
+	if x != nil {
+		return x.CompOffId
+	}
+	return ""
+}
+
+type ChangeCompOffStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmployeeId string `protobuf:"bytes,1,opt,name=employeeId,proto3" json:"employeeId,omitempty"`
+	CompOffId  string `protobuf:"bytes,2,opt,name=compOffId,proto3" json:"compOffId,omitempty"`
+	Status     string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ChangeCompOffStatusRequest) Reset() {
+	*x = ChangeCompOffStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeCompOffStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeCompOffStatusRequest) ProtoMessage() {}
+
+func (x *ChangeCompOffStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeCompOffStatusRequest.ProtoReflect.Descriptor instead.
+func (*ChangeCompOffStatusRequest) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ChangeCompOffStatusRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *ChangeCompOffStatusRequest) GetCompOffId() string {
+	if x != nil {
+		return x.CompOffId
+	}
+	return ""
+}
+
+func (x *ChangeCompOffStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ChangeCompOffStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ChangeCompOffStatusResponse) Reset() {
+	*x = ChangeCompOffStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeCompOffStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeCompOffStatusResponse) ProtoMessage() {}
+
+func (x *ChangeCompOffStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeCompOffStatusResponse.ProtoReflect.Descriptor instead.
+func (*ChangeCompOffStatusResponse) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{41}
+}
+
+type ListCompOffsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmployeeId string `protobuf:"bytes,1,opt,name=employeeId,proto3" json:"employeeId,omitempty"`
+	// targetEmployeeId is the employee whose comp-offs are returned, the
+	// caller when empty.
+	TargetEmployeeId string `protobuf:"bytes,2,opt,name=targetEmployeeId,proto3" json:"targetEmployeeId,omitempty"`
+	Status           string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ListCompOffsRequest) Reset() {
+	*x = ListCompOffsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCompOffsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompOffsRequest) ProtoMessage() {}
+
+func (x *ListCompOffsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompOffsRequest.ProtoReflect.Descriptor instead.
+func (*ListCompOffsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListCompOffsRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *ListCompOffsRequest) GetTargetEmployeeId() string {
+	if x != nil {
+		return x.TargetEmployeeId
+	}
+	return ""
+}
+
+func (x *ListCompOffsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListCompOffsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CompOffs []*CompOff `protobuf:"bytes,1,rep,name=compOffs,proto3" json:"compOffs,omitempty"`
+}
+
+func (x *ListCompOffsResponse) Reset() {
+	*x = ListCompOffsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCompOffsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompOffsResponse) ProtoMessage() {}
+
+func (x *ListCompOffsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompOffsResponse.ProtoReflect.Descriptor instead.
+func (*ListCompOffsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListCompOffsResponse) GetCompOffs() []*CompOff {
+	if x != nil {
+		return x.CompOffs
+	}
+	return nil
+}
+
+type Encashment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EncashmentId string `protobuf:"bytes,1,opt,name=encashmentId,proto3" json:"encashmentId,omitempty"`
+	EmployeeId   string `protobuf:"bytes,2,opt,name=employeeId,proto3" json:"employeeId,omitempty"`
+	LeaveTypeId  string `protobuf:"bytes,3,opt,name=leaveTypeId,proto3" json:"leaveTypeId,omitempty"`
+	// year is the leave year whose balance the days are taken from.
+	Year string `protobuf:"bytes,4,opt,name=year,proto3" json:"year,omitempty"`
+	Days string `protobuf:"bytes,5,opt,name=days,proto3" json:"days,omitempty"`
+	// occasion is YEAR_END or EXIT.
+	Occasion string `protobuf:"bytes,6,opt,name=occasion,proto3" json:"occasion,omitempty"`
+	// status is 0 pending, 1 approved and 2 declined.
+	Status        string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	MonthlySalary string `protobuf:"bytes,8,opt,name=monthlySalary,proto3" json:"monthlySalary,omitempty"`
+	DayRate       string `protobuf:"bytes,9,opt,name=dayRate,proto3" json:"dayRate,omitempty"`
+	Amount        string `protobuf:"bytes,10,opt,name=amount,proto3" json:"amount,omitempty"`
+	// formula shows how the day rate was worked out.
+	Formula     string `protobuf:"bytes,11,opt,name=formula,proto3" json:"formula,omitempty"`
+	RequestedAt string `protobuf:"bytes,12,opt,name=requestedAt,proto3" json:"requestedAt,omitempty"`
+	DecidedBy   string `protobuf:"bytes,13,opt,name=decidedBy,proto3" json:"decidedBy,omitempty"`
+	DecidedAt   string `protobuf:"bytes,14,opt,name=decidedAt,proto3" json:"decidedAt,omitempty"`
+}
+
+func (x *Encashment) Reset() {
+	*x = Encashment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Encashment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Encashment) ProtoMessage() {}
+
+func (x *Encashment) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Encashment.ProtoReflect.Descriptor instead.
+func (*Encashment) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Encashment) GetEncashmentId() string {
+	if x != nil {
+		return x.EncashmentId
+	}
+	return ""
+}
+
+func (x *Encashment) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *Encashment) GetLeaveTypeId() string {
+	if x != nil {
+		return x.LeaveTypeId
+	}
+	return ""
+}
+
+func (x *Encashment) GetYear() string {
+	if x != nil {
+		return x.Year
+	}
+	return ""
+}
+
+func (x *Encashment) GetDays() string {
+	if x != nil {
+		return x.Days
+	}
+	return ""
+}
+
+func (x *Encashment) GetOccasion() string {
+	if x != nil {
+		return x.Occasion
+	}
+	return ""
+}
+
+func (x *Encashment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Encashment) GetMonthlySalary() string {
+	if x != nil {
+		return x.MonthlySalary
+	}
+	return ""
+}
+
+func (x *Encashment) GetDayRate() string {
+	if x != nil {
+		return x.DayRate
+	}
+	return ""
+}
+
+func (x *Encashment) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *Encashment) GetFormula() string {
+	if x != nil {
+		return x.Formula
+	}
+	return ""
+}
+
+func (x *Encashment) GetRequestedAt() string {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return ""
+}
+
+func (x *Encashment) GetDecidedBy() string {
+	if x != nil {
+		return x.DecidedBy
+	}
+	return ""
+}
+
+func (x *Encashment) GetDecidedAt() string {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return ""
+}
+
+type EncashLeaveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmployeeId  string `protobuf:"bytes,1,opt,name=employeeId,proto3" json:"employeeId,omitempty"`
+	LeaveTypeId string `protobuf:"bytes,2,opt,name=leaveTypeId,proto3" json:"leaveTypeId,omitempty"`
+	// year is the leave year to encash, the current one when empty.
+	Year     string `protobuf:"bytes,3,opt,name=year,proto3" json:"year,omitempty"`
+	Days     string `protobuf:"bytes,4,opt,name=days,proto3" json:"days,omitempty"`
+	Occasion string `protobuf:"bytes,5,opt,name=occasion,proto3" json:"occasion,omitempty"`
+}
+
+func (x *EncashLeaveRequest) Reset() {
+	*x = EncashLeaveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EncashLeaveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncashLeaveRequest) ProtoMessage() {}
+
+func (x *EncashLeaveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncashLeaveRequest.ProtoReflect.Descriptor instead.
+func (*EncashLeaveRequest) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *EncashLeaveRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *EncashLeaveRequest) GetLeaveTypeId() string {
+	if x != nil {
+		return x.LeaveTypeId
+	}
+	return ""
+}
+
+func (x *EncashLeaveRequest) GetYear() string {
+	if x != nil {
+		return x.Year
+	}
+	return ""
+}
+
+func (x *EncashLeaveRequest) GetDays() string {
+	if x != nil {
+		return x.Days
+	}
+	return ""
+}
+
+func (x *EncashLeaveRequest) GetOccasion() string {
+	if x != nil {
+		return x.Occasion
+	}
+	return ""
+}
+
+type EncashLeaveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Encashment *Encashment `protobuf:"bytes,1,opt,name=encashment,proto3" json:"encashment,omitempty"`
+}
+
+func (x *EncashLeaveResponse) Reset() {
+	*x = EncashLeaveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EncashLeaveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncashLeaveResponse) ProtoMessage() {}
+
+func (x *EncashLeaveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncashLeaveResponse.ProtoReflect.Descriptor instead.
+func (*EncashLeaveResponse) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *EncashLeaveResponse) GetEncashment() *Encashment {
+	if x != nil {
+		return x.Encashment
+	}
+	return nil
+}
+
+type ChangeEncashmentStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmployeeId   string `protobuf:"bytes,1,opt,name=employeeId,proto3" json:"employeeId,omitempty"`
+	EncashmentId string `protobuf:"bytes,2,opt,name=encashmentId,proto3" json:"encashmentId,omitempty"`
+	Status       string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ChangeEncashmentStatusRequest) Reset() {
+	*x = ChangeEncashmentStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeEncashmentStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeEncashmentStatusRequest) ProtoMessage() {}
+
+func (x *ChangeEncashmentStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeEncashmentStatusRequest.ProtoReflect.Descriptor instead.
+func (*ChangeEncashmentStatusRequest) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ChangeEncashmentStatusRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *ChangeEncashmentStatusRequest) GetEncashmentId() string {
+	if x != nil {
+		return x.EncashmentId
+	}
+	return ""
+}
+
+func (x *ChangeEncashmentStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ChangeEncashmentStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ChangeEncashmentStatusResponse) Reset() {
+	*x = ChangeEncashmentStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeEncashmentStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeEncashmentStatusResponse) ProtoMessage() {}
+
+func (x *ChangeEncashmentStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeEncashmentStatusResponse.ProtoReflect.Descriptor instead.
+func (*ChangeEncashmentStatusResponse) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{48}
+}
+
+type ListEncashmentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmployeeId string `protobuf:"bytes,1,opt,name=employeeId,proto3" json:"employeeId,omitempty"`
+	// targetEmployeeId narrows the list down to one employee. HR can leave
+	// it empty to list everyone's, as for payroll export.
+	TargetEmployeeId string `protobuf:"bytes,2,opt,name=targetEmployeeId,proto3" json:"targetEmployeeId,omitempty"`
+	Status           string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Year             string `protobuf:"bytes,4,opt,name=year,proto3" json:"year,omitempty"`
+}
+
+func (x *ListEncashmentsRequest) Reset() {
+	*x = ListEncashmentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEncashmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEncashmentsRequest) ProtoMessage() {}
+
+func (x *ListEncashmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEncashmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListEncashmentsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListEncashmentsRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *ListEncashmentsRequest) GetTargetEmployeeId() string {
+	if x != nil {
+		return x.TargetEmployeeId
+	}
+	return ""
+}
+
+func (x *ListEncashmentsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListEncashmentsRequest) GetYear() string {
+	if x != nil {
+		return x.Year
+	}
+	return ""
+}
+
+type ListEncashmentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Encashments []*Encashment `protobuf:"bytes,1,rep,name=encashments,proto3" json:"encashments,omitempty"`
+}
+
+func (x *ListEncashmentsResponse) Reset() {
+	*x = ListEncashmentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEncashmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEncashmentsResponse) ProtoMessage() {}
+
+func (x *ListEncashmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEncashmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListEncashmentsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListEncashmentsResponse) GetEncashments() []*Encashment {
+	if x != nil {
+		return x.Encashments
+	}
+	return nil
+}
+
+type ListLossOfPayRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmployeeId string `protobuf:"bytes,1,opt,name=employeeId,proto3" json:"employeeId,omitempty"`
+	// targetEmployeeId narrows the report down to one employee. HR can leave
+	// it empty to report on everyone, as for payroll.
+	TargetEmployeeId string `protobuf:"bytes,2,opt,name=targetEmployeeId,proto3" json:"targetEmployeeId,omitempty"`
+	FromDate         string `protobuf:"bytes,3,opt,name=fromDate,proto3" json:"fromDate,omitempty"`
+	ToDate           string `protobuf:"bytes,4,opt,name=toDate,proto3" json:"toDate,omitempty"`
+}
+
+func (x *ListLossOfPayRequest) Reset() {
+	*x = ListLossOfPayRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLossOfPayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLossOfPayRequest) ProtoMessage() {}
+
+func (x *ListLossOfPayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLossOfPayRequest.ProtoReflect.Descriptor instead.
+func (*ListLossOfPayRequest) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListLossOfPayRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *ListLossOfPayRequest) GetTargetEmployeeId() string {
+	if x != nil {
+		return x.TargetEmployeeId
+	}
+	return ""
+}
+
+func (x *ListLossOfPayRequest) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *ListLossOfPayRequest) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+type LossOfPay struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApplicationId string `protobuf:"bytes,1,opt,name=applicationId,proto3" json:"applicationId,omitempty"`
+	EmployeeId    string `protobuf:"bytes,2,opt,name=employeeId,proto3" json:"employeeId,omitempty"`
+	LeaveTypeId   string `protobuf:"bytes,3,opt,name=leaveTypeId,proto3" json:"leaveTypeId,omitempty"`
+	FromDate      string `protobuf:"bytes,4,opt,name=fromDate,proto3" json:"fromDate,omitempty"`
+	ToDate        string `protobuf:"bytes,5,opt,name=toDate,proto3" json:"toDate,omitempty"`
+	NoOfDays      string `protobuf:"bytes,6,opt,name=noOfDays,proto3" json:"noOfDays,omitempty"`
+	LossOfPayDays string `protobuf:"bytes,7,opt,name=lossOfPayDays,proto3" json:"lossOfPayDays,omitempty"`
+}
+
+func (x *LossOfPay) Reset() {
+	*x = LossOfPay{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LossOfPay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LossOfPay) ProtoMessage() {}
+
+func (x *LossOfPay) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LossOfPay.ProtoReflect.Descriptor instead.
+func (*LossOfPay) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *LossOfPay) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *LossOfPay) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *LossOfPay) GetLeaveTypeId() string {
+	if x != nil {
+		return x.LeaveTypeId
+	}
+	return ""
+}
+
+func (x *LossOfPay) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *LossOfPay) GetToDate() string {
+	if x != nil {
+		return x.ToDate
+	}
+	return ""
+}
+
+func (x *LossOfPay) GetNoOfDays() string {
+	if x != nil {
+		return x.NoOfDays
+	}
+	return ""
+}
+
+func (x *LossOfPay) GetLossOfPayDays() string {
+	if x != nil {
+		return x.LossOfPayDays
+	}
+	return ""
+}
+
+type ListLossOfPayResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LossOfPay []*LossOfPay `protobuf:"bytes,1,rep,name=lossOfPay,proto3" json:"lossOfPay,omitempty"`
+}
+
+func (x *ListLossOfPayResponse) Reset() {
+	*x = ListLossOfPayResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLossOfPayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLossOfPayResponse) ProtoMessage() {}
+
+func (x *ListLossOfPayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLossOfPayResponse.ProtoReflect.Descriptor instead.
+func (*ListLossOfPayResponse) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListLossOfPayResponse) GetLossOfPay() []*LossOfPay {
+	if x != nil {
+		return x.LossOfPay
+	}
+	return nil
+}
+
+type BalanceAdjustment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AdjustmentId string `protobuf:"bytes,1,opt,name=adjustmentId,proto3" json:"adjustmentId,omitempty"`
+	EmployeeId   string `protobuf:"bytes,2,opt,name=employeeId,proto3" json:"employeeId,omitempty"`
+	LeaveTypeId  string `protobuf:"bytes,3,opt,name=leaveTypeId,proto3" json:"leaveTypeId,omitempty"`
+	// year is the leave year whose balance is adjusted.
+	Year string `protobuf:"bytes,4,opt,name=year,proto3" json:"year,omitempty"`
+	// days are credited when positive and debited when negative.
+	Days       string `protobuf:"bytes,5,opt,name=days,proto3" json:"days,omitempty"`
+	Reason     string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	AdjustedBy string `protobuf:"bytes,7,opt,name=adjustedBy,proto3" json:"adjustedBy,omitempty"`
+	AdjustedAt string `protobuf:"bytes,8,opt,name=adjustedAt,proto3" json:"adjustedAt,omitempty"`
+}
+
+func (x *BalanceAdjustment) Reset() {
+	*x = BalanceAdjustment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BalanceAdjustment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceAdjustment) ProtoMessage() {}
+
+func (x *BalanceAdjustment) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceAdjustment.ProtoReflect.Descriptor instead.
+func (*BalanceAdjustment) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *BalanceAdjustment) GetAdjustmentId() string {
+	if x != nil {
+		return x.AdjustmentId
+	}
+	return ""
+}
+
+func (x *BalanceAdjustment) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *BalanceAdjustment) GetLeaveTypeId() string {
+	if x != nil {
+		return x.LeaveTypeId
+	}
+	return ""
+}
+
+func (x *BalanceAdjustment) GetYear() string {
+	if x != nil {
+		return x.Year
+	}
+	return ""
+}
+
+func (x *BalanceAdjustment) GetDays() string {
+	if x != nil {
+		return x.Days
+	}
+	return ""
+}
+
+func (x *BalanceAdjustment) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BalanceAdjustment) GetAdjustedBy() string {
+	if x != nil {
+		return x.AdjustedBy
+	}
+	return ""
+}
+
+func (x *BalanceAdjustment) GetAdjustedAt() string {
+	if x != nil {
+		return x.AdjustedAt
+	}
+	return ""
+}
+
+type AdjustBalanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmployeeId       string `protobuf:"bytes,1,opt,name=employeeId,proto3" json:"employeeId,omitempty"`
+	TargetEmployeeId string `protobuf:"bytes,2,opt,name=targetEmployeeId,proto3" json:"targetEmployeeId,omitempty"`
+	LeaveTypeId      string `protobuf:"bytes,3,opt,name=leaveTypeId,proto3" json:"leaveTypeId,omitempty"`
+	// year is the leave year to adjust, the current one when empty.
+	Year   string `protobuf:"bytes,4,opt,name=year,proto3" json:"year,omitempty"`
+	Days   string `protobuf:"bytes,5,opt,name=days,proto3" json:"days,omitempty"`
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *AdjustBalanceRequest) Reset() {
+	*x = AdjustBalanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdjustBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustBalanceRequest) ProtoMessage() {}
+
+func (x *AdjustBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustBalanceRequest.ProtoReflect.Descriptor instead.
+func (*AdjustBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *AdjustBalanceRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *AdjustBalanceRequest) GetTargetEmployeeId() string {
+	if x != nil {
+		return x.TargetEmployeeId
+	}
+	return ""
+}
+
+func (x *AdjustBalanceRequest) GetLeaveTypeId() string {
+	if x != nil {
+		return x.LeaveTypeId
+	}
+	return ""
+}
+
+func (x *AdjustBalanceRequest) GetYear() string {
+	if x != nil {
+		return x.Year
+	}
+	return ""
+}
+
+func (x *AdjustBalanceRequest) GetDays() string {
+	if x != nil {
+		return x.Days
+	}
+	return ""
+}
+
+func (x *AdjustBalanceRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AdjustBalanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Adjustment *BalanceAdjustment `protobuf:"bytes,1,opt,name=adjustment,proto3" json:"adjustment,omitempty"`
+}
+
+func (x *AdjustBalanceResponse) Reset() {
+	*x = AdjustBalanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdjustBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustBalanceResponse) ProtoMessage() {}
+
+func (x *AdjustBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustBalanceResponse.ProtoReflect.Descriptor instead.
+func (*AdjustBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *AdjustBalanceResponse) GetAdjustment() *BalanceAdjustment {
+	if x != nil {
+		return x.Adjustment
+	}
+	return nil
+}
+
+type ListBalanceAdjustmentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmployeeId string `protobuf:"bytes,1,opt,name=employeeId,proto3" json:"employeeId,omitempty"`
+	// targetEmployeeId narrows the list down to one employee. HR can leave
+	// it empty to list everyone's.
+	TargetEmployeeId string `protobuf:"bytes,2,opt,name=targetEmployeeId,proto3" json:"targetEmployeeId,omitempty"`
+	LeaveTypeId      string `protobuf:"bytes,3,opt,name=leaveTypeId,proto3" json:"leaveTypeId,omitempty"`
+	Year             string `protobuf:"bytes,4,opt,name=year,proto3" json:"year,omitempty"`
+}
+
+func (x *ListBalanceAdjustmentsRequest) Reset() {
+	*x = ListBalanceAdjustmentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBalanceAdjustmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBalanceAdjustmentsRequest) ProtoMessage() {}
+
+func (x *ListBalanceAdjustmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBalanceAdjustmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListBalanceAdjustmentsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListBalanceAdjustmentsRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *ListBalanceAdjustmentsRequest) GetTargetEmployeeId() string {
+	if x != nil {
+		return x.TargetEmployeeId
+	}
+	return ""
+}
+
+func (x *ListBalanceAdjustmentsRequest) GetLeaveTypeId() string {
+	if x != nil {
+		return x.LeaveTypeId
+	}
+	return ""
+}
+
+func (x *ListBalanceAdjustmentsRequest) GetYear() string {
+	if x != nil {
+		return x.Year
+	}
+	return ""
+}
+
+type ListBalanceAdjustmentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Adjustments []*BalanceAdjustment `protobuf:"bytes,1,rep,name=adjustments,proto3" json:"adjustments,omitempty"`
+}
+
+func (x *ListBalanceAdjustmentsResponse) Reset() {
+	*x = ListBalanceAdjustmentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBalanceAdjustmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBalanceAdjustmentsResponse) ProtoMessage() {}
+
+func (x *ListBalanceAdjustmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBalanceAdjustmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListBalanceAdjustmentsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListBalanceAdjustmentsResponse) GetAdjustments() []*BalanceAdjustment {
+	if x != nil {
+		return x.Adjustments
+	}
+	return nil
+}
+
+type SettlementItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaveTypeId string `protobuf:"bytes,1,opt,name=leaveTypeId,proto3" json:"leaveTypeId,omitempty"`
+	LeaveName   string `protobuf:"bytes,2,opt,name=leaveName,proto3" json:"leaveName,omitempty"`
+	// earned is the entitlement pro-rated up to the exit date.
+	Earned           string `protobuf:"bytes,3,opt,name=earned,proto3" json:"earned,omitempty"`
+	Adjusted         string `protobuf:"bytes,4,opt,name=adjusted,proto3" json:"adjusted,omitempty"`
+	AdvanceRecovered string `protobuf:"bytes,5,opt,name=advanceRecovered,proto3" json:"advanceRecovered,omitempty"`
+	// taken counts every approved application starting by the exit date,
+	// ended or not.
+	Taken     string `protobuf:"bytes,6,opt,name=taken,proto3" json:"taken,omitempty"`
+	Pending   string `protobuf:"bytes,7,opt,name=pending,proto3" json:"pending,omitempty"`
+	Encashed  string `protobuf:"bytes,8,opt,name=encashed,proto3" json:"encashed,omitempty"`
+	LossOfPay string `protobuf:"bytes,9,opt,name=lossOfPay,proto3" json:"lossOfPay,omitempty"`
+	// balance is earned plus adjusted less advanceRecovered, taken and
+	// encashed. It is negative when more was taken than earned.
+	Balance string `protobuf:"bytes,10,opt,name=balance,proto3" json:"balance,omitempty"`
+	// afterExit is the paid days of approved applications starting after
+	// the exit date, left out of taken, for HR to cancel.
+	AfterExit string `protobuf:"bytes,11,opt,name=afterExit,proto3" json:"afterExit,omitempty"`
+}
+
+func (x *SettlementItem) Reset() {
+	*x = SettlementItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SettlementItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettlementItem) ProtoMessage() {}
+
+func (x *SettlementItem) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettlementItem.ProtoReflect.Descriptor instead.
+func (*SettlementItem) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SettlementItem) GetLeaveTypeId() string {
+	if x != nil {
+		return x.LeaveTypeId
+	}
+	return ""
+}
+
+func (x *SettlementItem) GetLeaveName() string {
+	if x != nil {
+		return x.LeaveName
+	}
+	return ""
+}
+
+func (x *SettlementItem) GetEarned() string {
+	if x != nil {
+		return x.Earned
+	}
+	return ""
+}
+
+func (x *SettlementItem) GetAdjusted() string {
+	if x != nil {
+		return x.Adjusted
+	}
+	return ""
+}
+
+func (x *SettlementItem) GetAdvanceRecovered() string {
+	if x != nil {
+		return x.AdvanceRecovered
+	}
+	return ""
+}
+
+func (x *SettlementItem) GetTaken() string {
+	if x != nil {
+		return x.Taken
+	}
+	return ""
+}
+
+func (x *SettlementItem) GetPending() string {
+	if x != nil {
+		return x.Pending
+	}
+	return ""
+}
+
+func (x *SettlementItem) GetEncashed() string {
+	if x != nil {
+		return x.Encashed
+	}
+	return ""
+}
+
+func (x *SettlementItem) GetLossOfPay() string {
+	if x != nil {
+		return x.LossOfPay
+	}
+	return ""
+}
+
+func (x *SettlementItem) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *SettlementItem) GetAfterExit() string {
+	if x != nil {
+		return x.AfterExit
+	}
+	return ""
+}
+
+type FinalSettlement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmployeeId string `protobuf:"bytes,1,opt,name=employeeId,proto3" json:"employeeId,omitempty"`
+	ExitDate   string `protobuf:"bytes,2,opt,name=exitDate,proto3" json:"exitDate,omitempty"`
+	// year is the leave year the exit date falls in.
+	Year  string            `protobuf:"bytes,3,opt,name=year,proto3" json:"year,omitempty"`
+	Items []*SettlementItem `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *FinalSettlement) Reset() {
+	*x = FinalSettlement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FinalSettlement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinalSettlement) ProtoMessage() {}
+
+func (x *FinalSettlement) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinalSettlement.ProtoReflect.Descriptor instead.
+func (*FinalSettlement) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *FinalSettlement) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *FinalSettlement) GetExitDate() string {
+	if x != nil {
+		return x.ExitDate
+	}
+	return ""
+}
+
+func (x *FinalSettlement) GetYear() string {
+	if x != nil {
+		return x.Year
+	}
+	return ""
+}
+
+func (x *FinalSettlement) GetItems() []*SettlementItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type RecordExitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmployeeId       string `protobuf:"bytes,1,opt,name=employeeId,proto3" json:"employeeId,omitempty"`
+	TargetEmployeeId string `protobuf:"bytes,2,opt,name=targetEmployeeId,proto3" json:"targetEmployeeId,omitempty"`
+	ExitDate         string `protobuf:"bytes,3,opt,name=exitDate,proto3" json:"exitDate,omitempty"`
+}
+
+func (x *RecordExitRequest) Reset() {
+	*x = RecordExitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordExitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordExitRequest) ProtoMessage() {}
+
+func (x *RecordExitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordExitRequest.ProtoReflect.Descriptor instead.
+func (*RecordExitRequest) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RecordExitRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *RecordExitRequest) GetTargetEmployeeId() string {
+	if x != nil {
+		return x.TargetEmployeeId
+	}
+	return ""
+}
+
+func (x *RecordExitRequest) GetExitDate() string {
+	if x != nil {
+		return x.ExitDate
+	}
+	return ""
+}
+
+type RecordExitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Settlement *FinalSettlement `protobuf:"bytes,1,opt,name=settlement,proto3" json:"settlement,omitempty"`
+}
+
+func (x *RecordExitResponse) Reset() {
+	*x = RecordExitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordExitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordExitResponse) ProtoMessage() {}
+
+func (x *RecordExitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordExitResponse.ProtoReflect.Descriptor instead.
+func (*RecordExitResponse) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RecordExitResponse) GetSettlement() *FinalSettlement {
+	if x != nil {
+		return x.Settlement
+	}
+	return nil
+}
+
+type GetFinalSettlementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmployeeId       string `protobuf:"bytes,1,opt,name=employeeId,proto3" json:"employeeId,omitempty"`
+	TargetEmployeeId string `protobuf:"bytes,2,opt,name=targetEmployeeId,proto3" json:"targetEmployeeId,omitempty"`
+}
+
+func (x *GetFinalSettlementRequest) Reset() {
+	*x = GetFinalSettlementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFinalSettlementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFinalSettlementRequest) ProtoMessage() {}
+
+func (x *GetFinalSettlementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFinalSettlementRequest.ProtoReflect.Descriptor instead.
+func (*GetFinalSettlementRequest) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetFinalSettlementRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *GetFinalSettlementRequest) GetTargetEmployeeId() string {
+	if x != nil {
+		return x.TargetEmployeeId
+	}
+	return ""
+}
+
+type GetFinalSettlementResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Settlement *FinalSettlement `protobuf:"bytes,1,opt,name=settlement,proto3" json:"settlement,omitempty"`
+}
+
+func (x *GetFinalSettlementResponse) Reset() {
+	*x = GetFinalSettlementResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pb_lm_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFinalSettlementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFinalSettlementResponse) ProtoMessage() {}
+
+func (x *GetFinalSettlementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_lm_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFinalSettlementResponse.ProtoReflect.Descriptor instead.
+func (*GetFinalSettlementResponse) Descriptor() ([]byte, []int) {
+	return file_pb_lm_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetFinalSettlementResponse) GetSettlement() *FinalSettlement {
+	if x != nil {
+		return x.Settlement
+	}
+	return nil
+}
+
+var File_pb_lm_proto protoreflect.FileDescriptor
+
+var file_pb_lm_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x70, 0x62, 0x2f, 0x6c, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x6c,
+	0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x20,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xcd, 0x01, 0x0a, 0x11, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79,
+	0x65, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c,
+	0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x65, 0x61,
+	0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d,
+	0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x4c, 0x6f, 0x73, 0x73, 0x4f, 0x66, 0x50, 0x61, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4c, 0x6f, 0x73, 0x73, 0x4f, 0x66, 0x50, 0x61, 0x79,
+	0x22, 0x4c, 0x0a, 0x08, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x44, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x64, 0x22, 0x5a,
+	0x0a, 0x0d, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x6e, 0x6f, 0x4f, 0x66, 0x44, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6e, 0x6f, 0x4f, 0x66, 0x44, 0x61, 0x79, 0x73, 0x12, 0x2d, 0x0a, 0x04, 0x64,
+	0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x65, 0x61, 0x76,
+	0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x65, 0x61, 0x76,
+	0x65, 0x44, 0x61, 0x79, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x22, 0xda, 0x01, 0x0a, 0x12, 0x41,
+	0x70, 0x70, 0x6c, 0x79, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x3a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x24, 0x0a, 0x0d, 0x6c, 0x6f, 0x73, 0x73, 0x4f, 0x66, 0x50, 0x61, 0x79, 0x44, 0x61, 0x79, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x73, 0x73, 0x4f, 0x66, 0x50, 0x61,
+	0x79, 0x44, 0x61, 0x79, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65,
+	0x44, 0x61, 0x79, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x64, 0x76, 0x61,
+	0x6e, 0x63, 0x65, 0x44, 0x61, 0x79, 0x73, 0x22, 0xc4, 0x01, 0x0a, 0x18, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79,
+	0x65, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x65,
+	0x61, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x1b,
+	0x0a, 0x19, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5b, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c,
+	0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d,
+	0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x22, 0xa0, 0x04, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x4c, 0x65, 0x61, 0x76, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f,
+	0x79, 0x65, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70,
+	0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x65,
+	0x61, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x64, 0x61, 0x74,
+	0x65, 0x4f, 0x66, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x41, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44,
+	0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e,
+	0x6f, 0x4f, 0x66, 0x44, 0x61, 0x79, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e,
+	0x6f, 0x4f, 0x66, 0x44, 0x61, 0x79, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x65, 0x61, 0x76, 0x65,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c,
+	0x65, 0x61, 0x76, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6c,
+	0x65, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x61, 0x74, 0x65, 0x4f,
+	0x66, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12,
+	0x1c, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x6f, 0x73, 0x73, 0x4f, 0x66, 0x50, 0x61, 0x79,
+	0x44, 0x61, 0x79, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x73, 0x73,
+	0x4f, 0x66, 0x50, 0x61, 0x79, 0x44, 0x61, 0x79, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x64, 0x76,
+	0x61, 0x6e, 0x63, 0x65, 0x44, 0x61, 0x79, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x61, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x61, 0x79, 0x73, 0x22, 0x55, 0x0a, 0x11, 0x4c,
+	0x65, 0x61, 0x76, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64,
+	0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x6b, 0x0a, 0x12, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x12, 0x6c, 0x65, 0x61, 0x76,
+	0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x42,
+	0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x12, 0x6c, 0x65, 0x61,
+	0x76, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x8b, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x79, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f,
+	0x79, 0x65, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70,
+	0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x65,
+	0x61, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f,
+	0x6d, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f,
+	0x6d, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x22, 0x72, 0x0a,
+	0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65,
+	0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x22, 0x15, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xca, 0x02, 0x0a, 0x12, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x24, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65,
+	0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f,
+	0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x65, 0x61, 0x76,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44,
+	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x3a, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x28, 0x0a, 0x0f, 0x61,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x4c, 0x6f, 0x73, 0x73, 0x4f, 0x66, 0x50, 0x61, 0x79, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4c, 0x6f, 0x73, 0x73,
+	0x4f, 0x66, 0x50, 0x61, 0x79, 0x22, 0xb5, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x3a, 0x0a, 0x08, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x65,
+	0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x65,
+	0x61, 0x76, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x6f, 0x73, 0x73, 0x4f, 0x66, 0x50,
+	0x61, 0x79, 0x44, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f,
+	0x73, 0x73, 0x4f, 0x66, 0x50, 0x61, 0x79, 0x44, 0x61, 0x79, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61,
+	0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x61, 0x79, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x61, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x61, 0x79, 0x73, 0x22, 0x5b, 0x0a,
+	0x13, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79,
+	0x65, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x79, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x2a, 0x0a,
+	0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x45,
+	0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x22, 0xf8, 0x02,
+	0x0a, 0x0c, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x61, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x65, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x65, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x73, 0x73,
+	0x4f, 0x66, 0x50, 0x61, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x73,
+	0x73, 0x4f, 0x66, 0x50, 0x61, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x76, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x2a, 0x0a, 0x10, 0x61, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x64, 0x76, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x22, 0x93, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x4c, 0x65, 0x61, 0x76, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65,
+	0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f,
+	0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x43, 0x0a, 0x0d, 0x6c, 0x65, 0x61,
+	0x76, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x0d, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0xd2,
+	0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d, 0x70,
+	0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65,
+	0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x20, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x49, 0x64, 0x22, 0xd4, 0x02, 0x0a, 0x0a, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x49,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x76, 0x48, 0x61, 0x73, 0x68, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x65, 0x76, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x58, 0x0a, 0x17, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x65, 0x61,
+	0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x13, 0x54, 0x65, 0x61, 0x6d, 0x43, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72,
+	0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72,
+	0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x22, 0xd5,
+	0x01, 0x0a, 0x0f, 0x54, 0x65, 0x61, 0x6d, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4c, 0x65, 0x61,
+	0x76, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65,
+	0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xcb, 0x01, 0x0a, 0x0f, 0x54, 0x65, 0x61, 0x6d, 0x43,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x44, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x12, 0x3a, 0x0a, 0x07, 0x6f, 0x6e, 0x4c, 0x65,
+	0x61, 0x76, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x65, 0x61, 0x76,
+	0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x65, 0x61, 0x6d,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x07, 0x6f, 0x6e, 0x4c,
+	0x65, 0x61, 0x76, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x49,
+	0x66, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x13, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x66, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x64, 0x22, 0x86, 0x01, 0x0a, 0x14, 0x54, 0x65, 0x61, 0x6d, 0x43, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74,
+	0x65, 0x61, 0x6d, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x65, 0x61, 0x6d, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x34, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x43, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x44, 0x61, 0x79, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x22, 0xb0, 0x02,
+	0x0a, 0x0e, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x64, 0x65, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b,
+	0x6c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x2e,
+	0x0a, 0x12, 0x65, 0x78, 0x65, 0x6d, 0x70, 0x74, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x49, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x65, 0x78, 0x65, 0x6d,
+	0x70, 0x74, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x22, 0x86, 0x01, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x61, 0x63, 0x6b,
+	0x6f, 0x75, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64,
+	0x12, 0x47, 0x0a, 0x0e, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x6c, 0x61, 0x63, 0x6b,
+	0x6f, 0x75, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x0e, 0x62, 0x6c, 0x61, 0x63, 0x6b,
+	0x6f, 0x75, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0x3e, 0x0a, 0x1c, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x61,
+	0x63, 0x6b, 0x6f, 0x75, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62,
+	0x6c, 0x61, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x49, 0x64, 0x22, 0x70, 0x0a, 0x1a, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f,
+	0x79, 0x65, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70,
+	0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x22, 0x68, 0x0a, 0x1b, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0f, 0x62, 0x6c,
+	0x61, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x52, 0x0f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x73, 0x22, 0x5d, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42,
+	0x6c, 0x61, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79,
+	0x65, 0x65, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6f, 0x75, 0x74,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x6f,
+	0x75, 0x74, 0x49, 0x64, 0x22, 0x1e, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6c,
+	0x61, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6c, 0x69, 0x67,
+	0x69, 0x62, 0x6c, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65,
+	0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f,
+	0x79, 0x65, 0x65, 0x49, 0x64, 0x22, 0x7d, 0x0a, 0x09, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x44, 0x61,
+	0x79, 0x73, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x13, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x44, 0x61, 0x79, 0x73, 0x41, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x22, 0x5c, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6c, 0x69, 0x67,
+	0x69, 0x62, 0x6c, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x65, 0x61,
+	0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x65, 0x61,
+	0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x70, 0x4f, 0x66, 0x66, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x4f, 0x66, 0x66, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x4f, 0x66, 0x66, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x77, 0x6f, 0x72, 0x6b, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x4f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x4f, 0x6e, 0x22, 0x6f, 0x0a, 0x15, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x4f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65,
+	0x65, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x64, 0x44, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x64, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x36, 0x0a, 0x16, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x4f, 0x66, 0x66, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x4f, 0x66, 0x66,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x4f, 0x66,
+	0x66, 0x49, 0x64, 0x22, 0x72, 0x0a, 0x1a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6d,
+	0x70, 0x4f, 0x66, 0x66, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x4f, 0x66, 0x66, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x4f, 0x66, 0x66, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x1d, 0x0a, 0x1b, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x43, 0x6f, 0x6d, 0x70, 0x4f, 0x66, 0x66, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x79, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x6d, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x2a, 0x0a,
+	0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x45,
+	0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x4c, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x4f, 0x66, 0x66,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x63, 0x6f, 0x6d,
+	0x70, 0x4f, 0x66, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x65,
+	0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f,
+	0x6d, 0x70, 0x4f, 0x66, 0x66, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x22,
+	0x9e, 0x03, 0x0a, 0x0a, 0x45, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22,
+	0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65,
+	0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x6f, 0x63, 0x63, 0x61, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6f, 0x63, 0x63, 0x61, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x53, 0x61, 0x6c, 0x61, 0x72,
+	0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79,
+	0x53, 0x61, 0x6c, 0x61, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x61, 0x79, 0x52, 0x61, 0x74,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x61, 0x79, 0x52, 0x61, 0x74, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d,
+	0x75, 0x6c, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75,
+	0x6c, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x42,
+	0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64,
+	0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x9a, 0x01, 0x0a, 0x12, 0x45, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x4c, 0x65, 0x61, 0x76, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f,
+	0x79, 0x65, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70,
+	0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x65,
+	0x61, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x79,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x63, 0x63, 0x61, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x63, 0x63, 0x61, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x0a,
+	0x13, 0x45, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x65, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x63, 0x61, 0x73,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x65, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x22, 0x7b, 0x0a, 0x1d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x6e, 0x63, 0x61, 0x73,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65,
+	0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x6e, 0x63, 0x61, 0x73, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x20,
+	0x0a, 0x1e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x90, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65,
+	0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x45, 0x6d, 0x70,
+	0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x79,
+	0x65, 0x61, 0x72, 0x22, 0x58, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x63, 0x61, 0x73,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d,
+	0x0a, 0x0b, 0x65, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x0b, 0x65, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x96, 0x01,
+	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x73, 0x73, 0x4f, 0x66, 0x50, 0x61, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79,
+	0x65, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c,
+	0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x6f, 0x44, 0x61, 0x74, 0x65, 0x22, 0xe9, 0x01, 0x0a, 0x09, 0x4c, 0x6f, 0x73, 0x73, 0x4f,
+	0x66, 0x50, 0x61, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d,
+	0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x65,
+	0x61, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x44, 0x61,
+	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x6f, 0x4f, 0x66, 0x44, 0x61, 0x79, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x4f, 0x66, 0x44, 0x61, 0x79, 0x73, 0x12, 0x24, 0x0a, 0x0d,
+	0x6c, 0x6f, 0x73, 0x73, 0x4f, 0x66, 0x50, 0x61, 0x79, 0x44, 0x61, 0x79, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x73, 0x73, 0x4f, 0x66, 0x50, 0x61, 0x79, 0x44, 0x61,
+	0x79, 0x73, 0x22, 0x51, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x73, 0x73, 0x4f, 0x66,
+	0x50, 0x61, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x6c,
+	0x6f, 0x73, 0x73, 0x4f, 0x66, 0x50, 0x61, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x4c, 0x6f, 0x73, 0x73, 0x4f, 0x66, 0x50, 0x61, 0x79, 0x52, 0x09, 0x6c, 0x6f, 0x73, 0x73,
+	0x4f, 0x66, 0x50, 0x61, 0x79, 0x22, 0xf9, 0x01, 0x0a, 0x11, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61,
+	0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x1e, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12,
+	0x20, 0x0a, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x42,
+	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0xc4, 0x01, 0x0a, 0x14, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d,
+	0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x45, 0x6d, 0x70, 0x6c,
+	0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x65, 0x61,
+	0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x79, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x5b, 0x0a, 0x15, 0x41, 0x64, 0x6a, 0x75,
+	0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x42, 0x0a, 0x0a, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41,
+	0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x64, 0x6a, 0x75, 0x73,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xa1, 0x01, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f,
+	0x79, 0x65, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70,
+	0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65,
+	0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x22, 0x66, 0x0a, 0x1e, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x61,
+	0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0xd2, 0x02, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x74, 0x65, 0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x65, 0x61, 0x76, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x65, 0x61, 0x76, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x64, 0x76, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x61, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x65, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x65, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x73, 0x73, 0x4f, 0x66, 0x50, 0x61, 0x79, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x73, 0x73, 0x4f, 0x66, 0x50, 0x61, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x45, 0x78, 0x69, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x45, 0x78, 0x69, 0x74, 0x22, 0x98, 0x01, 0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x61, 0x6c,
+	0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d,
+	0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78,
+	0x69, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78,
+	0x69, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x35, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x65, 0x61, 0x76,
+	0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x22, 0x7b, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x45, 0x78, 0x69, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79,
+	0x65, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c,
+	0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x69, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74, 0x44, 0x61, 0x74, 0x65, 0x22, 0x56,
+	0x0a, 0x12, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x45, 0x78, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c,
+	0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x73, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x67, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6e,
+	0x61, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65,
+	0x65, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x45, 0x6d, 0x70,
+	0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x22,
+	0x5e, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a,
+	0x0a, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x0a, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x32,
+	0xa0, 0x15, 0x0a, 0x16, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x69, 0x76, 0x63, 0x65, 0x12, 0x57, 0x0a, 0x0a, 0x41, 0x70,
+	0x70, 0x6c, 0x79, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x12, 0x22, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79,
+	0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c,
+	0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41,
+	0x70, 0x70, 0x6c, 0x79, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4c, 0x65, 0x61,
+	0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4c, 0x65, 0x61, 0x76,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x57, 0x0a, 0x0a, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x22, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0c, 0x47, 0x65,
+	0x74, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x42, 0x79, 0x49, 0x64, 0x12, 0x24, 0x2e, 0x6c, 0x65, 0x61,
+	0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74,
+	0x4c, 0x65, 0x61, 0x76, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x42, 0x79, 0x49, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0c, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x79, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x6c, 0x65, 0x61, 0x76,
+	0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4d, 0x79, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4c, 0x65, 0x61, 0x76, 0x65, 0x12, 0x23, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x65,
+	0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6c, 0x65, 0x61,
+	0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x76,
+	0x65, 0x12, 0x23, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c,
+	0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d,
+	0x0a, 0x0c, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x12, 0x24,
+	0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4c, 0x65,
+	0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x12, 0x28, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6c, 0x65,
+	0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65,
+	0x74, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x2e, 0x6c, 0x65,
+	0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x5d, 0x0a, 0x0c, 0x54, 0x65, 0x61, 0x6d, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
+	0x12, 0x24, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x43, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x75, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6f, 0x75,
+	0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x2c, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6c,
+	0x61, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6c,
+	0x61, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x12, 0x2b, 0x2e,
+	0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6c, 0x65, 0x61,
+	0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x75, 0x0a, 0x14, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x12, 0x2c, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6c, 0x61, 0x63, 0x6b,
+	0x6f, 0x75, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2d, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6f, 0x75,
+	0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x7b, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c,
+	0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x2e, 0x2e, 0x6c, 0x65,
+	0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6c, 0x65,
+	0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63,
+	0x0a, 0x0e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x4f, 0x66, 0x66,
+	0x12, 0x26, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x4f, 0x66,
+	0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x4f, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6d,
+	0x70, 0x4f, 0x66, 0x66, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x2e, 0x6c, 0x65, 0x61,
+	0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x4f, 0x66, 0x66, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x43, 0x6f, 0x6d, 0x70, 0x4f, 0x66, 0x66, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6f, 0x6d, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x12, 0x24, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x6d, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0b, 0x45, 0x6e, 0x63, 0x61, 0x73, 0x68,
+	0x4c, 0x65, 0x61, 0x76, 0x65, 0x12, 0x23, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x4c, 0x65,
+	0x61, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6c, 0x65, 0x61,
+	0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x63,
+	0x61, 0x73, 0x68, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x7b, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x6e, 0x63, 0x61,
+	0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2e, 0x2e, 0x6c,
+	0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6c,
+	0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x66, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x27, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6c, 0x65,
+	0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x45, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x4c,
+	0x6f, 0x73, 0x73, 0x4f, 0x66, 0x50, 0x61, 0x79, 0x12, 0x25, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c,
+	0x6f, 0x73, 0x73, 0x4f, 0x66, 0x50, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x26, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x73, 0x73, 0x4f, 0x66, 0x50, 0x61, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x0d, 0x41, 0x64, 0x6a,
+	0x75, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x2e, 0x6c, 0x65, 0x61,
+	0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x64, 0x6a,
+	0x75, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x7b, 0x0a, 0x16, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2e, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x0a, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x45, 0x78, 0x69, 0x74, 0x12, 0x22, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x45,
+	0x78, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x65, 0x61,
+	0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x45, 0x78, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x6f, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6e,
+	0x61, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x42, 0x05, 0x5a, 0x03, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_pb_lm_proto_rawDescOnce sync.Once
+	file_pb_lm_proto_rawDescData = file_pb_lm_proto_rawDesc
+)
+
+func file_pb_lm_proto_rawDescGZIP() []byte {
+	file_pb_lm_proto_rawDescOnce.Do(func() {
+		file_pb_lm_proto_rawDescData = protoimpl.X.CompressGZIP(file_pb_lm_proto_rawDescData)
+	})
+	return file_pb_lm_proto_rawDescData
+}
+
+var file_pb_lm_proto_msgTypes = make([]protoimpl.MessageInfo, 65)
+var file_pb_lm_proto_goTypes = []interface{}{
+	(*ApplyLeaveRequest)(nil),              // 0: leaveManagement.ApplyLeaveRequest
+	(*LeaveDay)(nil),                       // 1: leaveManagement.LeaveDay
+	(*LeaveDuration)(nil),                  // 2: leaveManagement.LeaveDuration
+	(*ApplyLeaveResponse)(nil),             // 3: leaveManagement.ApplyLeaveResponse
+	(*ChangeLeaveStatusRequest)(nil),       // 4: leaveManagement.ChangeLeaveStatusRequest
+	(*ChangeLeaveStatusResponse)(nil),      // 5: leaveManagement.ChangeLeaveStatusResponse
+	(*GetLeaveByIdRequest)(nil),            // 6: leaveManagement.GetLeaveByIdRequest
+	(*GetLeaveByIdResponse)(nil),           // 7: leaveManagement.GetLeaveByIdResponse
+	(*LeavesListRequest)(nil),              // 8: leaveManagement.LeavesListRequest
+	(*LeavesListResponse)(nil),             // 9: leaveManagement.LeavesListResponse
+	(*ListMyLeavesRequest)(nil),            // 10: leaveManagement.ListMyLeavesRequest
+	(*DeleteLeaveRequest)(nil),             // 11: leaveManagement.DeleteLeaveRequest
+	(*DeleteLeaveResponse)(nil),            // 12: leaveManagement.DeleteLeaveResponse
+	(*UpdateLeaveRequest)(nil),             // 13: leaveManagement.UpdateLeaveRequest
+	(*UpdateLeaveResponse)(nil),            // 14: leaveManagement.UpdateLeaveResponse
+	(*RestoreLeaveRequest)(nil),            // 15: leaveManagement.RestoreLeaveRequest
+	(*RestoreLeaveResponse)(nil),           // 16: leaveManagement.RestoreLeaveResponse
+	(*GetLeaveBalancesRequest)(nil),        // 17: leaveManagement.GetLeaveBalancesRequest
+	(*LeaveBalance)(nil),                   // 18: leaveManagement.LeaveBalance
+	(*GetLeaveBalancesResponse)(nil),       // 19: leaveManagement.GetLeaveBalancesResponse
+	(*ListAuditEventsRequest)(nil),         // 20: leaveManagement.ListAuditEventsRequest
+	(*AuditEvent)(nil),                     // 21: leaveManagement.AuditEvent
+	(*ListAuditEventsResponse)(nil),        // 22: leaveManagement.ListAuditEventsResponse
+	(*TeamCalendarRequest)(nil),            // 23: leaveManagement.TeamCalendarRequest
+	(*TeamMemberLeave)(nil),                // 24: leaveManagement.TeamMemberLeave
+	(*TeamCalendarDay)(nil),                // 25: leaveManagement.TeamCalendarDay
+	(*TeamCalendarResponse)(nil),           // 26: leaveManagement.TeamCalendarResponse
+	(*BlackoutPeriod)(nil),                 // 27: leaveManagement.BlackoutPeriod
+	(*CreateBlackoutPeriodRequest)(nil),    // 28: leaveManagement.CreateBlackoutPeriodRequest
+	(*CreateBlackoutPeriodResponse)(nil),   // 29: leaveManagement.CreateBlackoutPeriodResponse
+	(*ListBlackoutPeriodsRequest)(nil),     // 30: leaveManagement.ListBlackoutPeriodsRequest
+	(*ListBlackoutPeriodsResponse)(nil),    // 31: leaveManagement.ListBlackoutPeriodsResponse
+	(*DeleteBlackoutPeriodRequest)(nil),    // 32: leaveManagement.DeleteBlackoutPeriodRequest
+	(*DeleteBlackoutPeriodResponse)(nil),   // 33: leaveManagement.DeleteBlackoutPeriodResponse
+	(*ListEligibleLeaveTypesRequest)(nil),  // 34: leaveManagement.ListEligibleLeaveTypesRequest
+	(*LeaveType)(nil),                      // 35: leaveManagement.LeaveType
+	(*ListEligibleLeaveTypesResponse)(nil), // 36: leaveManagement.ListEligibleLeaveTypesResponse
+	(*CompOff)(nil),                        // 37: leaveManagement.CompOff
+	(*RequestCompOffRequest)(nil),          // 38: leaveManagement.RequestCompOffRequest
+	(*RequestCompOffResponse)(nil),         // 39: leaveManagement.RequestCompOffResponse
+	(*ChangeCompOffStatusRequest)(nil),     // 40: leaveManagement.ChangeCompOffStatusRequest
+	(*ChangeCompOffStatusResponse)(nil),    // 41: leaveManagement.ChangeCompOffStatusResponse
+	(*ListCompOffsRequest)(nil),            // 42: leaveManagement.ListCompOffsRequest
+	(*ListCompOffsResponse)(nil),           // 43: leaveManagement.ListCompOffsResponse
+	(*Encashment)(nil),                     // 44: leaveManagement.Encashment
+	(*EncashLeaveRequest)(nil),             // 45: leaveManagement.EncashLeaveRequest
+	(*EncashLeaveResponse)(nil),            // 46: leaveManagement.EncashLeaveResponse
+	(*ChangeEncashmentStatusRequest)(nil),  // 47: leaveManagement.ChangeEncashmentStatusRequest
+	(*ChangeEncashmentStatusResponse)(nil), // 48: leaveManagement.ChangeEncashmentStatusResponse
+	(*ListEncashmentsRequest)(nil),         // 49: leaveManagement.ListEncashmentsRequest
+	(*ListEncashmentsResponse)(nil),        // 50: leaveManagement.ListEncashmentsResponse
+	(*ListLossOfPayRequest)(nil),           // 51: leaveManagement.ListLossOfPayRequest
+	(*LossOfPay)(nil),                      // 52: leaveManagement.LossOfPay
+	(*ListLossOfPayResponse)(nil),          // 53: leaveManagement.ListLossOfPayResponse
+	(*BalanceAdjustment)(nil),              // 54: leaveManagement.BalanceAdjustment
+	(*AdjustBalanceRequest)(nil),           // 55: leaveManagement.AdjustBalanceRequest
+	(*AdjustBalanceResponse)(nil),          // 56: leaveManagement.AdjustBalanceResponse
+	(*ListBalanceAdjustmentsRequest)(nil),  // 57: leaveManagement.ListBalanceAdjustmentsRequest
+	(*ListBalanceAdjustmentsResponse)(nil), // 58: leaveManagement.ListBalanceAdjustmentsResponse
+	(*SettlementItem)(nil),                 // 59: leaveManagement.SettlementItem
+	(*FinalSettlement)(nil),                // 60: leaveManagement.FinalSettlement
+	(*RecordExitRequest)(nil),              // 61: leaveManagement.RecordExitRequest
+	(*RecordExitResponse)(nil),             // 62: leaveManagement.RecordExitResponse
+	(*GetFinalSettlementRequest)(nil),      // 63: leaveManagement.GetFinalSettlementRequest
+	(*GetFinalSettlementResponse)(nil),     // 64: leaveManagement.GetFinalSettlementResponse
+	(*fieldmaskpb.FieldMask)(nil),          // 65: google.protobuf.FieldMask
+}
+var file_pb_lm_proto_depIdxs = []int32{
+	1,  // 0: leaveManagement.LeaveDuration.days:type_name -> leaveManagement.LeaveDay
+	2,  // 1: leaveManagement.ApplyLeaveResponse.duration:type_name -> leaveManagement.LeaveDuration
+	7,  // 2: leaveManagement.LeavesListResponse.leavesListResponse:type_name -> leaveManagement.GetLeaveByIdResponse
+	65, // 3: leaveManagement.UpdateLeaveRequest.updateMask:type_name -> google.protobuf.FieldMask
+	2,  // 4: leaveManagement.UpdateLeaveResponse.duration:type_name -> leaveManagement.LeaveDuration
+	18, // 5: leaveManagement.GetLeaveBalancesResponse.leaveBalances:type_name -> leaveManagement.LeaveBalance
+	21, // 6: leaveManagement.ListAuditEventsResponse.auditEvents:type_name -> leaveManagement.AuditEvent
+	24, // 7: leaveManagement.TeamCalendarDay.onLeave:type_name -> leaveManagement.TeamMemberLeave
+	25, // 8: leaveManagement.TeamCalendarResponse.days:type_name -> leaveManagement.TeamCalendarDay
+	27, // 9: leaveManagement.CreateBlackoutPeriodRequest.blackoutPeriod:type_name -> leaveManagement.BlackoutPeriod
+	27, // 10: leaveManagement.ListBlackoutPeriodsResponse.blackoutPeriods:type_name -> leaveManagement.BlackoutPeriod
+	35, // 11: leaveManagement.ListEligibleLeaveTypesResponse.leaveTypes:type_name -> leaveManagement.LeaveType
+	37, // 12: leaveManagement.ListCompOffsResponse.compOffs:type_name -> leaveManagement.CompOff
+	44, // 13: leaveManagement.EncashLeaveResponse.encashment:type_name -> leaveManagement.Encashment
+	44, // 14: leaveManagement.ListEncashmentsResponse.encashments:type_name -> leaveManagement.Encashment
+	52, // 15: leaveManagement.ListLossOfPayResponse.lossOfPay:type_name -> leaveManagement.LossOfPay
+	54, // 16: leaveManagement.AdjustBalanceResponse.adjustment:type_name -> leaveManagement.BalanceAdjustment
+	54, // 17: leaveManagement.ListBalanceAdjustmentsResponse.adjustments:type_name -> leaveManagement.BalanceAdjustment
+	59, // 18: leaveManagement.FinalSettlement.items:type_name -> leaveManagement.SettlementItem
+	60, // 19: leaveManagement.RecordExitResponse.settlement:type_name -> leaveManagement.FinalSettlement
+	60, // 20: leaveManagement.GetFinalSettlementResponse.settlement:type_name -> leaveManagement.FinalSettlement
+	0,  // 21: leaveManagement.leaveManagementSerivce.ApplyLeave:input_type -> leaveManagement.ApplyLeaveRequest
+	4,  // 22: leaveManagement.leaveManagementSerivce.ChangeLeaveStatus:input_type -> leaveManagement.ChangeLeaveStatusRequest
+	8,  // 23: leaveManagement.leaveManagementSerivce.LeavesList:input_type -> leaveManagement.LeavesListRequest
+	6,  // 24: leaveManagement.leaveManagementSerivce.GetLeaveById:input_type -> leaveManagement.GetLeaveByIdRequest
+	10, // 25: leaveManagement.leaveManagementSerivce.ListMyLeaves:input_type -> leaveManagement.ListMyLeavesRequest
+	11, // 26: leaveManagement.leaveManagementSerivce.DeleteLeave:input_type -> leaveManagement.DeleteLeaveRequest
+	13, // 27: leaveManagement.leaveManagementSerivce.UpdateLeave:input_type -> leaveManagement.UpdateLeaveRequest
+	15, // 28: leaveManagement.leaveManagementSerivce.RestoreLeave:input_type -> leaveManagement.RestoreLeaveRequest
+	17, // 29: leaveManagement.leaveManagementSerivce.GetLeaveBalances:input_type -> leaveManagement.GetLeaveBalancesRequest
+	20, // 30: leaveManagement.leaveManagementSerivce.ListAuditEvents:input_type -> leaveManagement.ListAuditEventsRequest
+	23, // 31: leaveManagement.leaveManagementSerivce.TeamCalendar:input_type -> leaveManagement.TeamCalendarRequest
+	28, // 32: leaveManagement.leaveManagementSerivce.CreateBlackoutPeriod:input_type -> leaveManagement.CreateBlackoutPeriodRequest
+	30, // 33: leaveManagement.leaveManagementSerivce.ListBlackoutPeriods:input_type -> leaveManagement.ListBlackoutPeriodsRequest
+	32, // 34: leaveManagement.leaveManagementSerivce.DeleteBlackoutPeriod:input_type -> leaveManagement.DeleteBlackoutPeriodRequest
+	34, // 35: leaveManagement.leaveManagementSerivce.ListEligibleLeaveTypes:input_type -> leaveManagement.ListEligibleLeaveTypesRequest
+	38, // 36: leaveManagement.leaveManagementSerivce.RequestCompOff:input_type -> leaveManagement.RequestCompOffRequest
+	40, // 37: leaveManagement.leaveManagementSerivce.ChangeCompOffStatus:input_type -> leaveManagement.ChangeCompOffStatusRequest
+	42, // 38: leaveManagement.leaveManagementSerivce.ListCompOffs:input_type -> leaveManagement.ListCompOffsRequest
+	45, // 39: leaveManagement.leaveManagementSerivce.EncashLeave:input_type -> leaveManagement.EncashLeaveRequest
+	47, // 40: leaveManagement.leaveManagementSerivce.ChangeEncashmentStatus:input_type -> leaveManagement.ChangeEncashmentStatusRequest
+	49, // 41: leaveManagement.leaveManagementSerivce.ListEncashments:input_type -> leaveManagement.ListEncashmentsRequest
+	51, // 42: leaveManagement.leaveManagementSerivce.ListLossOfPay:input_type -> leaveManagement.ListLossOfPayRequest
+	55, // 43: leaveManagement.leaveManagementSerivce.AdjustBalance:input_type -> leaveManagement.AdjustBalanceRequest
+	57, // 44: leaveManagement.leaveManagementSerivce.ListBalanceAdjustments:input_type -> leaveManagement.ListBalanceAdjustmentsRequest
+	61, // 45: leaveManagement.leaveManagementSerivce.RecordExit:input_type -> leaveManagement.RecordExitRequest
+	63, // 46: leaveManagement.leaveManagementSerivce.GetFinalSettlement:input_type -> leaveManagement.GetFinalSettlementRequest
+	3,  // 47: leaveManagement.leaveManagementSerivce.ApplyLeave:output_type -> leaveManagement.ApplyLeaveResponse
+	5,  // 48: leaveManagement.leaveManagementSerivce.ChangeLeaveStatus:output_type -> leaveManagement.ChangeLeaveStatusResponse
+	9,  // 49: leaveManagement.leaveManagementSerivce.LeavesList:output_type -> leaveManagement.LeavesListResponse
+	7,  // 50: leaveManagement.leaveManagementSerivce.GetLeaveById:output_type -> leaveManagement.GetLeaveByIdResponse
+	9,  // 51: leaveManagement.leaveManagementSerivce.ListMyLeaves:output_type -> leaveManagement.LeavesListResponse
+	12, // 52: leaveManagement.leaveManagementSerivce.DeleteLeave:output_type -> leaveManagement.DeleteLeaveResponse
+	14, // 53: leaveManagement.leaveManagementSerivce.UpdateLeave:output_type -> leaveManagement.UpdateLeaveResponse
+	16, // 54: leaveManagement.leaveManagementSerivce.RestoreLeave:output_type -> leaveManagement.RestoreLeaveResponse
+	19, // 55: leaveManagement.leaveManagementSerivce.GetLeaveBalances:output_type -> leaveManagement.GetLeaveBalancesResponse
+	22, // 56: leaveManagement.leaveManagementSerivce.ListAuditEvents:output_type -> leaveManagement.ListAuditEventsResponse
+	26, // 57: leaveManagement.leaveManagementSerivce.TeamCalendar:output_type -> leaveManagement.TeamCalendarResponse
+	29, // 58: leaveManagement.leaveManagementSerivce.CreateBlackoutPeriod:output_type -> leaveManagement.CreateBlackoutPeriodResponse
+	31, // 59: leaveManagement.leaveManagementSerivce.ListBlackoutPeriods:output_type -> leaveManagement.ListBlackoutPeriodsResponse
+	33, // 60: leaveManagement.leaveManagementSerivce.DeleteBlackoutPeriod:output_type -> leaveManagement.DeleteBlackoutPeriodResponse
+	36, // 61: leaveManagement.leaveManagementSerivce.ListEligibleLeaveTypes:output_type -> leaveManagement.ListEligibleLeaveTypesResponse
+	39, // 62: leaveManagement.leaveManagementSerivce.RequestCompOff:output_type -> leaveManagement.RequestCompOffResponse
+	41, // 63: leaveManagement.leaveManagementSerivce.ChangeCompOffStatus:output_type -> leaveManagement.ChangeCompOffStatusResponse
+	43, // 64: leaveManagement.leaveManagementSerivce.ListCompOffs:output_type -> leaveManagement.ListCompOffsResponse
+	46, // 65: leaveManagement.leaveManagementSerivce.EncashLeave:output_type -> leaveManagement.EncashLeaveResponse
+	48, // 66: leaveManagement.leaveManagementSerivce.ChangeEncashmentStatus:output_type -> leaveManagement.ChangeEncashmentStatusResponse
+	50, // 67: leaveManagement.leaveManagementSerivce.ListEncashments:output_type -> leaveManagement.ListEncashmentsResponse
+	53, // 68: leaveManagement.leaveManagementSerivce.ListLossOfPay:output_type -> leaveManagement.ListLossOfPayResponse
+	56, // 69: leaveManagement.leaveManagementSerivce.AdjustBalance:output_type -> leaveManagement.AdjustBalanceResponse
+	58, // 70: leaveManagement.leaveManagementSerivce.ListBalanceAdjustments:output_type -> leaveManagement.ListBalanceAdjustmentsResponse
+	62, // 71: leaveManagement.leaveManagementSerivce.RecordExit:output_type -> leaveManagement.RecordExitResponse
+	64, // 72: leaveManagement.leaveManagementSerivce.GetFinalSettlement:output_type -> leaveManagement.GetFinalSettlementResponse
+	47, // [47:73] is the sub-list for method output_type
+	21, // [21:47] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
+}
+
+func init() { file_pb_lm_proto_init() }
+func file_pb_lm_proto_init() {
+	if File_pb_lm_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pb_lm_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyLeaveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveDay); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveDuration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyLeaveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeLeaveStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeLeaveStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeaveByIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeaveByIdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeavesListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeavesListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMyLeavesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteLeaveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteLeaveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateLeaveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateLeaveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreLeaveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreLeaveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeaveBalancesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveBalance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeaveBalancesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAuditEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAuditEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TeamCalendarRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TeamMemberLeave); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TeamCalendarDay); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TeamCalendarResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlackoutPeriod); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBlackoutPeriodRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBlackoutPeriodResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBlackoutPeriodsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBlackoutPeriodsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBlackoutPeriodRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
 				return &v.unknownFields
 			default:
 				return nil
 			}
 		}
-		file_pb_lm_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLeaveByIdRequest); i {
+		file_pb_lm_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBlackoutPeriodResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -995,8 +5915,8 @@ func file_pb_lm_proto_init() {
 				return nil
 			}
 		}
-		file_pb_lm_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLeaveByIdResponse); i {
+		file_pb_lm_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEligibleLeaveTypesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1007,8 +5927,8 @@ func file_pb_lm_proto_init() {
 				return nil
 			}
 		}
-		file_pb_lm_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeavesListRequest); i {
+		file_pb_lm_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaveType); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1019,8 +5939,8 @@ func file_pb_lm_proto_init() {
 				return nil
 			}
 		}
-		file_pb_lm_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeavesListResponse); i {
+		file_pb_lm_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEligibleLeaveTypesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1031,8 +5951,8 @@ func file_pb_lm_proto_init() {
 				return nil
 			}
 		}
-		file_pb_lm_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteLeaveRequest); i {
+		file_pb_lm_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompOff); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1043,8 +5963,8 @@ func file_pb_lm_proto_init() {
 				return nil
 			}
 		}
-		file_pb_lm_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteLeaveResponse); i {
+		file_pb_lm_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestCompOffRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1055,8 +5975,8 @@ func file_pb_lm_proto_init() {
 				return nil
 			}
 		}
-		file_pb_lm_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateLeaveRequest); i {
+		file_pb_lm_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestCompOffResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1067,8 +5987,296 @@ func file_pb_lm_proto_init() {
 				return nil
 			}
 		}
-		file_pb_lm_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateLeaveResponse); i {
+		file_pb_lm_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeCompOffStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeCompOffStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCompOffsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCompOffsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Encashment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncashLeaveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EncashLeaveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeEncashmentStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeEncashmentStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEncashmentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEncashmentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLossOfPayRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LossOfPay); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLossOfPayResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BalanceAdjustment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdjustBalanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdjustBalanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBalanceAdjustmentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBalanceAdjustmentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SettlementItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FinalSettlement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordExitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordExitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFinalSettlementRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pb_lm_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFinalSettlementResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1086,7 +6294,7 @@ func file_pb_lm_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pb_lm_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   65,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
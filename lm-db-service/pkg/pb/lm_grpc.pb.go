@@ -26,8 +26,28 @@ type LeaveManagementSerivceClient interface {
 	ChangeLeaveStatus(ctx context.Context, in *ChangeLeaveStatusRequest, opts ...grpc.CallOption) (*ChangeLeaveStatusResponse, error)
 	LeavesList(ctx context.Context, in *LeavesListRequest, opts ...grpc.CallOption) (*LeavesListResponse, error)
 	GetLeaveById(ctx context.Context, in *GetLeaveByIdRequest, opts ...grpc.CallOption) (*GetLeaveByIdResponse, error)
+	ListMyLeaves(ctx context.Context, in *ListMyLeavesRequest, opts ...grpc.CallOption) (*LeavesListResponse, error)
 	DeleteLeave(ctx context.Context, in *DeleteLeaveRequest, opts ...grpc.CallOption) (*DeleteLeaveResponse, error)
 	UpdateLeave(ctx context.Context, in *UpdateLeaveRequest, opts ...grpc.CallOption) (*UpdateLeaveResponse, error)
+	RestoreLeave(ctx context.Context, in *RestoreLeaveRequest, opts ...grpc.CallOption) (*RestoreLeaveResponse, error)
+	GetLeaveBalances(ctx context.Context, in *GetLeaveBalancesRequest, opts ...grpc.CallOption) (*GetLeaveBalancesResponse, error)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	TeamCalendar(ctx context.Context, in *TeamCalendarRequest, opts ...grpc.CallOption) (*TeamCalendarResponse, error)
+	CreateBlackoutPeriod(ctx context.Context, in *CreateBlackoutPeriodRequest, opts ...grpc.CallOption) (*CreateBlackoutPeriodResponse, error)
+	ListBlackoutPeriods(ctx context.Context, in *ListBlackoutPeriodsRequest, opts ...grpc.CallOption) (*ListBlackoutPeriodsResponse, error)
+	DeleteBlackoutPeriod(ctx context.Context, in *DeleteBlackoutPeriodRequest, opts ...grpc.CallOption) (*DeleteBlackoutPeriodResponse, error)
+	ListEligibleLeaveTypes(ctx context.Context, in *ListEligibleLeaveTypesRequest, opts ...grpc.CallOption) (*ListEligibleLeaveTypesResponse, error)
+	RequestCompOff(ctx context.Context, in *RequestCompOffRequest, opts ...grpc.CallOption) (*RequestCompOffResponse, error)
+	ChangeCompOffStatus(ctx context.Context, in *ChangeCompOffStatusRequest, opts ...grpc.CallOption) (*ChangeCompOffStatusResponse, error)
+	ListCompOffs(ctx context.Context, in *ListCompOffsRequest, opts ...grpc.CallOption) (*ListCompOffsResponse, error)
+	EncashLeave(ctx context.Context, in *EncashLeaveRequest, opts ...grpc.CallOption) (*EncashLeaveResponse, error)
+	ChangeEncashmentStatus(ctx context.Context, in *ChangeEncashmentStatusRequest, opts ...grpc.CallOption) (*ChangeEncashmentStatusResponse, error)
+	ListEncashments(ctx context.Context, in *ListEncashmentsRequest, opts ...grpc.CallOption) (*ListEncashmentsResponse, error)
+	ListLossOfPay(ctx context.Context, in *ListLossOfPayRequest, opts ...grpc.CallOption) (*ListLossOfPayResponse, error)
+	AdjustBalance(ctx context.Context, in *AdjustBalanceRequest, opts ...grpc.CallOption) (*AdjustBalanceResponse, error)
+	ListBalanceAdjustments(ctx context.Context, in *ListBalanceAdjustmentsRequest, opts ...grpc.CallOption) (*ListBalanceAdjustmentsResponse, error)
+	RecordExit(ctx context.Context, in *RecordExitRequest, opts ...grpc.CallOption) (*RecordExitResponse, error)
+	GetFinalSettlement(ctx context.Context, in *GetFinalSettlementRequest, opts ...grpc.CallOption) (*GetFinalSettlementResponse, error)
 }
 
 type leaveManagementSerivceClient struct {
@@ -74,6 +94,15 @@ func (c *leaveManagementSerivceClient) GetLeaveById(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *leaveManagementSerivceClient) ListMyLeaves(ctx context.Context, in *ListMyLeavesRequest, opts ...grpc.CallOption) (*LeavesListResponse, error) {
+	out := new(LeavesListResponse)
+	err := c.cc.Invoke(ctx, "/leaveManagement.leaveManagementSerivce/ListMyLeaves", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *leaveManagementSerivceClient) DeleteLeave(ctx context.Context, in *DeleteLeaveRequest, opts ...grpc.CallOption) (*DeleteLeaveResponse, error) {
 	out := new(DeleteLeaveResponse)
 	err := c.cc.Invoke(ctx, "/leaveManagement.leaveManagementSerivce/DeleteLeave", in, out, opts...)
@@ -92,6 +121,177 @@ func (c *leaveManagementSerivceClient) UpdateLeave(ctx context.Context, in *Upda
 	return out, nil
 }
 
+func (c *leaveManagementSerivceClient) RestoreLeave(ctx context.Context, in *RestoreLeaveRequest, opts ...grpc.CallOption) (*RestoreLeaveResponse, error) {
+	out := new(RestoreLeaveResponse)
+	err := c.cc.Invoke(ctx, "/leaveManagement.leaveManagementSerivce/RestoreLeave", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveManagementSerivceClient) GetLeaveBalances(ctx context.Context, in *GetLeaveBalancesRequest, opts ...grpc.CallOption) (*GetLeaveBalancesResponse, error) {
+	out := new(GetLeaveBalancesResponse)
+	err := c.cc.Invoke(ctx, "/leaveManagement.leaveManagementSerivce/GetLeaveBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveManagementSerivceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, "/leaveManagement.leaveManagementSerivce/ListAuditEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveManagementSerivceClient) TeamCalendar(ctx context.Context, in *TeamCalendarRequest, opts ...grpc.CallOption) (*TeamCalendarResponse, error) {
+	out := new(TeamCalendarResponse)
+	err := c.cc.Invoke(ctx, "/leaveManagement.leaveManagementSerivce/TeamCalendar", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveManagementSerivceClient) CreateBlackoutPeriod(ctx context.Context, in *CreateBlackoutPeriodRequest, opts ...grpc.CallOption) (*CreateBlackoutPeriodResponse, error) {
+	out := new(CreateBlackoutPeriodResponse)
+	err := c.cc.Invoke(ctx, "/leaveManagement.leaveManagementSerivce/CreateBlackoutPeriod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveManagementSerivceClient) ListBlackoutPeriods(ctx context.Context, in *ListBlackoutPeriodsRequest, opts ...grpc.CallOption) (*ListBlackoutPeriodsResponse, error) {
+	out := new(ListBlackoutPeriodsResponse)
+	err := c.cc.Invoke(ctx, "/leaveManagement.leaveManagementSerivce/ListBlackoutPeriods", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveManagementSerivceClient) DeleteBlackoutPeriod(ctx context.Context, in *DeleteBlackoutPeriodRequest, opts ...grpc.CallOption) (*DeleteBlackoutPeriodResponse, error) {
+	out := new(DeleteBlackoutPeriodResponse)
+	err := c.cc.Invoke(ctx, "/leaveManagement.leaveManagementSerivce/DeleteBlackoutPeriod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveManagementSerivceClient) ListEligibleLeaveTypes(ctx context.Context, in *ListEligibleLeaveTypesRequest, opts ...grpc.CallOption) (*ListEligibleLeaveTypesResponse, error) {
+	out := new(ListEligibleLeaveTypesResponse)
+	err := c.cc.Invoke(ctx, "/leaveManagement.leaveManagementSerivce/ListEligibleLeaveTypes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveManagementSerivceClient) RequestCompOff(ctx context.Context, in *RequestCompOffRequest, opts ...grpc.CallOption) (*RequestCompOffResponse, error) {
+	out := new(RequestCompOffResponse)
+	err := c.cc.Invoke(ctx, "/leaveManagement.leaveManagementSerivce/RequestCompOff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveManagementSerivceClient) ChangeCompOffStatus(ctx context.Context, in *ChangeCompOffStatusRequest, opts ...grpc.CallOption) (*ChangeCompOffStatusResponse, error) {
+	out := new(ChangeCompOffStatusResponse)
+	err := c.cc.Invoke(ctx, "/leaveManagement.leaveManagementSerivce/ChangeCompOffStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveManagementSerivceClient) ListCompOffs(ctx context.Context, in *ListCompOffsRequest, opts ...grpc.CallOption) (*ListCompOffsResponse, error) {
+	out := new(ListCompOffsResponse)
+	err := c.cc.Invoke(ctx, "/leaveManagement.leaveManagementSerivce/ListCompOffs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveManagementSerivceClient) EncashLeave(ctx context.Context, in *EncashLeaveRequest, opts ...grpc.CallOption) (*EncashLeaveResponse, error) {
+	out := new(EncashLeaveResponse)
+	err := c.cc.Invoke(ctx, "/leaveManagement.leaveManagementSerivce/EncashLeave", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveManagementSerivceClient) ChangeEncashmentStatus(ctx context.Context, in *ChangeEncashmentStatusRequest, opts ...grpc.CallOption) (*ChangeEncashmentStatusResponse, error) {
+	out := new(ChangeEncashmentStatusResponse)
+	err := c.cc.Invoke(ctx, "/leaveManagement.leaveManagementSerivce/ChangeEncashmentStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveManagementSerivceClient) ListEncashments(ctx context.Context, in *ListEncashmentsRequest, opts ...grpc.CallOption) (*ListEncashmentsResponse, error) {
+	out := new(ListEncashmentsResponse)
+	err := c.cc.Invoke(ctx, "/leaveManagement.leaveManagementSerivce/ListEncashments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveManagementSerivceClient) ListLossOfPay(ctx context.Context, in *ListLossOfPayRequest, opts ...grpc.CallOption) (*ListLossOfPayResponse, error) {
+	out := new(ListLossOfPayResponse)
+	err := c.cc.Invoke(ctx, "/leaveManagement.leaveManagementSerivce/ListLossOfPay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveManagementSerivceClient) AdjustBalance(ctx context.Context, in *AdjustBalanceRequest, opts ...grpc.CallOption) (*AdjustBalanceResponse, error) {
+	out := new(AdjustBalanceResponse)
+	err := c.cc.Invoke(ctx, "/leaveManagement.leaveManagementSerivce/AdjustBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveManagementSerivceClient) ListBalanceAdjustments(ctx context.Context, in *ListBalanceAdjustmentsRequest, opts ...grpc.CallOption) (*ListBalanceAdjustmentsResponse, error) {
+	out := new(ListBalanceAdjustmentsResponse)
+	err := c.cc.Invoke(ctx, "/leaveManagement.leaveManagementSerivce/ListBalanceAdjustments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveManagementSerivceClient) RecordExit(ctx context.Context, in *RecordExitRequest, opts ...grpc.CallOption) (*RecordExitResponse, error) {
+	out := new(RecordExitResponse)
+	err := c.cc.Invoke(ctx, "/leaveManagement.leaveManagementSerivce/RecordExit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaveManagementSerivceClient) GetFinalSettlement(ctx context.Context, in *GetFinalSettlementRequest, opts ...grpc.CallOption) (*GetFinalSettlementResponse, error) {
+	out := new(GetFinalSettlementResponse)
+	err := c.cc.Invoke(ctx, "/leaveManagement.leaveManagementSerivce/GetFinalSettlement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LeaveManagementSerivceServer is the server API for LeaveManagementSerivce service.
 // All implementations must embed UnimplementedLeaveManagementSerivceServer
 // for forward compatibility
@@ -100,8 +300,28 @@ type LeaveManagementSerivceServer interface {
 	ChangeLeaveStatus(context.Context, *ChangeLeaveStatusRequest) (*ChangeLeaveStatusResponse, error)
 	LeavesList(context.Context, *LeavesListRequest) (*LeavesListResponse, error)
 	GetLeaveById(context.Context, *GetLeaveByIdRequest) (*GetLeaveByIdResponse, error)
+	ListMyLeaves(context.Context, *ListMyLeavesRequest) (*LeavesListResponse, error)
 	DeleteLeave(context.Context, *DeleteLeaveRequest) (*DeleteLeaveResponse, error)
 	UpdateLeave(context.Context, *UpdateLeaveRequest) (*UpdateLeaveResponse, error)
+	RestoreLeave(context.Context, *RestoreLeaveRequest) (*RestoreLeaveResponse, error)
+	GetLeaveBalances(context.Context, *GetLeaveBalancesRequest) (*GetLeaveBalancesResponse, error)
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	TeamCalendar(context.Context, *TeamCalendarRequest) (*TeamCalendarResponse, error)
+	CreateBlackoutPeriod(context.Context, *CreateBlackoutPeriodRequest) (*CreateBlackoutPeriodResponse, error)
+	ListBlackoutPeriods(context.Context, *ListBlackoutPeriodsRequest) (*ListBlackoutPeriodsResponse, error)
+	DeleteBlackoutPeriod(context.Context, *DeleteBlackoutPeriodRequest) (*DeleteBlackoutPeriodResponse, error)
+	ListEligibleLeaveTypes(context.Context, *ListEligibleLeaveTypesRequest) (*ListEligibleLeaveTypesResponse, error)
+	RequestCompOff(context.Context, *RequestCompOffRequest) (*RequestCompOffResponse, error)
+	ChangeCompOffStatus(context.Context, *ChangeCompOffStatusRequest) (*ChangeCompOffStatusResponse, error)
+	ListCompOffs(context.Context, *ListCompOffsRequest) (*ListCompOffsResponse, error)
+	EncashLeave(context.Context, *EncashLeaveRequest) (*EncashLeaveResponse, error)
+	ChangeEncashmentStatus(context.Context, *ChangeEncashmentStatusRequest) (*ChangeEncashmentStatusResponse, error)
+	ListEncashments(context.Context, *ListEncashmentsRequest) (*ListEncashmentsResponse, error)
+	ListLossOfPay(context.Context, *ListLossOfPayRequest) (*ListLossOfPayResponse, error)
+	AdjustBalance(context.Context, *AdjustBalanceRequest) (*AdjustBalanceResponse, error)
+	ListBalanceAdjustments(context.Context, *ListBalanceAdjustmentsRequest) (*ListBalanceAdjustmentsResponse, error)
+	RecordExit(context.Context, *RecordExitRequest) (*RecordExitResponse, error)
+	GetFinalSettlement(context.Context, *GetFinalSettlementRequest) (*GetFinalSettlementResponse, error)
 	mustEmbedUnimplementedLeaveManagementSerivceServer()
 }
 
@@ -121,12 +341,72 @@ func (UnimplementedLeaveManagementSerivceServer) LeavesList(context.Context, *Le
 func (UnimplementedLeaveManagementSerivceServer) GetLeaveById(context.Context, *GetLeaveByIdRequest) (*GetLeaveByIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLeaveById not implemented")
 }
+func (UnimplementedLeaveManagementSerivceServer) ListMyLeaves(context.Context, *ListMyLeavesRequest) (*LeavesListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyLeaves not implemented")
+}
 func (UnimplementedLeaveManagementSerivceServer) DeleteLeave(context.Context, *DeleteLeaveRequest) (*DeleteLeaveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteLeave not implemented")
 }
 func (UnimplementedLeaveManagementSerivceServer) UpdateLeave(context.Context, *UpdateLeaveRequest) (*UpdateLeaveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateLeave not implemented")
 }
+func (UnimplementedLeaveManagementSerivceServer) RestoreLeave(context.Context, *RestoreLeaveRequest) (*RestoreLeaveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreLeave not implemented")
+}
+func (UnimplementedLeaveManagementSerivceServer) GetLeaveBalances(context.Context, *GetLeaveBalancesRequest) (*GetLeaveBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeaveBalances not implemented")
+}
+func (UnimplementedLeaveManagementSerivceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedLeaveManagementSerivceServer) TeamCalendar(context.Context, *TeamCalendarRequest) (*TeamCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TeamCalendar not implemented")
+}
+func (UnimplementedLeaveManagementSerivceServer) CreateBlackoutPeriod(context.Context, *CreateBlackoutPeriodRequest) (*CreateBlackoutPeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBlackoutPeriod not implemented")
+}
+func (UnimplementedLeaveManagementSerivceServer) ListBlackoutPeriods(context.Context, *ListBlackoutPeriodsRequest) (*ListBlackoutPeriodsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlackoutPeriods not implemented")
+}
+func (UnimplementedLeaveManagementSerivceServer) DeleteBlackoutPeriod(context.Context, *DeleteBlackoutPeriodRequest) (*DeleteBlackoutPeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBlackoutPeriod not implemented")
+}
+func (UnimplementedLeaveManagementSerivceServer) ListEligibleLeaveTypes(context.Context, *ListEligibleLeaveTypesRequest) (*ListEligibleLeaveTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEligibleLeaveTypes not implemented")
+}
+func (UnimplementedLeaveManagementSerivceServer) RequestCompOff(context.Context, *RequestCompOffRequest) (*RequestCompOffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestCompOff not implemented")
+}
+func (UnimplementedLeaveManagementSerivceServer) ChangeCompOffStatus(context.Context, *ChangeCompOffStatusRequest) (*ChangeCompOffStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeCompOffStatus not implemented")
+}
+func (UnimplementedLeaveManagementSerivceServer) ListCompOffs(context.Context, *ListCompOffsRequest) (*ListCompOffsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCompOffs not implemented")
+}
+func (UnimplementedLeaveManagementSerivceServer) EncashLeave(context.Context, *EncashLeaveRequest) (*EncashLeaveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncashLeave not implemented")
+}
+func (UnimplementedLeaveManagementSerivceServer) ChangeEncashmentStatus(context.Context, *ChangeEncashmentStatusRequest) (*ChangeEncashmentStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeEncashmentStatus not implemented")
+}
+func (UnimplementedLeaveManagementSerivceServer) ListEncashments(context.Context, *ListEncashmentsRequest) (*ListEncashmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEncashments not implemented")
+}
+func (UnimplementedLeaveManagementSerivceServer) ListLossOfPay(context.Context, *ListLossOfPayRequest) (*ListLossOfPayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLossOfPay not implemented")
+}
+func (UnimplementedLeaveManagementSerivceServer) AdjustBalance(context.Context, *AdjustBalanceRequest) (*AdjustBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustBalance not implemented")
+}
+func (UnimplementedLeaveManagementSerivceServer) ListBalanceAdjustments(context.Context, *ListBalanceAdjustmentsRequest) (*ListBalanceAdjustmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBalanceAdjustments not implemented")
+}
+func (UnimplementedLeaveManagementSerivceServer) RecordExit(context.Context, *RecordExitRequest) (*RecordExitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordExit not implemented")
+}
+func (UnimplementedLeaveManagementSerivceServer) GetFinalSettlement(context.Context, *GetFinalSettlementRequest) (*GetFinalSettlementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFinalSettlement not implemented")
+}
 func (UnimplementedLeaveManagementSerivceServer) mustEmbedUnimplementedLeaveManagementSerivceServer() {
 }
 
@@ -213,6 +493,24 @@ func _LeaveManagementSerivce_GetLeaveById_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LeaveManagementSerivce_ListMyLeaves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyLeavesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveManagementSerivceServer).ListMyLeaves(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/leaveManagement.leaveManagementSerivce/ListMyLeaves",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveManagementSerivceServer).ListMyLeaves(ctx, req.(*ListMyLeavesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LeaveManagementSerivce_DeleteLeave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteLeaveRequest)
 	if err := dec(in); err != nil {
@@ -249,6 +547,348 @@ func _LeaveManagementSerivce_UpdateLeave_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LeaveManagementSerivce_RestoreLeave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreLeaveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveManagementSerivceServer).RestoreLeave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/leaveManagement.leaveManagementSerivce/RestoreLeave",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveManagementSerivceServer).RestoreLeave(ctx, req.(*RestoreLeaveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveManagementSerivce_GetLeaveBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaveBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveManagementSerivceServer).GetLeaveBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/leaveManagement.leaveManagementSerivce/GetLeaveBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveManagementSerivceServer).GetLeaveBalances(ctx, req.(*GetLeaveBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveManagementSerivce_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveManagementSerivceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/leaveManagement.leaveManagementSerivce/ListAuditEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveManagementSerivceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveManagementSerivce_TeamCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TeamCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveManagementSerivceServer).TeamCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/leaveManagement.leaveManagementSerivce/TeamCalendar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveManagementSerivceServer).TeamCalendar(ctx, req.(*TeamCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveManagementSerivce_CreateBlackoutPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBlackoutPeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveManagementSerivceServer).CreateBlackoutPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/leaveManagement.leaveManagementSerivce/CreateBlackoutPeriod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveManagementSerivceServer).CreateBlackoutPeriod(ctx, req.(*CreateBlackoutPeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveManagementSerivce_ListBlackoutPeriods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlackoutPeriodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveManagementSerivceServer).ListBlackoutPeriods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/leaveManagement.leaveManagementSerivce/ListBlackoutPeriods",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveManagementSerivceServer).ListBlackoutPeriods(ctx, req.(*ListBlackoutPeriodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveManagementSerivce_DeleteBlackoutPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBlackoutPeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveManagementSerivceServer).DeleteBlackoutPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/leaveManagement.leaveManagementSerivce/DeleteBlackoutPeriod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveManagementSerivceServer).DeleteBlackoutPeriod(ctx, req.(*DeleteBlackoutPeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveManagementSerivce_ListEligibleLeaveTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEligibleLeaveTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveManagementSerivceServer).ListEligibleLeaveTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/leaveManagement.leaveManagementSerivce/ListEligibleLeaveTypes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveManagementSerivceServer).ListEligibleLeaveTypes(ctx, req.(*ListEligibleLeaveTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveManagementSerivce_RequestCompOff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestCompOffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveManagementSerivceServer).RequestCompOff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/leaveManagement.leaveManagementSerivce/RequestCompOff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveManagementSerivceServer).RequestCompOff(ctx, req.(*RequestCompOffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveManagementSerivce_ChangeCompOffStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeCompOffStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveManagementSerivceServer).ChangeCompOffStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/leaveManagement.leaveManagementSerivce/ChangeCompOffStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveManagementSerivceServer).ChangeCompOffStatus(ctx, req.(*ChangeCompOffStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveManagementSerivce_ListCompOffs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCompOffsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveManagementSerivceServer).ListCompOffs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/leaveManagement.leaveManagementSerivce/ListCompOffs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveManagementSerivceServer).ListCompOffs(ctx, req.(*ListCompOffsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveManagementSerivce_EncashLeave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EncashLeaveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveManagementSerivceServer).EncashLeave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/leaveManagement.leaveManagementSerivce/EncashLeave",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveManagementSerivceServer).EncashLeave(ctx, req.(*EncashLeaveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveManagementSerivce_ChangeEncashmentStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeEncashmentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveManagementSerivceServer).ChangeEncashmentStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/leaveManagement.leaveManagementSerivce/ChangeEncashmentStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveManagementSerivceServer).ChangeEncashmentStatus(ctx, req.(*ChangeEncashmentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveManagementSerivce_ListEncashments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEncashmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveManagementSerivceServer).ListEncashments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/leaveManagement.leaveManagementSerivce/ListEncashments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveManagementSerivceServer).ListEncashments(ctx, req.(*ListEncashmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveManagementSerivce_ListLossOfPay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLossOfPayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveManagementSerivceServer).ListLossOfPay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/leaveManagement.leaveManagementSerivce/ListLossOfPay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveManagementSerivceServer).ListLossOfPay(ctx, req.(*ListLossOfPayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveManagementSerivce_AdjustBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveManagementSerivceServer).AdjustBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/leaveManagement.leaveManagementSerivce/AdjustBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveManagementSerivceServer).AdjustBalance(ctx, req.(*AdjustBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveManagementSerivce_ListBalanceAdjustments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBalanceAdjustmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveManagementSerivceServer).ListBalanceAdjustments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/leaveManagement.leaveManagementSerivce/ListBalanceAdjustments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveManagementSerivceServer).ListBalanceAdjustments(ctx, req.(*ListBalanceAdjustmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveManagementSerivce_RecordExit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordExitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveManagementSerivceServer).RecordExit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/leaveManagement.leaveManagementSerivce/RecordExit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveManagementSerivceServer).RecordExit(ctx, req.(*RecordExitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaveManagementSerivce_GetFinalSettlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFinalSettlementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaveManagementSerivceServer).GetFinalSettlement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/leaveManagement.leaveManagementSerivce/GetFinalSettlement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaveManagementSerivceServer).GetFinalSettlement(ctx, req.(*GetFinalSettlementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LeaveManagementSerivce_ServiceDesc is the grpc.ServiceDesc for LeaveManagementSerivce service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -272,6 +912,10 @@ var LeaveManagementSerivce_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLeaveById",
 			Handler:    _LeaveManagementSerivce_GetLeaveById_Handler,
 		},
+		{
+			MethodName: "ListMyLeaves",
+			Handler:    _LeaveManagementSerivce_ListMyLeaves_Handler,
+		},
 		{
 			MethodName: "DeleteLeave",
 			Handler:    _LeaveManagementSerivce_DeleteLeave_Handler,
@@ -280,6 +924,82 @@ var LeaveManagementSerivce_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateLeave",
 			Handler:    _LeaveManagementSerivce_UpdateLeave_Handler,
 		},
+		{
+			MethodName: "RestoreLeave",
+			Handler:    _LeaveManagementSerivce_RestoreLeave_Handler,
+		},
+		{
+			MethodName: "GetLeaveBalances",
+			Handler:    _LeaveManagementSerivce_GetLeaveBalances_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _LeaveManagementSerivce_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "TeamCalendar",
+			Handler:    _LeaveManagementSerivce_TeamCalendar_Handler,
+		},
+		{
+			MethodName: "CreateBlackoutPeriod",
+			Handler:    _LeaveManagementSerivce_CreateBlackoutPeriod_Handler,
+		},
+		{
+			MethodName: "ListBlackoutPeriods",
+			Handler:    _LeaveManagementSerivce_ListBlackoutPeriods_Handler,
+		},
+		{
+			MethodName: "DeleteBlackoutPeriod",
+			Handler:    _LeaveManagementSerivce_DeleteBlackoutPeriod_Handler,
+		},
+		{
+			MethodName: "ListEligibleLeaveTypes",
+			Handler:    _LeaveManagementSerivce_ListEligibleLeaveTypes_Handler,
+		},
+		{
+			MethodName: "RequestCompOff",
+			Handler:    _LeaveManagementSerivce_RequestCompOff_Handler,
+		},
+		{
+			MethodName: "ChangeCompOffStatus",
+			Handler:    _LeaveManagementSerivce_ChangeCompOffStatus_Handler,
+		},
+		{
+			MethodName: "ListCompOffs",
+			Handler:    _LeaveManagementSerivce_ListCompOffs_Handler,
+		},
+		{
+			MethodName: "EncashLeave",
+			Handler:    _LeaveManagementSerivce_EncashLeave_Handler,
+		},
+		{
+			MethodName: "ChangeEncashmentStatus",
+			Handler:    _LeaveManagementSerivce_ChangeEncashmentStatus_Handler,
+		},
+		{
+			MethodName: "ListEncashments",
+			Handler:    _LeaveManagementSerivce_ListEncashments_Handler,
+		},
+		{
+			MethodName: "ListLossOfPay",
+			Handler:    _LeaveManagementSerivce_ListLossOfPay_Handler,
+		},
+		{
+			MethodName: "AdjustBalance",
+			Handler:    _LeaveManagementSerivce_AdjustBalance_Handler,
+		},
+		{
+			MethodName: "ListBalanceAdjustments",
+			Handler:    _LeaveManagementSerivce_ListBalanceAdjustments_Handler,
+		},
+		{
+			MethodName: "RecordExit",
+			Handler:    _LeaveManagementSerivce_RecordExit_Handler,
+		},
+		{
+			MethodName: "GetFinalSettlement",
+			Handler:    _LeaveManagementSerivce_GetFinalSettlement_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pb/lm.proto",